@@ -4,17 +4,22 @@ import (
 	"github.com/gin-gonic/gin"
 	ginSwagger "github.com/swaggo/gin-swagger"
 	"github.com/swaggo/gin-swagger/swaggerFiles"
+	"github.com/trustwallet/blockatlas/api/middleware"
 	_ "github.com/trustwallet/blockatlas/docs"
 	"github.com/trustwallet/blockatlas/platform"
+	"github.com/trustwallet/blockatlas/services/status"
 )
 
+// SetupPlatformAPI registers the routes of every supported platform, requests to
+// platforms disabled by the current config are answered with 404
 func SetupPlatformAPI(router gin.IRouter) {
-	for _, api := range platform.Platforms {
+	for _, api := range platform.GetAllPlatforms() {
 		RegisterTransactionsAPI(router, api)
 		RegisterTokensAPI(router, api)
+		RegisterApprovalsAPI(router, api)
 		RegisterStakeAPI(router, api)
 	}
-	for _, api := range platform.CollectionsAPIs {
+	for _, api := range platform.GetAllCollectionsAPIs() {
 		RegisterCollectionsAPI(router, api)
 	}
 
@@ -23,6 +28,26 @@ func SetupPlatformAPI(router gin.IRouter) {
 	RegisterBasicAPI(router)
 }
 
+func SetupStatusAPI(router gin.IRouter, checker *status.Checker) {
+	for _, api := range platform.GetAllPlatforms() {
+		RegisterPlatformStatusAPI(router, api, checker)
+	}
+	RegisterStatusAPI(router, checker)
+}
+
+// SetupPlatformsAPI lists the routes registered on the engine, so call it after the other setups
+func SetupPlatformsAPI(engine *gin.Engine) {
+	RegisterPlatformsAPI(engine, engine.Routes)
+}
+
+// SetupAdminAPI is only enabled if an admin token is configured
+func SetupAdminAPI(router gin.IRouter, token string, reload func() (platform.Changes, error)) {
+	if token == "" {
+		return
+	}
+	RegisterAdminAPI(router.Group("/admin", middleware.AdminTokenMiddleware(token)), reload)
+}
+
 func SetupSwaggerAPI(router gin.IRouter) {
 	router.GET("swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 }
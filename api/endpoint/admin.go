@@ -0,0 +1,26 @@
+package endpoint
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/trustwallet/blockatlas/platform"
+)
+
+// @Summary Reload platforms
+// @ID reload_platforms
+// @Description Re-read the config file and rebuild the platform registry
+// @Produce json
+// @Tags Admin
+// @Param Authorization header string true "Bearer admin token"
+// @Success 200 {object} platform.Changes
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/reload [post]
+func ReloadPlatforms(c *gin.Context, reload func() (platform.Changes, error)) {
+	changes, err := reload()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	c.JSON(http.StatusOK, changes)
+}
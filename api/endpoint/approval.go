@@ -0,0 +1,27 @@
+package endpoint
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"net/http"
+)
+
+// @Summary Get Approvals
+// @ID approvals
+// @Description Get the current non-zero token allowances of the address
+// @Accept json
+// @Produce json
+// @Tags Transactions
+// @Param coin path string true "the coin name" default(ethereum)
+// @Param address path string true "the query address" default(0x5574Cd97432cEd0D7Caf58ac3c4fEDB2061C98fB)
+// @Success 200 {object} blockatlas.ApprovalPage
+// @Failure 500 {object} ErrorResponse
+// @Router /v2/{coin}/approvals/{address} [get]
+func GetApprovalsByAddress(c *gin.Context, api blockatlas.ApprovalsAPI) {
+	result, err := api.GetApprovals(c.Param("address"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	c.JSON(http.StatusOK, blockatlas.DocsResponse{Docs: &result})
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/trustwallet/blockatlas/pkg/errors"
 	"github.com/trustwallet/blockatlas/services/domains"
 )
 
@@ -80,3 +81,84 @@ func sliceAtoi(sa []string) ([]uint64, error) {
 	}
 	return si, nil
 }
+
+// @Summary Reverse lookup of an address
+// @ID reverse_lookup
+// @Description Find the verified ENS, Unstoppable Domains or FIO name of an address
+// @Produce json
+// @Tags Naming
+// @Param coin query string true "string coin" default(60)
+// @Param address query string true "string address"
+// @Success 200 {object} blockatlas.ReverseResolved
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /v2/ns/reverse [get]
+func GetNameByAddress(c *gin.Context) {
+	coin, err := strconv.ParseUint(c.Query("coin"), 10, 64)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	address := c.Query("address")
+	if address == "" {
+		c.AbortWithStatusJSON(http.StatusBadRequest, errorResponse(errors.E("empty address")))
+		return
+	}
+	result, err := domains.HandleReverseLookup(coin, address)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+// @Summary Reverse lookup of multiple addresses
+// @ID reverse_lookup_batch
+// @Description Find the verified names of up to 100 addresses, addresses without a name have an empty name
+// @Accept json
+// @Produce json
+// @Tags Naming
+// @Param addresses body AddressesRequest true "Addresses and coins"
+// @Success 200 {array} blockatlas.ReverseResolved
+// @Failure 400 {object} ErrorResponse
+// @Router /v2/ns/reverse [post]
+func GetNamesByAddresses(c *gin.Context) {
+	var reqs AddressesRequest
+	if err := c.BindJSON(&reqs); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	if len(reqs) > domains.MaxReverseLookupBatch {
+		c.AbortWithStatusJSON(http.StatusBadRequest, errorResponse(errors.E("too many addresses",
+			errors.Params{"max": domains.MaxReverseLookupBatch})))
+		return
+	}
+	requests := make([]domains.AddressRequest, 0, len(reqs))
+	for _, r := range reqs {
+		requests = append(requests, domains.AddressRequest{Coin: uint64(r.Coin), Address: r.Address})
+	}
+	c.JSON(http.StatusOK, domains.HandleReverseLookupBatch(requests))
+}
+
+// @Summary Get the profile of a name
+// @ID name_profile
+// @Description Get the avatar, url, email, twitter, description and contenthash records of an ENS or Unstoppable Domains name
+// @Produce json
+// @Tags Naming
+// @Param name query string true "string name" default(vitalik.eth)
+// @Success 200 {object} blockatlas.NameProfile
+// @Failure 404 {object} ErrorResponse
+// @Router /v2/ns/profile [get]
+func GetNameProfile(c *gin.Context) {
+	name := c.Query("name")
+	if name == "" {
+		c.AbortWithStatusJSON(http.StatusBadRequest, errorResponse(errors.E("empty name")))
+		return
+	}
+	result, err := domains.HandleProfile(name)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, errorResponse(err))
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
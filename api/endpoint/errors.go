@@ -1,5 +1,12 @@
 package endpoint
 
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/trustwallet/blockatlas/pkg/errors"
+)
+
 type (
 	ErrorResponse struct {
 		Error ErrorDetails `json:"error"`
@@ -20,3 +27,9 @@ func errorResponse(err error) ErrorResponse {
 		Message: message,
 	}}
 }
+
+// PlatformNotEnabled responds to requests for a platform disabled by the current config
+func PlatformNotEnabled(c *gin.Context, handle string) {
+	err := errors.E("platform is not enabled", errors.Params{"platform": handle})
+	c.AbortWithStatusJSON(http.StatusNotFound, errorResponse(err))
+}
@@ -0,0 +1,49 @@
+package endpoint
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/platform"
+)
+
+// @Summary Get enabled platforms
+// @ID platforms
+// @Description Get the enabled coins with their metadata, supported interfaces and endpoint paths
+// @Produce json
+// @Tags Platforms
+// @Success 200 {array} blockatlas.PlatformInfo
+// @Router /v2/platforms [get]
+func GetPlatforms(c *gin.Context, platforms map[string]blockatlas.Platform, routes gin.RoutesInfo) {
+	c.JSON(http.StatusOK, getPlatformsInfo(platforms, routes))
+}
+
+func getPlatformsInfo(platforms map[string]blockatlas.Platform, routes gin.RoutesInfo) []blockatlas.PlatformInfo {
+	result := make([]blockatlas.PlatformInfo, 0, len(platforms))
+	for _, p := range platforms {
+		info := platform.GetPlatformInfo(p)
+		info.Endpoints = getPlatformEndpoints(info.Handle, routes)
+		result = append(result, info)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Coin < result[j].Coin
+	})
+	return result
+}
+
+// getPlatformEndpoints returns the routes registered for the handle, e.g. GET /v2/cosmos/transactions/:address
+func getPlatformEndpoints(handle string, routes gin.RoutesInfo) []string {
+	endpoints := make([]string, 0)
+	for _, r := range routes {
+		parts := strings.Split(r.Path, "/")
+		if len(parts) < 3 || parts[2] != handle {
+			continue
+		}
+		endpoints = append(endpoints, r.Method+" "+r.Path)
+	}
+	sort.Strings(endpoints)
+	return endpoints
+}
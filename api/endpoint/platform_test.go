@@ -0,0 +1,25 @@
+package endpoint
+
+import (
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_getPlatformEndpoints(t *testing.T) {
+	routes := gin.RoutesInfo{
+		{Method: "GET", Path: "/v2/cosmos/transactions/:address"},
+		{Method: "GET", Path: "/v1/cosmos/:address"},
+		{Method: "GET", Path: "/v2/cosmos/staking/validators"},
+		{Method: "GET", Path: "/v2/tezos/transactions/:address"},
+		{Method: "POST", Path: "/v2/staking/delegations"},
+		{Method: "GET", Path: "/"},
+	}
+	assert.Equal(t, []string{
+		"GET /v1/cosmos/:address",
+		"GET /v2/cosmos/staking/validators",
+		"GET /v2/cosmos/transactions/:address",
+	}, getPlatformEndpoints("cosmos", routes))
+	assert.Equal(t, []string{}, getPlatformEndpoints("bitcoin", routes))
+}
@@ -5,6 +5,8 @@ import (
 	"github.com/trustwallet/blockatlas/coin"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 	"github.com/trustwallet/blockatlas/pkg/errors"
+	"github.com/trustwallet/blockatlas/pkg/logger"
+	"github.com/trustwallet/blockatlas/services/economics"
 	"net/http"
 	"sort"
 	"strconv"
@@ -30,6 +32,16 @@ type (
 	CoinsRequest     []CoinBatchRequest
 )
 
+const (
+	validatorsSortAPR        = "apr"
+	validatorsSortCommission = "commission"
+	validatorsSortStake      = "stake"
+	validatorsSortUptime     = "uptime"
+
+	validatorsFilterActive   = "active"
+	validatorsFilterUnjailed = "unjailed"
+)
+
 // @Summary Get Multiple Stake Delegations
 // @ID batch_delegations
 // @Description Get Stake Delegations for multiple coins
@@ -150,7 +162,10 @@ func GetStakeInfoForCoins(c *gin.Context, apis map[string]blockatlas.StakeAPI) {
 // @Produce json
 // @Tags Staking
 // @Param coin path string true "the coin name" default(cosmos)
+// @Param sort query string false "the order of the validators: apr, commission, stake or uptime, the order of the platform without it"
+// @Param filter query string false "comma separated conditions on the validators: active, unjailed"
 // @Success 200 {object} blockatlas.DocsResponse
+// @Failure 400 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 // @Router /v2/{coin}/staking/validators [get]
 func GetValidators(c *gin.Context, api blockatlas.StakeAPI) {
@@ -159,6 +174,17 @@ func GetValidators(c *gin.Context, api blockatlas.StakeAPI) {
 		c.AbortWithStatusJSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
+	results, err = filterValidators(results, c.Query("filter"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	if by := c.Query("sort"); by != "" {
+		if err := sortValidators(results, by); err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, errorResponse(err))
+			return
+		}
+	}
 	c.JSON(http.StatusOK, blockatlas.DocsResponse{Docs: &results})
 }
 
@@ -183,6 +209,72 @@ func GetStakingDelegationsForSpecificCoin(c *gin.Context, api blockatlas.StakeAP
 	c.JSON(http.StatusOK, &result)
 }
 
+// @Summary Get Staking Rewards
+// @ID staking_rewards
+// @Description Get the pending rewards and the history of the rewards of the address
+// @Accept json
+// @Produce json
+// @Tags Staking
+// @Param coin path string true "the coin name" default(cosmos)
+// @Param address path string true "the query address" default(cosmos1cxehfdhfm96ljpktdxsj0k6xp9gtuheghwgqug)
+// @Param page query int false "the page of the history" default(1)
+// @Success 200 {object} blockatlas.RewardsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /v2/{coin}/staking/rewards/{address} [get]
+func GetStakingRewards(c *gin.Context, api blockatlas.StakingRewardsAPI) {
+	address := c.Param("address")
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		c.AbortWithStatusJSON(http.StatusBadRequest, errorResponse(errors.E("invalid page", errors.Params{"page": c.Query("page")})))
+		return
+	}
+	pending, err := api.GetPendingRewards(address)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	history, err := api.GetRewardsHistory(address, page)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	rewardsCoin := api.Coin()
+	c.JSON(http.StatusOK, blockatlas.RewardsResponse{
+		Address:      address,
+		Coin:         rewardsCoin.External(),
+		PendingTotal: pending.Total(),
+		Pending:      pending,
+		History:      history,
+	})
+}
+
+// @Summary Get Staking APR History
+// @ID staking_apr
+// @Description Get the yield of the network, or of a validator, computed periodically from the chain
+// @Accept json
+// @Produce json
+// @Tags Staking
+// @Param coin path string true "the coin name" default(cosmos)
+// @Param validator query string false "the validator id"
+// @Success 200 {object} blockatlas.APRHistoryResponse
+// @Router /v2/{coin}/staking/apr [get]
+func GetStakingAPRHistory(c *gin.Context, api blockatlas.StakingEconomicsAPI) {
+	aprCoin := api.Coin()
+	validator := c.Query("validator")
+	history := economics.History(aprCoin.Handle, validator)
+	var annual float64
+	if len(history) > 0 {
+		annual = history[len(history)-1].Annual
+	}
+	c.JSON(http.StatusOK, blockatlas.APRHistoryResponse{
+		Coin:      aprCoin.External(),
+		Validator: validator,
+		Annual:    annual,
+		History:   history,
+	})
+}
+
 func getDelegationResponse(api blockatlas.StakeAPI, address string) (blockatlas.DelegationResponse, error) {
 	delegations, err := api.GetDelegations(address)
 	if err != nil {
@@ -203,10 +295,41 @@ func getDelegationResponse(api blockatlas.StakeAPI, address string) (blockatlas.
 		Balance:         balance,
 		Delegations:     delegations,
 		Address:         address,
+		Rewards:         getPendingRewards(api, address),
+		Redelegations:   getRedelegations(api, address),
+		Locked:          delegations.Locked(),
 		StakingResponse: getStakingResponse(api),
 	}, nil
 }
 
+// getRedelegations returns nil for the platforms without redelegations, a failure doesn't fail the delegations
+func getRedelegations(api blockatlas.StakeAPI, address string) blockatlas.RedelegationsPage {
+	redelegationsAPI, ok := api.(blockatlas.RedelegationsAPI)
+	if !ok {
+		return nil
+	}
+	redelegations, err := redelegationsAPI.GetRedelegations(address)
+	if err != nil {
+		logger.Error(err, "Unable to fetch redelegations", logger.Params{"coin": api.Coin().Handle, "address": address})
+		return nil
+	}
+	return redelegations
+}
+
+// getPendingRewards returns nil for the platforms without rewards, a failure doesn't fail the delegations
+func getPendingRewards(api blockatlas.StakeAPI, address string) blockatlas.PendingRewards {
+	rewardsAPI, ok := api.(blockatlas.StakingRewardsAPI)
+	if !ok {
+		return nil
+	}
+	rewards, err := rewardsAPI.GetPendingRewards(address)
+	if err != nil {
+		logger.Error(err, "Unable to fetch pending rewards", logger.Params{"coin": api.Coin().Handle, "address": address})
+		return nil
+	}
+	return rewards
+}
+
 func getStakingResponse(api blockatlas.StakeAPI) blockatlas.StakingResponse {
 	stakingCoin := api.Coin()
 	return blockatlas.StakingResponse{
@@ -215,6 +338,76 @@ func getStakingResponse(api blockatlas.StakeAPI) blockatlas.StakingResponse {
 	}
 }
 
+// filterValidators keeps the validators meeting all the conditions, the validators without metrics are never jailed
+func filterValidators(validators blockatlas.StakeValidators, filter string) (blockatlas.StakeValidators, error) {
+	if filter == "" {
+		return validators, nil
+	}
+	conditions := strings.Split(filter, ",")
+	for _, condition := range conditions {
+		if condition != validatorsFilterActive && condition != validatorsFilterUnjailed {
+			return nil, errors.E("invalid filter", errors.Params{"filter": condition})
+		}
+	}
+	result := make(blockatlas.StakeValidators, 0, len(validators))
+	for _, v := range validators {
+		keep := true
+		for _, condition := range conditions {
+			switch condition {
+			case validatorsFilterActive:
+				keep = keep && v.Status
+			case validatorsFilterUnjailed:
+				keep = keep && (v.Metrics == nil || !v.Metrics.Jailed)
+			}
+		}
+		if keep {
+			result = append(result, v)
+		}
+	}
+	return result, nil
+}
+
+// sortValidators orders the validators from the best, the lowest commission first, the validators without metrics last.
+// The validators with an unknown commission come after the known ones when sorted by commission
+func sortValidators(validators blockatlas.StakeValidators, by string) error {
+	var less func(i, j blockatlas.StakeValidator) bool
+	switch by {
+	case validatorsSortAPR:
+		less = func(i, j blockatlas.StakeValidator) bool {
+			return i.Details.Reward.Annual > j.Details.Reward.Annual
+		}
+	case validatorsSortCommission:
+		less = byMetrics(func(i, j *blockatlas.ValidatorMetrics) bool {
+			if i.HasCommission != j.HasCommission {
+				return i.HasCommission
+			}
+			return i.Commission < j.Commission
+		})
+	case validatorsSortStake:
+		less = byMetrics(func(i, j *blockatlas.ValidatorMetrics) bool { return i.StakeShare > j.StakeShare })
+	case validatorsSortUptime:
+		less = byMetrics(func(i, j *blockatlas.ValidatorMetrics) bool { return i.Uptime > j.Uptime })
+	default:
+		return errors.E("invalid sort", errors.Params{"sort": by})
+	}
+	sort.SliceStable(validators, func(i, j int) bool {
+		if less(validators[i], validators[j]) != less(validators[j], validators[i]) {
+			return less(validators[i], validators[j])
+		}
+		return validators[i].ID < validators[j].ID
+	})
+	return nil
+}
+
+func byMetrics(less func(i, j *blockatlas.ValidatorMetrics) bool) func(i, j blockatlas.StakeValidator) bool {
+	return func(i, j blockatlas.StakeValidator) bool {
+		if i.Metrics == nil || j.Metrics == nil {
+			return i.Metrics != nil && j.Metrics == nil
+		}
+		return less(i.Metrics, j.Metrics)
+	}
+}
+
 func sortDelegations(delegations blockatlas.DelegationsPage) blockatlas.DelegationsPage {
 	sort.Slice(delegations, func(i, j int) bool {
 		iA, err := strconv.Atoi(delegations[i].Value)
@@ -0,0 +1,85 @@
+package endpoint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+)
+
+func testValidators() blockatlas.StakeValidators {
+	return blockatlas.StakeValidators{
+		{ID: "a", Status: true, Details: blockatlas.StakingDetails{Reward: blockatlas.StakingReward{Annual: 7}},
+			Metrics: &blockatlas.ValidatorMetrics{Commission: 10, HasCommission: true, StakeShare: 20, Uptime: 99}},
+		{ID: "b", Status: false, Details: blockatlas.StakingDetails{Reward: blockatlas.StakingReward{Annual: 9}}},
+		{ID: "c", Status: true, Details: blockatlas.StakingDetails{Reward: blockatlas.StakingReward{Annual: 8}},
+			Metrics: &blockatlas.ValidatorMetrics{Commission: 5, HasCommission: true, StakeShare: 30, Uptime: 95, Jailed: true}},
+		{ID: "d", Status: true, Details: blockatlas.StakingDetails{Reward: blockatlas.StakingReward{Annual: 7}},
+			Metrics: &blockatlas.ValidatorMetrics{Commission: 5, HasCommission: true, StakeShare: 10, Uptime: 100}},
+	}
+}
+
+func ids(validators blockatlas.StakeValidators) []string {
+	result := make([]string, 0, len(validators))
+	for _, v := range validators {
+		result = append(result, v.ID)
+	}
+	return result
+}
+
+func Test_sortValidators(t *testing.T) {
+	tests := []struct {
+		by      string
+		want    []string
+		wantErr bool
+	}{
+		{validatorsSortAPR, []string{"b", "c", "a", "d"}, false},
+		{validatorsSortCommission, []string{"c", "d", "a", "b"}, false},
+		{validatorsSortStake, []string{"c", "a", "d", "b"}, false},
+		{validatorsSortUptime, []string{"d", "a", "c", "b"}, false},
+		{"name", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.by, func(t *testing.T) {
+			validators := testValidators()
+			err := sortValidators(validators, tt.by)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, ids(validators))
+		})
+	}
+}
+
+func Test_sortValidators_unknownCommission(t *testing.T) {
+	validators := append(testValidators(), blockatlas.StakeValidator{ID: "e", Metrics: &blockatlas.ValidatorMetrics{Uptime: 100}})
+	assert.NoError(t, sortValidators(validators, validatorsSortCommission))
+	assert.Equal(t, []string{"c", "d", "a", "e", "b"}, ids(validators))
+}
+
+func Test_filterValidators(t *testing.T) {
+	tests := []struct {
+		filter  string
+		want    []string
+		wantErr bool
+	}{
+		{"", []string{"a", "b", "c", "d"}, false},
+		{validatorsFilterActive, []string{"a", "c", "d"}, false},
+		{validatorsFilterUnjailed, []string{"a", "b", "d"}, false},
+		{"active,unjailed", []string{"a", "d"}, false},
+		{"active,top", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			result, err := filterValidators(testValidators(), tt.filter)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, ids(result))
+		})
+	}
+}
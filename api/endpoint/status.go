@@ -0,0 +1,60 @@
+package endpoint
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/services/status"
+)
+
+// @Summary Get platforms status
+// @ID platforms_status
+// @Description Get upstream reachability, chain head and parser lag of every enabled platform
+// @Produce json
+// @Tags Status
+// @Success 200 {object} status.Report
+// @Router /v2/status [get]
+func GetPlatformsStatus(c *gin.Context, checker *status.Checker, platforms map[string]blockatlas.Platform) {
+	c.JSON(http.StatusOK, checker.GetReport(platforms))
+}
+
+// @Summary Get platform status
+// @ID platform_status
+// @Description Get upstream reachability, chain head and parser lag of the platform
+// @Produce json
+// @Tags Status
+// @Param coin path string true "the coin name" default(cosmos)
+// @Success 200 {object} blockatlas.PlatformStatus
+// @Router /v2/{coin}/status [get]
+func GetPlatformStatus(c *gin.Context, checker *status.Checker, api blockatlas.Platform) {
+	c.JSON(http.StatusOK, checker.GetPlatformStatus(api))
+}
+
+// @Summary Liveness probe
+// @ID liveness
+// @Description Kubernetes liveness probe
+// @Produce json
+// @Tags Status
+// @Success 200 {object} status.Probe
+// @Router /v2/status/live [get]
+func GetLiveness(c *gin.Context, checker *status.Checker) {
+	c.JSON(http.StatusOK, checker.Live())
+}
+
+// @Summary Readiness probe
+// @ID readiness
+// @Description Kubernetes readiness probe, derived from the platforms status
+// @Produce json
+// @Tags Status
+// @Success 200 {object} status.Probe
+// @Failure 503 {object} status.Probe
+// @Router /v2/status/ready [get]
+func GetReadiness(c *gin.Context, checker *status.Checker, platforms map[string]blockatlas.Platform) {
+	probe := checker.Ready(platforms)
+	if !probe.Status {
+		c.JSON(http.StatusServiceUnavailable, probe)
+		return
+	}
+	c.JSON(http.StatusOK, probe)
+}
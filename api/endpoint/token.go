@@ -3,6 +3,7 @@ package endpoint
 import (
 	"github.com/gin-gonic/gin"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/services/tokens"
 	"net/http"
 	"strconv"
 	"sync"
@@ -32,6 +33,7 @@ func GetTokensByAddress(c *gin.Context, tokenAPI blockatlas.TokensAPI) {
 		c.AbortWithStatusJSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
+	tokens.EnrichTokens(result)
 	c.JSON(http.StatusOK, blockatlas.DocsResponse{Docs: &result})
 }
 
@@ -61,9 +63,9 @@ func GetTokens(c *gin.Context, apis map[uint]blockatlas.TokensAPI) {
 			continue
 		}
 
-		tokens := getTokens(api, addresses)
-		result = append(result, tokens...)
+		result = append(result, getTokens(api, addresses)...)
 	}
+	tokens.EnrichTokens(result)
 	c.JSON(http.StatusOK, blockatlas.ResultsResponse{Total: len(result), Results: &result})
 }
 
@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdminTokenMiddleware(t *testing.T) {
+	router := gin.New()
+	router.POST("/reload", AdminTokenMiddleware("secret"), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	tests := []struct {
+		name   string
+		header string
+		want   int
+	}{
+		{"valid token", "Bearer secret", http.StatusOK},
+		{"invalid token", "Bearer public", http.StatusUnauthorized},
+		{"missing token", "", http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPost, "/reload", nil)
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+			router.ServeHTTP(w, req)
+			assert.Equal(t, tt.want, w.Code)
+		})
+	}
+}
@@ -8,98 +8,168 @@ import (
 	"github.com/trustwallet/blockatlas/api/middleware"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 	"github.com/trustwallet/blockatlas/platform"
+	"github.com/trustwallet/blockatlas/services/status"
 	"time"
 )
 
+// platformHandler resolves the platform on every request, so the route follows platform.Reload
+func platformHandler(handle string, handler func(c *gin.Context, api blockatlas.Platform)) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		api := platform.GetPlatform(handle)
+		if api == nil {
+			endpoint.PlatformNotEnabled(c, handle)
+			return
+		}
+		handler(c, api)
+	}
+}
+
 func RegisterTransactionsAPI(router gin.IRouter, api blockatlas.Platform) {
 	handle := api.Coin().Handle
-	txUtxoAPI, ok := api.(blockatlas.TxUtxoAPI)
-	if ok {
-		router.GET("/v1/"+handle+"/address/:address", func(c *gin.Context) {
-			endpoint.GetTransactionsHistory(c, txUtxoAPI, nil)
+	if _, ok := api.(blockatlas.TxUtxoAPI); ok {
+		getTransactionsHistory := platformHandler(handle, func(c *gin.Context, api blockatlas.Platform) {
+			endpoint.GetTransactionsHistory(c, api.(blockatlas.TxUtxoAPI), nil)
 		})
-		router.GET("/v1/"+handle+"/xpub/:xpub", func(c *gin.Context) {
-			endpoint.GetTransactionsByXpub(c, txUtxoAPI)
-		})
-		router.GET("/v2/"+handle+"/transactions/xpub/:xpub", func(c *gin.Context) {
-			endpoint.GetTransactionsByXpub(c, txUtxoAPI)
+		getTransactionsByXpub := platformHandler(handle, func(c *gin.Context, api blockatlas.Platform) {
+			endpoint.GetTransactionsByXpub(c, api.(blockatlas.TxUtxoAPI))
 		})
+		router.GET("/v1/"+handle+"/address/:address", getTransactionsHistory)
+		router.GET("/v1/"+handle+"/xpub/:xpub", getTransactionsByXpub)
+		router.GET("/v2/"+handle+"/transactions/xpub/:xpub", getTransactionsByXpub)
 		return
 	}
-	txAPI, okTxApi := api.(blockatlas.TxAPI)
-	tokenTxAPI, okTokenTxApi := api.(blockatlas.TokenTxAPI)
+	_, okTxApi := api.(blockatlas.TxAPI)
+	_, okTokenTxApi := api.(blockatlas.TokenTxAPI)
 	if okTxApi || okTokenTxApi {
-		router.GET("/v1/"+handle+"/:address", func(c *gin.Context) {
-			endpoint.GetTransactionsHistory(c, txAPI, tokenTxAPI)
-		})
-		router.GET("/v2/"+handle+"/transactions/:address", func(c *gin.Context) {
+		getTransactionsHistory := platformHandler(handle, func(c *gin.Context, api blockatlas.Platform) {
+			txAPI, _ := api.(blockatlas.TxAPI)
+			tokenTxAPI, _ := api.(blockatlas.TokenTxAPI)
 			endpoint.GetTransactionsHistory(c, txAPI, tokenTxAPI)
 		})
+		router.GET("/v1/"+handle+"/:address", getTransactionsHistory)
+		router.GET("/v2/"+handle+"/transactions/:address", getTransactionsHistory)
 	}
 }
 
 func RegisterTokensAPI(router gin.IRouter, api blockatlas.Platform) {
-	tokenAPI, ok := api.(blockatlas.TokensAPI)
-	if !ok {
+	if _, ok := api.(blockatlas.TokensAPI); !ok {
 		return
 	}
-	handle := tokenAPI.Coin().Handle
-	router.GET("/v2/"+handle+"/tokens/:address", func(c *gin.Context) {
-		endpoint.GetTokensByAddress(c, tokenAPI)
-	})
+	handle := api.Coin().Handle
+	router.GET("/v2/"+handle+"/tokens/:address", platformHandler(handle, func(c *gin.Context, api blockatlas.Platform) {
+		endpoint.GetTokensByAddress(c, api.(blockatlas.TokensAPI))
+	}))
+}
+
+func RegisterApprovalsAPI(router gin.IRouter, api blockatlas.Platform) {
+	if _, ok := api.(blockatlas.ApprovalsAPI); !ok {
+		return
+	}
+	handle := api.Coin().Handle
+	router.GET("/v2/"+handle+"/approvals/:address", platformHandler(handle, func(c *gin.Context, api blockatlas.Platform) {
+		endpoint.GetApprovalsByAddress(c, api.(blockatlas.ApprovalsAPI))
+	}))
 }
 
 func RegisterStakeAPI(router gin.IRouter, api blockatlas.Platform) {
-	stakeAPI, ok := api.(blockatlas.StakeAPI)
-	if !ok {
+	if _, ok := api.(blockatlas.StakeAPI); !ok {
 		return
 	}
 	handle := api.Coin().Handle
-	router.GET("/v2/"+handle+"/staking/validators", middleware.CacheMiddleware(time.Hour, func(c *gin.Context) {
-		endpoint.GetValidators(c, stakeAPI)
+	router.GET("/v2/"+handle+"/staking/validators", middleware.CacheMiddleware(time.Hour, platformHandler(handle, func(c *gin.Context, api blockatlas.Platform) {
+		endpoint.GetValidators(c, api.(blockatlas.StakeAPI))
+	})))
+	router.GET("/v2/"+handle+"/staking/delegations/:address", platformHandler(handle, func(c *gin.Context, api blockatlas.Platform) {
+		endpoint.GetStakingDelegationsForSpecificCoin(c, api.(blockatlas.StakeAPI))
 	}))
-	router.GET("/v2/"+handle+"/staking/delegations/:address", func(c *gin.Context) {
-		endpoint.GetStakingDelegationsForSpecificCoin(c, stakeAPI)
-	})
+	if _, ok := api.(blockatlas.StakingRewardsAPI); ok {
+		router.GET("/v2/"+handle+"/staking/rewards/:address", platformHandler(handle, func(c *gin.Context, api blockatlas.Platform) {
+			endpoint.GetStakingRewards(c, api.(blockatlas.StakingRewardsAPI))
+		}))
+	}
+	if _, ok := api.(blockatlas.StakingEconomicsAPI); ok {
+		router.GET("/v2/"+handle+"/staking/apr", platformHandler(handle, func(c *gin.Context, api blockatlas.Platform) {
+			endpoint.GetStakingAPRHistory(c, api.(blockatlas.StakingEconomicsAPI))
+		}))
+	}
 }
 
 func RegisterCollectionsAPI(router gin.IRouter, api blockatlas.CollectionsAPI) {
+	coinID := api.Coin().ID
 	handle := api.Coin().Handle
-	router.GET("/v3/"+handle+"/collections/:owner/collection/:collection_id", func(c *gin.Context) {
-		endpoint.GetCollectiblesForSpecificCollectionAndOwnerV3(c, api)
-	})
-	router.GET("/v3/"+handle+"/collections/:owner", func(c *gin.Context) {
-		endpoint.GetCollectiblesForOwnerV3(c, api)
-	})
-	router.GET("/v4/"+handle+"/collections/:owner/collection/:collection_id", func(c *gin.Context) {
-		endpoint.GetCollectiblesForSpecificCollectionAndOwner(c, api)
-	})
+	collectionsHandler := func(handler func(c *gin.Context, api blockatlas.CollectionsAPI)) gin.HandlerFunc {
+		return func(c *gin.Context) {
+			api, ok := platform.GetCollectionsAPIs()[coinID]
+			if !ok {
+				endpoint.PlatformNotEnabled(c, handle)
+				return
+			}
+			handler(c, api)
+		}
+	}
+	router.GET("/v3/"+handle+"/collections/:owner/collection/:collection_id", collectionsHandler(endpoint.GetCollectiblesForSpecificCollectionAndOwnerV3))
+	router.GET("/v3/"+handle+"/collections/:owner", collectionsHandler(endpoint.GetCollectiblesForOwnerV3))
+	router.GET("/v4/"+handle+"/collections/:owner/collection/:collection_id", collectionsHandler(endpoint.GetCollectiblesForSpecificCollectionAndOwner))
 }
 
 func RegisterBatchAPI(router gin.IRouter) {
 	router.GET("/v3/staking/list", middleware.CacheMiddleware(time.Hour*10, func(c *gin.Context) {
-		endpoint.GetStakeInfoForCoins(c, platform.StakeAPIs)
+		endpoint.GetStakeInfoForCoins(c, platform.GetStakeAPIs())
 	}))
 	router.POST("/v2/staking/delegations", func(c *gin.Context) {
-		endpoint.GetStakeDelegationsWithAllInfoForBatch(c, platform.StakeAPIs)
+		endpoint.GetStakeDelegationsWithAllInfoForBatch(c, platform.GetStakeAPIs())
 	})
 	router.POST("/v2/staking/list", middleware.CacheMiddleware(time.Hour, func(c *gin.Context) {
-		endpoint.GetStakeInfoForBatch(c, platform.StakeAPIs)
+		endpoint.GetStakeInfoForBatch(c, platform.GetStakeAPIs())
 	}))
 	router.POST("/v3/collectibles/categories", func(c *gin.Context) {
-		endpoint.GetCollectionCategoriesFromListV3(c, platform.CollectionsAPIs)
+		endpoint.GetCollectionCategoriesFromListV3(c, platform.GetCollectionsAPIs())
 	})
 	router.POST("/v4/collectibles/categories", func(c *gin.Context) {
-		endpoint.GetCollectionCategoriesFromList(c, platform.CollectionsAPIs)
+		endpoint.GetCollectionCategoriesFromList(c, platform.GetCollectionsAPIs())
 	})
 	router.POST("/v2/tokens", func(c *gin.Context) {
-		endpoint.GetTokens(c, platform.TokensAPIs)
+		endpoint.GetTokens(c, platform.GetTokensAPIs())
 	})
 }
 
 func RegisterDomainAPI(router gin.IRouter) {
 	router.GET("/ns/lookup", endpoint.GetAddressByCoinAndDomain)
 	router.GET("/v2/ns/lookup", endpoint.GetAddressByCoinAndDomainBatch)
+	router.GET("/v2/ns/reverse", endpoint.GetNameByAddress)
+	router.POST("/v2/ns/reverse", endpoint.GetNamesByAddresses)
+	router.GET("/v2/ns/profile", endpoint.GetNameProfile)
+}
+
+func RegisterPlatformStatusAPI(router gin.IRouter, api blockatlas.Platform, checker *status.Checker) {
+	handle := api.Coin().Handle
+	router.GET("/v2/"+handle+"/status", platformHandler(handle, func(c *gin.Context, api blockatlas.Platform) {
+		endpoint.GetPlatformStatus(c, checker, api)
+	}))
+}
+
+func RegisterStatusAPI(router gin.IRouter, checker *status.Checker) {
+	router.GET("/v2/status", func(c *gin.Context) {
+		endpoint.GetPlatformsStatus(c, checker, platform.GetPlatforms())
+	})
+	router.GET("/v2/status/live", func(c *gin.Context) {
+		endpoint.GetLiveness(c, checker)
+	})
+	router.GET("/v2/status/ready", func(c *gin.Context) {
+		endpoint.GetReadiness(c, checker, platform.GetPlatforms())
+	})
+}
+
+func RegisterPlatformsAPI(router gin.IRouter, routes func() gin.RoutesInfo) {
+	router.GET("/v2/platforms", func(c *gin.Context) {
+		endpoint.GetPlatforms(c, platform.GetPlatforms(), routes())
+	})
+}
+
+func RegisterAdminAPI(router gin.IRouter, reload func() (platform.Changes, error)) {
+	router.POST("/reload", func(c *gin.Context) {
+		endpoint.ReloadPlatforms(c, reload)
+	})
 }
 
 func RegisterBasicAPI(router gin.IRouter) {
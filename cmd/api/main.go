@@ -4,20 +4,29 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/spf13/viper"
 	"github.com/trustwallet/blockatlas/api"
+	"github.com/trustwallet/blockatlas/config"
+	"github.com/trustwallet/blockatlas/db"
 	_ "github.com/trustwallet/blockatlas/docs"
 	"github.com/trustwallet/blockatlas/internal"
 	"github.com/trustwallet/blockatlas/pkg/logger"
 	"github.com/trustwallet/blockatlas/platform"
+	"github.com/trustwallet/blockatlas/services/assets"
+	"github.com/trustwallet/blockatlas/services/economics"
+	"github.com/trustwallet/blockatlas/services/status"
+	"github.com/trustwallet/blockatlas/services/tokens"
+	"time"
 )
 
 const (
 	defaultPort       = "8420"
 	defaultConfigPath = "../../config.yml"
+	prod              = "prod"
 )
 
 var (
 	port, confPath string
 	engine         *gin.Engine
+	checker        *status.Checker
 )
 
 func init() {
@@ -29,6 +38,67 @@ func init() {
 	engine = internal.InitEngine(viper.GetString("gin.mode"))
 
 	platform.Init(viper.GetStringSlice("platform"))
+
+	assets.Init(
+		viper.GetString("assets.validators.local_dir"),
+		viper.GetStringSlice("assets.validators.mirrors"),
+		viper.GetString("assets.validators.snapshot_dir"),
+	)
+
+	tokens.Enabled = viper.GetBool("tokens.enabled")
+	if viper.GetBool("tokens.on_chain") {
+		tokens.OnChain = platform.GetTokenInfoAPI
+	}
+
+	database := initDatabase()
+
+	if viper.GetBool("staking.economics.enabled") {
+		economics.HistorySize = viper.GetInt("staking.economics.history")
+		if database != nil {
+			economics.Database = database
+		}
+		go economics.Run(platform.GetStakeAPIs, viper.GetDuration("staking.economics.interval"))
+	}
+
+	var statusDatabase *db.Instance
+	if viper.GetBool("status.parser_lag") {
+		statusDatabase = database
+	}
+	checker = status.NewChecker(statusDatabase, viper.GetDuration("status.timeout"))
+	checker.MaxLag = viper.GetInt64("status.readiness.max_lag")
+	checker.RequireUpstreams = viper.GetBool("status.readiness.require_upstreams")
+
+	if viper.GetBool("reload.watch_config") {
+		config.WatchConfig(func() {
+			if _, err := platform.Reload(viper.GetStringSlice("platform")); err != nil {
+				logger.Error("Failed to reload platforms", err)
+			}
+		})
+	}
+}
+
+// reloadPlatforms is triggered by the admin API, routes resolve the platforms on every request
+func reloadPlatforms() (platform.Changes, error) {
+	if err := config.ReloadConfig(); err != nil {
+		return platform.Changes{}, err
+	}
+	return platform.Reload(viper.GetStringSlice("platform"))
+}
+
+// initDatabase connects to postgres to report the parser lag and to keep the staking economics history,
+// the API keeps running without it
+func initDatabase() *db.Instance {
+	if !viper.GetBool("status.parser_lag") && !viper.GetBool("staking.economics.enabled") {
+		return nil
+	}
+	pgUri := viper.GetString("postgres.uri")
+	database, err := db.New(pgUri, prod)
+	if err != nil {
+		logger.Warn("Parser lag is not reported and the staking economics are kept in memory, postgres is unavailable", err)
+		return nil
+	}
+	go db.RestoreConnectionWorker(database, time.Second*10, pgUri)
+	return database
 }
 
 func main() {
@@ -37,9 +107,15 @@ func main() {
 		api.SetupSwaggerAPI(engine)
 	case "platform":
 		api.SetupPlatformAPI(engine)
+		api.SetupStatusAPI(engine, checker)
+		api.SetupAdminAPI(engine, viper.GetString("reload.admin_token"), reloadPlatforms)
+		api.SetupPlatformsAPI(engine)
 	default:
 		api.SetupSwaggerAPI(engine)
 		api.SetupPlatformAPI(engine)
+		api.SetupStatusAPI(engine, checker)
+		api.SetupAdminAPI(engine, viper.GetString("reload.admin_token"), reloadPlatforms)
+		api.SetupPlatformsAPI(engine)
 	}
 	internal.SetupGracefulShutdown(port, engine)
 }
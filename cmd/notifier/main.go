@@ -7,7 +7,10 @@ import (
 	"github.com/trustwallet/blockatlas/internal"
 	"github.com/trustwallet/blockatlas/mq"
 	"github.com/trustwallet/blockatlas/pkg/logger"
+	"github.com/trustwallet/blockatlas/platform"
+	"github.com/trustwallet/blockatlas/services/assets"
 	"github.com/trustwallet/blockatlas/services/observer/notifier"
+	"github.com/trustwallet/blockatlas/services/tokens"
 	"time"
 )
 
@@ -56,6 +59,28 @@ func init() {
 
 	logger.Info("maxPushNotificationsBatchLimit ", logger.Params{"limit": maxPushNotificationsBatchLimit})
 
+	assets.Init(
+		viper.GetString("assets.validators.local_dir"),
+		viper.GetStringSlice("assets.validators.mirrors"),
+		viper.GetString("assets.validators.snapshot_dir"),
+	)
+
+	tokens.Enabled = viper.GetBool("tokens.enabled")
+	tokens.Async = true
+
+	watchStaking := viper.GetBool("staking.watcher.enabled")
+	resolveCollectibles := viper.GetBool("collectibles.notifications")
+	if watchStaking || resolveCollectibles {
+		platform.Init(viper.GetStringSlice("platform"))
+	}
+	if resolveCollectibles {
+		notifier.CollectibleTransfers = platform.GetCollectibleTransferAPI
+	}
+
+	if watchStaking {
+		go notifier.RunStakingWatcher(database, platform.GetStakeAPIs, viper.GetDuration("staking.watcher.interval"))
+	}
+
 	go mq.FatalWorker(time.Second * 10)
 	go db.RestoreConnectionWorker(database, time.Second*10, pgUri)
 
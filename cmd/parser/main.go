@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"github.com/spf13/viper"
+	"github.com/trustwallet/blockatlas/config"
 	"github.com/trustwallet/blockatlas/db"
 	"github.com/trustwallet/blockatlas/internal"
 	"github.com/trustwallet/blockatlas/mq"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 	"github.com/trustwallet/blockatlas/pkg/logger"
 	"github.com/trustwallet/blockatlas/platform"
 	"github.com/trustwallet/blockatlas/services/observer/parser"
@@ -22,12 +24,20 @@ const (
 	prod              = "prod"
 )
 
+type runningParser struct {
+	cancel  context.CancelFunc
+	stopped chan struct{}
+}
+
 var (
 	confPath                                                   string
 	backlogTime, minInterval, maxInterval, fetchBlocksInterval time.Duration
 	maxBackLogBlocks                                           int64
 	txsBatchLimit                                              uint
 	database                                                   *db.Instance
+
+	parsersMu sync.Mutex
+	parsers   = make(map[string]runningParser)
 )
 
 func init() {
@@ -47,7 +57,7 @@ func init() {
 		logger.Fatal(err)
 	}
 
-	if len(platform.BlockAPIs) == 0 {
+	if len(platform.GetBlockAPIs()) == 0 {
 		logger.Fatal("No APIs to observe")
 	}
 
@@ -75,77 +85,129 @@ func init() {
 
 func main() {
 	defer mq.Close()
-	var (
-		wg          sync.WaitGroup
-		coinCancel  = make(map[string]context.CancelFunc)
-		stopChannel = make(chan<- struct{}, len(platform.BlockAPIs))
-	)
-
-	wg.Add(len(platform.BlockAPIs))
-	for _, api := range platform.BlockAPIs {
-		time.Sleep(time.Millisecond * 5)
-		coin := api.Coin()
-		pollInterval := parser.GetInterval(coin.BlockTime, minInterval, maxInterval)
-
-		var backlogCount int
-		if coin.BlockTime == 0 {
-			backlogCount = 50
-			logger.Warn("Unknown block time", logger.Params{"coin": coin.Handle})
-		} else {
-			backlogCount = int(backlogTime / pollInterval)
-		}
-
-		// do not allow
-		if txsBatchLimit < parser.MinTxsBatchLimit {
-			txsBatchLimit = parser.MinTxsBatchLimit
-		}
-
-		ctx, cancel := context.WithCancel(context.Background())
-
-		coinCancel[coin.Handle] = cancel
-
-		params := parser.Params{
-			Ctx:                   ctx,
-			Api:                   api,
-			Queue:                 mq.RawTransactions,
-			ParsingBlocksInterval: pollInterval,
-			FetchBlocksTimeout:    fetchBlocksInterval,
-			BacklogCount:          backlogCount,
-			MaxBacklogBlocks:      maxBackLogBlocks,
-			StopChannel:           stopChannel,
-			TxBatchLimit:          txsBatchLimit,
-			Database:              database,
-		}
-
-		go parser.RunParser(params)
 
-		logger.Info("Parser params", logger.Params{
-			"interval":                 pollInterval,
-			"backlog":                  backlogCount,
-			"Max backlog":              maxBackLogBlocks,
-			"Txs Batch limit":          txsBatchLimit,
-			"Fetching blocks interval": fetchBlocksInterval,
-		})
+	// do not allow
+	if txsBatchLimit < parser.MinTxsBatchLimit {
+		txsBatchLimit = parser.MinTxsBatchLimit
+	}
 
-		wg.Done()
+	parsersMu.Lock()
+	for _, api := range platform.GetBlockAPIs() {
+		time.Sleep(time.Millisecond * 5)
+		startParser(api)
 	}
+	parsersMu.Unlock()
 
-	wg.Wait()
+	if viper.GetBool("reload.watch_config") {
+		config.WatchConfig(reloadParsers)
+	}
 
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 	logger.Info("Shutdown parser ...")
-	for coin, cancel := range coinCancel {
-		logger.Info(fmt.Sprintf("Starting to stop %s parser...", coin))
-		cancel()
+	parsersMu.Lock()
+	handles := make([]string, 0, len(parsers))
+	for coin := range parsers {
+		handles = append(handles, coin)
+	}
+	stopped := stopParsers(handles)
+	parsersMu.Unlock()
+	waitParsers(stopped)
+	logger.Info("All parsers are stopped")
+
+	logger.Info("Exiting gracefully")
+}
+
+// reloadParsers rebuilds the platform registry, then stops the parsers of removed coins,
+// restarts the ones with an updated config and starts the parsers of added coins.
+// A restarted parser continues from the last parsed block stored in postgres.
+func reloadParsers() {
+	changes, err := platform.Reload(viper.GetStringSlice("platform"))
+	if err != nil {
+		logger.Error("Failed to reload platforms", err)
+		return
 	}
-	for {
-		if len(stopChannel) == len(platform.BlockAPIs) {
-			logger.Info("All parsers are stopped")
-			break
+	blockAPIs := platform.GetBlockAPIs()
+
+	// All the parsers are stopped at once, the updated ones are started again once their old parser
+	// has finished so a coin is never parsed twice
+	parsersMu.Lock()
+	stopped := stopParsers(append(changes.Removed, changes.Updated...))
+	parsersMu.Unlock()
+	waitParsers(stopped)
+
+	parsersMu.Lock()
+	defer parsersMu.Unlock()
+	for _, handle := range append(changes.Added, changes.Updated...) {
+		if api, ok := blockAPIs[handle]; ok {
+			startParser(api)
 		}
 	}
+}
+
+// startParser runs the parser of the coin in background, parsersMu must be held
+func startParser(api blockatlas.BlockAPI) {
+	coin := api.Coin()
+	pollInterval := parser.GetInterval(coin.BlockTime, minInterval, maxInterval)
+
+	var backlogCount int
+	if coin.BlockTime == 0 {
+		backlogCount = 50
+		logger.Warn("Unknown block time", logger.Params{"coin": coin.Handle})
+	} else {
+		backlogCount = int(backlogTime / pollInterval)
+	}
 
-	logger.Info("Exiting gracefully")
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan struct{}, 1)
+
+	params := parser.Params{
+		Ctx:                   ctx,
+		Api:                   api,
+		Queue:                 mq.RawTransactions,
+		ParsingBlocksInterval: pollInterval,
+		FetchBlocksTimeout:    fetchBlocksInterval,
+		BacklogCount:          backlogCount,
+		MaxBacklogBlocks:      maxBackLogBlocks,
+		StopChannel:           stopped,
+		TxBatchLimit:          txsBatchLimit,
+		Database:              database,
+	}
+
+	go parser.RunParser(params)
+	parsers[coin.Handle] = runningParser{cancel: cancel, stopped: stopped}
+
+	logger.Info("Parser params", logger.Params{
+		"coin":                     coin.Handle,
+		"interval":                 pollInterval,
+		"backlog":                  backlogCount,
+		"Max backlog":              maxBackLogBlocks,
+		"Txs Batch limit":          txsBatchLimit,
+		"Fetching blocks interval": fetchBlocksInterval,
+	})
+}
+
+// stopParsers cancels the running parsers of the coins and removes them, parsersMu must be held.
+// The returned parsers are awaited with waitParsers once parsersMu is released
+func stopParsers(handles []string) []runningParser {
+	stopped := make([]runningParser, 0, len(handles))
+	for _, handle := range handles {
+		p, ok := parsers[handle]
+		if !ok {
+			continue
+		}
+		logger.Info(fmt.Sprintf("Starting to stop %s parser...", handle))
+		p.cancel()
+		delete(parsers, handle)
+		stopped = append(stopped, p)
+	}
+	return stopped
+}
+
+// waitParsers waits until the parsers have finished their current cycle
+func waitParsers(stopped []runningParser) {
+	for _, p := range stopped {
+		<-p.stopped
+	}
 }
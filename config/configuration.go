@@ -1,6 +1,7 @@
 package config
 
 import (
+	"github.com/fsnotify/fsnotify"
 	"github.com/spf13/viper"
 	"github.com/trustwallet/blockatlas/pkg/logger"
 	"strings"
@@ -37,3 +38,17 @@ func LoadConfig(confPath string) {
 		}
 	}
 }
+
+// ReloadConfig reads the config file again, environment variables keep precedence over it
+func ReloadConfig() error {
+	return viper.ReadInConfig()
+}
+
+// WatchConfig calls onChange every time the config file is written
+func WatchConfig(onChange func()) {
+	viper.OnConfigChange(func(e fsnotify.Event) {
+		logger.Info("Config file changed", logger.Params{"config_file": e.Name, "op": e.Op.String()})
+		onChange()
+	})
+	viper.WatchConfig()
+}
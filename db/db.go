@@ -31,6 +31,8 @@ func New(uri, env string) (*Instance, error) {
 	g.AutoMigrate(
 		&models.Subscription{},
 		&models.Tracker{},
+		&models.StakingSnapshot{},
+		&models.StakingEconomics{},
 	)
 
 	i := &Instance{Gorm: g}
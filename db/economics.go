@@ -0,0 +1,38 @@
+package db
+
+import (
+	"context"
+
+	"github.com/trustwallet/blockatlas/db/models"
+	"go.elastic.co/apm/module/apmgorm"
+)
+
+func (i *Instance) AddStakingEconomics(economics models.StakingEconomics, ctx context.Context) error {
+	g := apmgorm.WithContext(ctx, i.Gorm)
+	return g.
+		Set("gorm:insert_option", "ON CONFLICT (handle, date) DO NOTHING").
+		Create(&economics).Error
+}
+
+// GetStakingEconomics returns the last runs of the platform, newest first
+func (i *Instance) GetStakingEconomics(handle string, limit int, ctx context.Context) ([]models.StakingEconomics, error) {
+	g := apmgorm.WithContext(ctx, i.Gorm)
+	var economics []models.StakingEconomics
+	err := g.
+		Model(&models.StakingEconomics{}).
+		Where("handle = ?", handle).
+		Order("date desc").
+		Limit(limit).
+		Find(&economics).Error
+	if err != nil {
+		return nil, err
+	}
+	return economics, nil
+}
+
+func (i *Instance) DeleteStakingEconomicsBefore(handle string, date int64, ctx context.Context) error {
+	g := apmgorm.WithContext(ctx, i.Gorm)
+	return g.
+		Where("handle = ? and date < ?", handle, date).
+		Delete(&models.StakingEconomics{}).Error
+}
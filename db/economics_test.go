@@ -0,0 +1,60 @@
+package db
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/db/models"
+)
+
+func TestInstance_AddStakingEconomics(t *testing.T) {
+	db, mock := setupDB(t)
+	defer db.Close()
+	mock.ExpectBegin()
+	mock.ExpectQuery(
+		regexp.QuoteMeta(
+			`INSERT INTO "staking_economics" ("handle","date","annual","inflation","bonded_ratio","validators") VALUES ($1,$2,$3,$4,$5,$6) ON CONFLICT (handle, date) DO NOTHING RETURNING "staking_economics"."handle"`)).
+		WithArgs("cosmos", 1603000000, 9.5, 6.5, 0.68, `{"v1":8.55}`).
+		WillReturnRows(sqlmock.NewRows([]string{"handle"}).AddRow("cosmos"))
+	mock.ExpectCommit()
+	i := Instance{Gorm: db}
+
+	economics := models.StakingEconomics{Handle: "cosmos", Date: 1603000000, Annual: 9.5, Inflation: 6.5, BondedRatio: 0.68, Validators: `{"v1":8.55}`}
+	assert.Nil(t, i.AddStakingEconomics(economics, context.Background()))
+}
+
+func TestInstance_GetStakingEconomics(t *testing.T) {
+	db, mock := setupDB(t)
+	defer db.Close()
+	mock.ExpectQuery(
+		regexp.QuoteMeta(`SELECT * FROM "staking_economics"  WHERE (handle = $1) ORDER BY date desc LIMIT 2`)).
+		WithArgs("cosmos").
+		WillReturnRows(sqlmock.NewRows([]string{"handle", "date", "annual", "validators"}).
+			AddRow("cosmos", 1603003600, 9.6, `{}`).
+			AddRow("cosmos", 1603000000, 9.5, `{"v1":8.55}`))
+	i := Instance{Gorm: db}
+
+	economics, err := i.GetStakingEconomics("cosmos", 2, context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, []models.StakingEconomics{
+		{Handle: "cosmos", Date: 1603003600, Annual: 9.6, Validators: `{}`},
+		{Handle: "cosmos", Date: 1603000000, Annual: 9.5, Validators: `{"v1":8.55}`},
+	}, economics)
+}
+
+func TestInstance_DeleteStakingEconomicsBefore(t *testing.T) {
+	db, mock := setupDB(t)
+	defer db.Close()
+	mock.ExpectBegin()
+	mock.ExpectExec(
+		regexp.QuoteMeta(`DELETE FROM "staking_economics"  WHERE (handle = $1 and date < $2)`)).
+		WithArgs("cosmos", 1603000000).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectCommit()
+	i := Instance{Gorm: db}
+
+	assert.Nil(t, i.DeleteStakingEconomicsBefore("cosmos", 1603000000, context.Background()))
+}
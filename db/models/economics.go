@@ -0,0 +1,12 @@
+package models
+
+// StakingEconomics is a run of the staking economics job for a platform, Validators is the JSON map of the
+// yield of every validator
+type StakingEconomics struct {
+	Handle      string `gorm:"primary_key; column:handle; type:varchar(64)"`
+	Date        int64  `gorm:"primary_key; column:date; auto_increment:false"`
+	Annual      float64
+	Inflation   float64
+	BondedRatio float64
+	Validators  string `gorm:"type:text"`
+}
@@ -0,0 +1,11 @@
+package models
+
+import "time"
+
+// StakingSnapshot is the last staking state of a subscribed address seen by the staking watcher, State is JSON
+type StakingSnapshot struct {
+	UpdatedAt time.Time
+	Coin      uint   `gorm:"primary_key; column:coin; auto_increment:false"`
+	Address   string `gorm:"primary_key; column:address; type:varchar(128)"`
+	State     string `gorm:"type:text"`
+}
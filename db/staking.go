@@ -0,0 +1,41 @@
+package db
+
+import (
+	"context"
+
+	"github.com/trustwallet/blockatlas/db/models"
+	"go.elastic.co/apm/module/apmgorm"
+)
+
+func (i *Instance) GetSubscriptionsByCoin(coin uint, ctx context.Context) ([]models.Subscription, error) {
+	g := apmgorm.WithContext(ctx, i.Gorm)
+	var subscriptions []models.Subscription
+	err := g.
+		Model(&models.Subscription{}).
+		Where("coin = ?", coin).
+		Find(&subscriptions).Error
+	if err != nil {
+		return nil, err
+	}
+	return subscriptions, nil
+}
+
+func (i *Instance) GetStakingSnapshots(coin uint, ctx context.Context) ([]models.StakingSnapshot, error) {
+	g := apmgorm.WithContext(ctx, i.Gorm)
+	var snapshots []models.StakingSnapshot
+	err := g.
+		Model(&models.StakingSnapshot{}).
+		Where("coin = ?", coin).
+		Find(&snapshots).Error
+	if err != nil {
+		return nil, err
+	}
+	return snapshots, nil
+}
+
+func (i *Instance) SetStakingSnapshot(snapshot models.StakingSnapshot, ctx context.Context) error {
+	g := apmgorm.WithContext(ctx, i.Gorm)
+	return g.
+		Set("gorm:insert_option", "ON CONFLICT (coin, address) DO UPDATE SET state = excluded.state, updated_at = excluded.updated_at").
+		Create(&snapshot).Error
+}
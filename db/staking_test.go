@@ -0,0 +1,44 @@
+package db
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/db/models"
+)
+
+func TestInstance_SetStakingSnapshot(t *testing.T) {
+	db, mock := setupDB(t)
+	defer db.Close()
+	mock.ExpectBegin()
+	mock.ExpectQuery(
+		regexp.QuoteMeta(
+			`INSERT INTO "staking_snapshots" ("updated_at","coin","address","state") VALUES ($1,$2,$3,$4) ON CONFLICT (coin, address) DO UPDATE SET state = excluded.state, updated_at = excluded.updated_at RETURNING "staking_snapshots"."coin"`)).
+		WithArgs(sqlmock.AnyArg(), 118, "cosmos1dx27g0kzhwej0ekcf2k9hsktcxnmpl7fcehcvq", `{"date":1603000000}`).
+		WillReturnRows(sqlmock.NewRows([]string{"coin"}).AddRow(118))
+	mock.ExpectCommit()
+	i := Instance{Gorm: db}
+
+	snapshot := models.StakingSnapshot{Coin: 118, Address: "cosmos1dx27g0kzhwej0ekcf2k9hsktcxnmpl7fcehcvq", State: `{"date":1603000000}`}
+	assert.Nil(t, i.SetStakingSnapshot(snapshot, context.Background()))
+}
+
+func TestInstance_GetStakingSnapshots(t *testing.T) {
+	db, mock := setupDB(t)
+	defer db.Close()
+	mock.ExpectQuery(
+		regexp.QuoteMeta(`SELECT * FROM "staking_snapshots"  WHERE (coin = $1)`)).
+		WithArgs(118).
+		WillReturnRows(sqlmock.NewRows([]string{"coin", "address", "state"}).
+			AddRow(118, "cosmos1dx27g0kzhwej0ekcf2k9hsktcxnmpl7fcehcvq", `{"date":1603000000}`))
+	i := Instance{Gorm: db}
+
+	snapshots, err := i.GetStakingSnapshots(118, context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, []models.StakingSnapshot{
+		{Coin: 118, Address: "cosmos1dx27g0kzhwej0ekcf2k9hsktcxnmpl7fcehcvq", State: `{"date":1603000000}`},
+	}, snapshots)
+}
@@ -32,6 +32,8 @@ func (t *Tx) UnmarshalJSON(data []byte) error {
 		t.Meta = new(Transfer)
 	case TxMultiCurrencyTransfer:
 		t.Meta = new(MultiCurrencyTransfer)
+	case TxMultiTransfer:
+		t.Meta = new(MultiTransfer)
 	case TxNativeTokenTransfer:
 		t.Meta = new(NativeTokenTransfer)
 	case TxTokenTransfer:
@@ -64,6 +66,8 @@ func (t *Tx) MarshalJSON() ([]byte, error) {
 		t.Type = TxTransfer
 	case MultiCurrencyTransfer, *MultiCurrencyTransfer:
 		t.Type = TxMultiCurrencyTransfer
+	case MultiTransfer, *MultiTransfer:
+		t.Type = TxMultiTransfer
 	case NativeTokenTransfer, *NativeTokenTransfer:
 		t.Type = TxNativeTokenTransfer
 	case TokenTransfer, *TokenTransfer:
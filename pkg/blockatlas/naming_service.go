@@ -1,6 +1,59 @@
 package blockatlas
 
+const (
+	ProfileAvatar      ProfileField = "avatar"
+	ProfileURL         ProfileField = "url"
+	ProfileEmail       ProfileField = "email"
+	ProfileTwitter     ProfileField = "twitter"
+	ProfileDescription ProfileField = "description"
+	ProfileContentHash ProfileField = "content_hash"
+)
+
+// ProfileFields lists the fields of a NameProfile
+var ProfileFields = []ProfileField{
+	ProfileAvatar, ProfileURL, ProfileEmail, ProfileTwitter, ProfileDescription, ProfileContentHash,
+}
+
 type Resolved struct {
-	Result string `json:"result"`
-	Coin   uint64 `json:"coin"`
+	Result   string `json:"result"`
+	Coin     uint64 `json:"coin"`
+	Provider string `json:"provider,omitempty"`
+}
+
+type ReverseResolved struct {
+	Coin    uint64 `json:"coin"`
+	Address string `json:"address"`
+	Name    string `json:"name"`
+}
+
+type (
+	ProfileField string
+
+	// NameProfile contains the records of a name, ContentHash is an ipfs://, ipns:// or bzz:// URI
+	NameProfile struct {
+		Name        string `json:"name"`
+		Avatar      string `json:"avatar,omitempty"`
+		URL         string `json:"url,omitempty"`
+		Email       string `json:"email,omitempty"`
+		Twitter     string `json:"twitter,omitempty"`
+		Description string `json:"description,omitempty"`
+		ContentHash string `json:"content_hash,omitempty"`
+	}
+)
+
+func (p *NameProfile) Set(field ProfileField, value string) {
+	switch field {
+	case ProfileAvatar:
+		p.Avatar = value
+	case ProfileURL:
+		p.URL = value
+	case ProfileEmail:
+		p.Email = value
+	case ProfileTwitter:
+		p.Twitter = value
+	case ProfileDescription:
+		p.Description = value
+	case ProfileContentHash:
+		p.ContentHash = value
+	}
 }
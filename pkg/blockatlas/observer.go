@@ -17,11 +17,6 @@ type (
 		Address string `json:"address"`
 	}
 
-	CoinStatus struct {
-		Height int64  `json:"height"`
-		Error  string `json:"error,omitempty"`
-	}
-
 	Observer struct {
 		Status  bool   `json:"status"`
 		Message string `json:"message"`
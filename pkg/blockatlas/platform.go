@@ -41,6 +41,24 @@ type (
 		GetTokenListByAddress(address string) (TokenPage, error)
 	}
 
+	// TokenInfoAPI provides the metadata of a token read from the chain
+	TokenInfoAPI interface {
+		Platform
+		GetTokenInfo(tokenID string) (Token, error)
+	}
+
+	// CollectibleTransferAPI resolves the name and the image of the collectible transfers read from the chain
+	CollectibleTransferAPI interface {
+		Platform
+		FillCollectibleTransfers(txs []Tx)
+	}
+
+	// ApprovalsAPI provides the current non-zero token allowances of an address
+	ApprovalsAPI interface {
+		Platform
+		GetApprovals(address string) (ApprovalPage, error)
+	}
+
 	// StakingAPI provides staking information
 	StakeAPI interface {
 		Platform
@@ -51,6 +69,26 @@ type (
 		GetActiveValidators() (StakeValidators, error)
 	}
 
+	// RedelegationsAPI provides the redelegations of a delegator which are not completed yet
+	RedelegationsAPI interface {
+		Platform
+		GetRedelegations(address string) (RedelegationsPage, error)
+	}
+
+	// StakingRewardsAPI provides the claimable rewards of a delegator and the history of its rewards
+	StakingRewardsAPI interface {
+		Platform
+		GetPendingRewards(address string) (PendingRewards, error)
+		GetRewardsHistory(address string, page int) (RewardsPage, error)
+	}
+
+	// StakingEconomicsAPI computes the yield of the network and of its validators from the chain, it is
+	// requested periodically in the background as it takes several upstream calls
+	StakingEconomicsAPI interface {
+		Platform
+		GetEconomics() (StakingEconomics, error)
+	}
+
 	CollectionsAPI interface {
 		Platform
 		GetCollections(owner string) (CollectionPage, error)
@@ -65,6 +103,18 @@ type (
 		Lookup(coins []uint64, name string) ([]Resolved, error)
 	}
 
+	// ReverseNamingServiceAPI provides the primary name of an address, an empty name if none is set
+	ReverseNamingServiceAPI interface {
+		NamingServiceAPI
+		ReverseLookup(coin uint64, address string) (string, error)
+	}
+
+	// ProfileNamingServiceAPI provides the records of a name, an empty value if the record is not set
+	ProfileNamingServiceAPI interface {
+		NamingServiceAPI
+		Record(name string, field ProfileField) (string, error)
+	}
+
 	Platforms map[string]Platform
 
 	CollectionsAPIs map[uint]CollectionsAPI
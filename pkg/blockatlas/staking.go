@@ -1,6 +1,10 @@
 package blockatlas
 
-import "github.com/trustwallet/blockatlas/coin"
+import (
+	"math/big"
+
+	"github.com/trustwallet/blockatlas/coin"
+)
 
 const (
 	DelegationStatusActive  DelegationStatus = "active"
@@ -9,7 +13,12 @@ const (
 	DelegationTypeAuto     DelegationType = "auto"
 	DelegationTypeDelegate DelegationType = "delegate"
 
+	RewardTypePayout RewardType = "payout"
+	RewardTypeClaim  RewardType = "claim"
+
 	DefaultAnnualReward = 0
+
+	RewardsPerPage = 25
 )
 
 type (
@@ -18,9 +27,14 @@ type (
 	DelegationsBatchPage []DelegationResponse
 	StakingBatchPage     []StakingResponse
 	StakeValidators      []StakeValidator
+	RedelegationsPage    []Redelegation
+	PendingRewards       []PendingReward
+	RewardsPage          []Reward
+	APRHistory           []APRPoint
 
 	DelegationStatus string
 	DelegationType   string
+	RewardType       string
 
 	ValidatorMap map[string]StakeValidator
 
@@ -36,9 +50,24 @@ type (
 	}
 
 	Validator struct {
-		ID      string         `json:"id"`
-		Status  bool           `json:"status"`
-		Details StakingDetails `json:"details"`
+		ID      string            `json:"id"`
+		Status  bool              `json:"status"`
+		Details StakingDetails    `json:"details"`
+		Metrics *ValidatorMetrics `json:"metrics,omitempty"`
+	}
+
+	// ValidatorMetrics is the performance and the risk of a validator, as far as its chain exposes them.
+	// Commission, StakeShare and Uptime are in percents, VotingPower and SelfBond in the smallest unit of the coin.
+	// HasCommission is set when the commission is read from the chain, a zero is then a real 0%
+	ValidatorMetrics struct {
+		Commission    float64 `json:"commission"`
+		HasCommission bool    `json:"-"`
+		VotingPower   string  `json:"voting_power,omitempty"`
+		StakeShare    float64 `json:"stake_share,omitempty"`
+		Uptime        float64 `json:"uptime,omitempty"`
+		MissedBlocks  int64   `json:"missed_blocks,omitempty"`
+		Jailed        bool    `json:"jailed"`
+		SelfBond      string  `json:"self_bond,omitempty"`
 	}
 
 	Delegation struct {
@@ -48,8 +77,27 @@ type (
 		Metadata  interface{}      `json:"metadata,omitempty"`
 	}
 
+	// DelegationMetaDataPending is the metadata of the pending delegations, AvailableDate is
+	// the unix time the unbonding or the lock of the funds completes. Locked is set when the funds
+	// stay delegated once the lock completes, only an unbonding releases them at AvailableDate
 	DelegationMetaDataPending struct {
 		AvailableDate uint `json:"available_date"`
+		Locked        bool `json:"locked,omitempty"`
+	}
+
+	// Redelegation moves a delegation between validators, the funds stay staked but can't be moved again until CompletionDate
+	Redelegation struct {
+		Source         StakeValidator `json:"source"`
+		Destination    StakeValidator `json:"destination"`
+		Value          string         `json:"value"`
+		CompletionDate uint           `json:"completion_date"`
+	}
+
+	// LockedSummary sums the pending delegations, Until is the date the last of them completes
+	LockedSummary struct {
+		Value       string `json:"value"`
+		NextRelease uint   `json:"next_release"`
+		Until       uint   `json:"until"`
 	}
 
 	StakeValidatorInfo struct {
@@ -64,15 +112,64 @@ type (
 		Status  bool               `json:"status"`
 		Info    StakeValidatorInfo `json:"info,omitempty"`
 		Details StakingDetails     `json:"details,omitempty"`
+		Metrics *ValidatorMetrics  `json:"metrics,omitempty"`
 	}
 
 	DelegationResponse struct {
-		Delegations DelegationsPage `json:"delegations"`
-		Balance     string          `json:"balance"`
-		Address     string          `json:"address"`
+		Delegations   DelegationsPage   `json:"delegations"`
+		Balance       string            `json:"balance"`
+		Address       string            `json:"address"`
+		Rewards       PendingRewards    `json:"rewards,omitempty"`
+		Redelegations RedelegationsPage `json:"redelegations,omitempty"`
+		Locked        *LockedSummary    `json:"locked,omitempty"`
 		StakingResponse
 	}
 
+	// PendingReward is the reward earned with a validator and not claimed yet
+	PendingReward struct {
+		Validator string `json:"validator,omitempty"`
+		Value     string `json:"value"`
+	}
+
+	// Reward is a reward paid to the delegator, automatically (payout) or on request (claim).
+	// ID is the hash of the transaction, or the epoch/cycle of protocol payouts
+	Reward struct {
+		ID        string     `json:"id"`
+		Validator string     `json:"validator,omitempty"`
+		Value     string     `json:"value"`
+		Type      RewardType `json:"type"`
+		Date      int64      `json:"date"`
+	}
+
+	RewardsResponse struct {
+		Address      string             `json:"address"`
+		Coin         *coin.ExternalCoin `json:"coin"`
+		PendingTotal string             `json:"pending_total"`
+		Pending      PendingRewards     `json:"pending"`
+		History      RewardsPage        `json:"history"`
+	}
+
+	// StakingEconomics is the yield of a network, Annual is the yield of the staked supply before the commissions
+	// and Validators the yield of each validator after its on-chain commission, all in percents
+	StakingEconomics struct {
+		Annual      float64            `json:"annual"`
+		Inflation   float64            `json:"inflation,omitempty"`
+		BondedRatio float64            `json:"bonded_ratio,omitempty"`
+		Validators  map[string]float64 `json:"validators,omitempty"`
+	}
+
+	APRPoint struct {
+		Date   int64   `json:"date"`
+		Annual float64 `json:"annual"`
+	}
+
+	APRHistoryResponse struct {
+		Coin      *coin.ExternalCoin `json:"coin"`
+		Validator string             `json:"validator,omitempty"`
+		Annual    float64            `json:"annual"`
+		History   APRHistory         `json:"history"`
+	}
+
 	StakingResponse struct {
 		Coin    *coin.ExternalCoin `json:"coin"`
 		Details StakingDetails     `json:"details"`
@@ -86,3 +183,73 @@ func (sv StakeValidators) ToMap() ValidatorMap {
 	}
 	return validators
 }
+
+// SetStakeShares computes the share of the voting power of the validators with metrics, out of their total
+func (vp ValidatorPage) SetStakeShares() {
+	total := new(big.Float)
+	for _, v := range vp {
+		if v.Metrics == nil {
+			continue
+		}
+		if power, ok := new(big.Float).SetString(v.Metrics.VotingPower); ok {
+			total.Add(total, power)
+		}
+	}
+	if total.Sign() == 0 {
+		return
+	}
+	for _, v := range vp {
+		if v.Metrics == nil {
+			continue
+		}
+		power, ok := new(big.Float).SetString(v.Metrics.VotingPower)
+		if !ok {
+			continue
+		}
+		v.Metrics.StakeShare, _ = new(big.Float).Quo(power.Mul(power, big.NewFloat(100)), total).Float64()
+	}
+}
+
+// Total returns the sum of the pending rewards, values are in the smallest unit of the coin
+func (pr PendingRewards) Total() string {
+	total := new(big.Int)
+	for _, r := range pr {
+		if v, ok := new(big.Int).SetString(r.Value, 10); ok {
+			total.Add(total, v)
+		}
+	}
+	return total.String()
+}
+
+// Locked returns the summary of the pending delegations with an available date, nil if there is none
+func (dp DelegationsPage) Locked() *LockedSummary {
+	var summary *LockedSummary
+	total := new(big.Int)
+	for _, d := range dp {
+		if d.Status != DelegationStatusPending {
+			continue
+		}
+		meta, ok := d.Metadata.(DelegationMetaDataPending)
+		if !ok {
+			continue
+		}
+		value, ok := new(big.Int).SetString(d.Value, 10)
+		if !ok {
+			continue
+		}
+		total.Add(total, value)
+		if summary == nil {
+			summary = &LockedSummary{NextRelease: meta.AvailableDate, Until: meta.AvailableDate}
+		}
+		if meta.AvailableDate < summary.NextRelease {
+			summary.NextRelease = meta.AvailableDate
+		}
+		if meta.AvailableDate > summary.Until {
+			summary.Until = meta.AvailableDate
+		}
+	}
+	if summary != nil {
+		summary.Value = total.String()
+	}
+	return summary
+}
@@ -23,3 +23,69 @@ func TestStakeValidators_ToMap(t *testing.T) {
 		})
 	}
 }
+
+func TestPendingRewards_Total(t *testing.T) {
+	tests := []struct {
+		name string
+		pr   PendingRewards
+		want string
+	}{
+		{"test no rewards", PendingRewards{}, "0"},
+		{"test rewards", PendingRewards{{Validator: "a", Value: "100"}, {Validator: "b", Value: "23"}}, "123"},
+		{"test invalid value", PendingRewards{{Value: "100"}, {Value: "1.5"}}, "100"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.pr.Total(); got != tt.want {
+				t.Errorf("Total() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDelegationsPage_Locked(t *testing.T) {
+	tests := []struct {
+		name string
+		dp   DelegationsPage
+		want *LockedSummary
+	}{
+		{"test no delegations", DelegationsPage{}, nil},
+		{"test active delegations", DelegationsPage{{Value: "100", Status: DelegationStatusActive}}, nil},
+		{
+			"test pending delegations",
+			DelegationsPage{
+				{Value: "100", Status: DelegationStatusActive},
+				{Value: "20", Status: DelegationStatusPending, Metadata: DelegationMetaDataPending{AvailableDate: 1600000000}},
+				{Value: "30", Status: DelegationStatusPending, Metadata: DelegationMetaDataPending{AvailableDate: 1500000000}},
+				{Value: "40", Status: DelegationStatusPending},
+			},
+			&LockedSummary{Value: "50", NextRelease: 1500000000, Until: 1600000000},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.dp.Locked(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Locked() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidatorPage_SetStakeShares(t *testing.T) {
+	page := ValidatorPage{
+		{ID: "v1", Metrics: &ValidatorMetrics{VotingPower: "300"}},
+		{ID: "v2", Metrics: &ValidatorMetrics{VotingPower: "100"}},
+		{ID: "v3"},
+		{ID: "v4", Metrics: &ValidatorMetrics{}},
+	}
+	page.SetStakeShares()
+	want := []float64{75, 25}
+	for i, share := range want {
+		if page[i].Metrics.StakeShare != share {
+			t.Errorf("SetStakeShares() %s = %v, want %v", page[i].ID, page[i].Metrics.StakeShare, share)
+		}
+	}
+	if page[3].Metrics.StakeShare != 0 {
+		t.Errorf("SetStakeShares() v4 = %v, want 0", page[3].Metrics.StakeShare)
+	}
+}
@@ -0,0 +1,59 @@
+package blockatlas
+
+const (
+	HealthAvailable   HealthStatus = "available"
+	HealthUnavailable HealthStatus = "unavailable"
+	HealthUnknown     HealthStatus = "unknown"
+)
+
+type (
+	HealthStatus string
+
+	// CoinStatus describes the chain head reported by the upstream API
+	CoinStatus struct {
+		Height int64  `json:"height"`
+		Error  string `json:"error,omitempty"`
+	}
+
+	// ParserStatus describes how far the block parser is behind the chain head
+	ParserStatus struct {
+		Height int64  `json:"height"`
+		Lag    int64  `json:"lag"`
+		Error  string `json:"error,omitempty"`
+	}
+
+	// PlatformCapabilities lists the services enabled for a platform
+	PlatformCapabilities struct {
+		Transactions bool `json:"transactions"`
+		Xpub         bool `json:"xpub"`
+		Tokens       bool `json:"tokens"`
+		Staking      bool `json:"staking"`
+		Collections  bool `json:"collections"`
+		Naming       bool `json:"naming"`
+	}
+
+	// PlatformStatus describes the health of a platform and its upstream API
+	PlatformStatus struct {
+		Coin         uint                 `json:"coin"`
+		Handle       string               `json:"handle"`
+		Status       HealthStatus         `json:"status"`
+		Latency      int64                `json:"latency_ms"`
+		Chain        CoinStatus           `json:"chain"`
+		Parser       *ParserStatus        `json:"parser,omitempty"`
+		Capabilities PlatformCapabilities `json:"capabilities"`
+	}
+
+	// PlatformInfo describes an enabled platform, its coin and the services it supports
+	PlatformInfo struct {
+		Coin          uint                 `json:"coin"`
+		Handle        string               `json:"handle"`
+		Symbol        string               `json:"symbol"`
+		Name          string               `json:"name"`
+		Decimals      uint                 `json:"decimals"`
+		BlockTime     int                  `json:"block_time"`
+		SampleAddress string               `json:"sample_address"`
+		Capabilities  PlatformCapabilities `json:"capabilities"`
+		Interfaces    []string             `json:"interfaces"`
+		Endpoints     []string             `json:"endpoints"`
+	}
+)
@@ -16,17 +16,22 @@ const (
 	DirectionIncoming Direction = "incoming"
 	DirectionSelf     Direction = "yourself"
 
-	TokenTypeERC20 TokenType = "ERC20"
-	TokenTypeBEP2  TokenType = "BEP2"
-	TokenTypeBEP8  TokenType = "BEP8"
-	TokenTypeTRC10 TokenType = "TRC10"
-	TokenTypeETC20 TokenType = "ETC20"
-	TokenTypePOA20 TokenType = "POA20"
-	TokenTypeTRC20 TokenType = "TRC20"
-	TokenTypeCLO20 TokenType = "CLO20"
-	TokenTypeGO20  TokenType = "G020"
-	TokenTypeWAN20 TokenType = "WAN20"
-	TokenTypeTT20  TokenType = "TT20"
+	TokenTypeERC20   TokenType = "ERC20"
+	TokenTypeERC721  TokenType = "ERC721"
+	TokenTypeERC1155 TokenType = "ERC1155"
+	TokenTypeBEP2    TokenType = "BEP2"
+	TokenTypeBEP8    TokenType = "BEP8"
+	TokenTypeTRC10   TokenType = "TRC10"
+	TokenTypeETC20   TokenType = "ETC20"
+	TokenTypePOA20   TokenType = "POA20"
+	TokenTypeTRC20   TokenType = "TRC20"
+	TokenTypeCLO20   TokenType = "CLO20"
+	TokenTypeGO20    TokenType = "G020"
+	TokenTypeWAN20   TokenType = "WAN20"
+	TokenTypeTT20    TokenType = "TT20"
+
+	TokenStatusVerified TokenStatus = "verified"
+	TokenStatusSpam     TokenStatus = "spam"
 
 	TxTransfer              TransactionType = "transfer"
 	TxNativeTokenTransfer   TransactionType = "native_token_transfer"
@@ -36,6 +41,7 @@ const (
 	TxContractCall          TransactionType = "contract_call"
 	TxAnyAction             TransactionType = "any_action"
 	TxMultiCurrencyTransfer TransactionType = "multi_currency_transfer"
+	TxMultiTransfer         TransactionType = "multi_transfer"
 
 	KeyPlaceOrder        KeyType = "place_order"
 	KeyCancelOrder       KeyType = "cancel_order"
@@ -43,14 +49,33 @@ const (
 	KeyBurnToken         KeyType = "burn_token"
 	KeyMintToken         KeyType = "mint_token"
 	KeyApproveToken      KeyType = "approve_token"
+	KeyFreezeToken       KeyType = "freeze_token"
+	KeyUnfreezeToken     KeyType = "unfreeze_token"
+	KeyTimeLock          KeyType = "time_lock"
+	KeyTimeUnlock        KeyType = "time_unlock"
+	KeyTimeRelock        KeyType = "time_relock"
 	KeyStakeDelegate     KeyType = "stake_delegate"
+	KeyStakeUndelegate   KeyType = "stake_undelegate"
+	KeyStakeRedelegate   KeyType = "stake_redelegate"
 	KeyStakeClaimRewards KeyType = "stake_claim_rewards"
+	KeyStakeWithdraw     KeyType = "stake_withdraw"
 
 	KeyTitlePlaceOrder    KeyTitle = "Place Order"
 	KeyTitleCancelOrder   KeyTitle = "Cancel Order"
+	KeyTitleIssueToken    KeyTitle = "Issue Token"
+	KeyTitleBurnToken     KeyTitle = "Burn Token"
+	KeyTitleMintToken     KeyTitle = "Mint Token"
+	KeyTitleFreezeToken   KeyTitle = "Freeze Token"
+	KeyTitleUnfreezeToken KeyTitle = "Unfreeze Token"
+	KeyTitleTimeLock      KeyTitle = "Time Lock"
+	KeyTitleTimeUnlock    KeyTitle = "Time Unlock"
+	KeyTitleTimeRelock    KeyTitle = "Time Relock"
+	KeyTitleApproveToken  KeyTitle = "Approve Token"
 	AnyActionDelegation   KeyTitle = "Delegation"
 	AnyActionUndelegation KeyTitle = "Undelegation"
+	AnyActionRedelegation KeyTitle = "Redelegation"
 	AnyActionClaimRewards KeyTitle = "Claim Rewards"
+	AnyActionWithdraw     KeyTitle = "Withdraw"
 
 	// TxPerPage says how many transactions to return per page
 	TxPerPage = 25
@@ -61,6 +86,7 @@ type (
 	Direction       string
 	Status          string
 	TokenType       string
+	TokenStatus     string
 	TransactionType string
 	KeyType         string
 	KeyTitle        string
@@ -150,11 +176,16 @@ type (
 	}
 
 	// CollectibleTransfer describes the transfer of a
-	// "collectible", unique token.
+	// "collectible", unique token. Value is 1 unless
+	// the standard allows several copies, e.g. ERC-1155
 	CollectibleTransfer struct {
 		Name     string `json:"name"`
 		Contract string `json:"contract"`
 		ImageURL string `json:"image_url"`
+		TokenID  string `json:"token_id"`
+		Value    Amount `json:"value"`
+		From     string `json:"from"`
+		To       string `json:"to"`
 	}
 
 	// TokenSwap describes the exchange of two different tokens
@@ -181,6 +212,26 @@ type (
 		Fees       []Currency `json:"fees"`
 	}
 
+	// MultiTransfer describes all value movements of a single transaction,
+	// e.g. internal value transfers and token transfer logs of a contract call
+	MultiTransfer struct {
+		Transfers []SubTransfer `json:"transfers"`
+	}
+
+	// SubTransfer describes one value movement inside a MultiTransfer.
+	// Type is one of TxTransfer, TxTokenTransfer or TxCollectibleTransfer
+	SubTransfer struct {
+		Type          TransactionType `json:"type"`
+		Name          string          `json:"name"`
+		Symbol        string          `json:"symbol"`
+		TokenID       string          `json:"token_id"`
+		CollectibleID string          `json:"collectible_id,omitempty"`
+		Decimals      uint            `json:"decimals"`
+		Value         Amount          `json:"value"`
+		From          string          `json:"from"`
+		To            string          `json:"to"`
+	}
+
 	// AnyAction describes all other types
 	AnyAction struct {
 		Coin     uint     `json:"coin"`
@@ -191,6 +242,7 @@ type (
 		Symbol   string   `json:"symbol"`
 		Decimals uint     `json:"decimals"`
 		Value    Amount   `json:"value"`
+		Spender  string   `json:"spender,omitempty"`
 	}
 
 	// TokenPage is a page of transactions.
@@ -199,12 +251,25 @@ type (
 	// Token describes the non-native tokens.
 	// Examples: ERC-20, TRC-20, BEP-2
 	Token struct {
-		Name     string    `json:"name"`
-		Symbol   string    `json:"symbol"`
-		Decimals uint      `json:"decimals"`
-		TokenID  string    `json:"token_id"`
-		Coin     uint      `json:"coin"`
-		Type     TokenType `json:"type"`
+		Name     string      `json:"name"`
+		Symbol   string      `json:"symbol"`
+		Decimals uint        `json:"decimals"`
+		TokenID  string      `json:"token_id"`
+		Coin     uint        `json:"coin"`
+		Type     TokenType   `json:"type"`
+		Logo     string      `json:"logo,omitempty"`
+		Status   TokenStatus `json:"status,omitempty"`
+	}
+
+	// ApprovalPage is a page of token allowances
+	ApprovalPage []Approval
+
+	// Approval describes the amount of a token the spender is allowed to transfer from the owner
+	Approval struct {
+		Coin    uint   `json:"coin"`
+		TokenID string `json:"token_id"`
+		Spender string `json:"spender"`
+		Value   Amount `json:"value"`
 	}
 
 	Txs []Tx
@@ -244,8 +309,12 @@ func (t *Tx) GetUtxoAddresses() (addresses []string) {
 func (t *Tx) GetAddresses() []string {
 	addresses := make([]string, 0)
 	switch t.Meta.(type) {
-	case Transfer, *Transfer, CollectibleTransfer, *CollectibleTransfer, ContractCall, *ContractCall, AnyAction, *AnyAction, MultiCurrencyTransfer, *MultiCurrencyTransfer:
+	case Transfer, *Transfer, ContractCall, *ContractCall, AnyAction, *AnyAction, MultiCurrencyTransfer, *MultiCurrencyTransfer:
 		return append(addresses, t.From, t.To)
+	case CollectibleTransfer:
+		return append(addresses, t.Meta.(CollectibleTransfer).From, t.Meta.(CollectibleTransfer).To)
+	case *CollectibleTransfer:
+		return append(addresses, t.Meta.(*CollectibleTransfer).From, t.Meta.(*CollectibleTransfer).To)
 	case NativeTokenTransfer:
 		return append(addresses, t.Meta.(NativeTokenTransfer).From, t.Meta.(NativeTokenTransfer).To)
 	case *NativeTokenTransfer:
@@ -254,6 +323,10 @@ func (t *Tx) GetAddresses() []string {
 		return append(addresses, t.Meta.(TokenTransfer).From, t.Meta.(TokenTransfer).To)
 	case *TokenTransfer:
 		return append(addresses, t.Meta.(*TokenTransfer).From, t.Meta.(*TokenTransfer).To)
+	case MultiTransfer:
+		return append(addresses, t.Meta.(MultiTransfer).addresses(t.From, t.To)...)
+	case *MultiTransfer:
+		return append(addresses, t.Meta.(*MultiTransfer).addresses(t.From, t.To)...)
 	case TokenSwap:
 		{
 			m := t.Meta.(TokenSwap)
@@ -286,11 +359,58 @@ func (t *Tx) GetTransactionDirection(address string) Direction {
 		return determineTransactionDirection(address, meta.From, meta.To)
 	case NativeTokenTransfer:
 		return determineTransactionDirection(address, meta.From, meta.To)
+	case *CollectibleTransfer:
+		return determineTransactionDirection(address, meta.From, meta.To)
+	case CollectibleTransfer:
+		return determineTransactionDirection(address, meta.From, meta.To)
+	case *MultiTransfer:
+		return meta.GetDirection(address, t.From, t.To)
+	case MultiTransfer:
+		return meta.GetDirection(address, t.From, t.To)
 	default:
 		return determineTransactionDirection(address, t.From, t.To)
 	}
 }
 
+func (m MultiTransfer) addresses(from, to string) []string {
+	addresses := make([]string, 0, 2+len(m.Transfers)*2)
+	addresses = append(addresses, from, to)
+	for _, transfer := range m.Transfers {
+		addresses = append(addresses, transfer.From, transfer.To)
+	}
+	return addresses
+}
+
+// GetDirection returns the direction of the transaction sent from `from` to `to` for the address.
+// The address sends if it signed the transaction or any transfer comes from it, it receives otherwise.
+func (m MultiTransfer) GetDirection(address, from, to string) Direction {
+	if address == from {
+		if address != to {
+			return DirectionOutgoing
+		}
+		for _, transfer := range m.Transfers {
+			if transfer.To != address {
+				return DirectionOutgoing
+			}
+		}
+		return DirectionSelf
+	}
+	for _, transfer := range m.Transfers {
+		if transfer.From == address {
+			return DirectionOutgoing
+		}
+	}
+	if address == to {
+		return DirectionIncoming
+	}
+	for _, transfer := range m.Transfers {
+		if transfer.To == address {
+			return DirectionIncoming
+		}
+	}
+	return DirectionOutgoing
+}
+
 func determineTransactionDirection(address, from, to string) Direction {
 	if address == to {
 		if from == to {
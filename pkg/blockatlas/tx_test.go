@@ -521,3 +521,72 @@ func TestTxs_SortByDate(t *testing.T) {
 	})
 	assert.True(t, isSorted)
 }
+
+func TestTx_GetAddresses_MultiTransfer(t *testing.T) {
+	tx := Tx{
+		From: "0x7d8bf18C7cE84b3E175b339c4Ca93aEd1dD166F1",
+		To:   "0xc73e0383F3Aff3215E6f04B0331D58CeCf0Ab849",
+		Meta: MultiTransfer{Transfers: []SubTransfer{
+			{Type: TxTransfer, From: "0xc73e0383F3Aff3215E6f04B0331D58CeCf0Ab849", To: "0xad37fd42185Ba63009177058208dd1be4b136e6b"},
+		}},
+	}
+	assert.Equal(t, []string{
+		"0x7d8bf18C7cE84b3E175b339c4Ca93aEd1dD166F1",
+		"0xc73e0383F3Aff3215E6f04B0331D58CeCf0Ab849",
+		"0xc73e0383F3Aff3215E6f04B0331D58CeCf0Ab849",
+		"0xad37fd42185Ba63009177058208dd1be4b136e6b",
+	}, tx.GetAddresses())
+}
+
+func TestMultiTransfer_GetDirection(t *testing.T) {
+	const (
+		sender   = "0x7d8bf18C7cE84b3E175b339c4Ca93aEd1dD166F1"
+		contract = "0xc73e0383F3Aff3215E6f04B0331D58CeCf0Ab849"
+		receiver = "0xad37fd42185Ba63009177058208dd1be4b136e6b"
+	)
+	transfers := MultiTransfer{Transfers: []SubTransfer{
+		{Type: TxTokenTransfer, From: sender, To: contract},
+		{Type: TxTransfer, From: contract, To: receiver},
+	}}
+	tests := []struct {
+		name    string
+		to      string
+		meta    MultiTransfer
+		address string
+		want    Direction
+	}{
+		{"Signer", contract, transfers, sender, DirectionOutgoing},
+		{"Internal sender", contract, transfers, contract, DirectionOutgoing},
+		{"Internal receiver", contract, transfers, receiver, DirectionIncoming},
+		{"Not involved", contract, transfers, "0x0000000000000000000000000000000000000000", DirectionOutgoing},
+		{"Self", sender, MultiTransfer{Transfers: []SubTransfer{{From: sender, To: sender}}}, sender, DirectionSelf},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := Tx{From: sender, To: tt.to, Meta: tt.meta}
+			assert.Equal(t, tt.want, tx.GetTransactionDirection(tt.address))
+		})
+	}
+}
+
+func TestTx_CollectibleTransfer(t *testing.T) {
+	const (
+		marketplace = "0x7Be8076f4EA4A4AD08075C2508e481d6C946D12b"
+		seller      = "0x7d8bf18C7cE84b3E175b339c4Ca93aEd1dD166F1"
+		buyer       = "0xad37fd42185Ba63009177058208dd1be4b136e6b"
+	)
+	tx := Tx{
+		From: buyer,
+		To:   marketplace,
+		Meta: CollectibleTransfer{
+			Contract: "0x06012c8cf97BEaD5deAe237070F9587f8E7A266d",
+			TokenID:  "1",
+			Value:    "1",
+			From:     seller,
+			To:       buyer,
+		},
+	}
+	assert.Equal(t, []string{seller, buyer}, tx.GetAddresses())
+	assert.Equal(t, DirectionOutgoing, tx.GetTransactionDirection(seller))
+	assert.Equal(t, DirectionIncoming, tx.GetTransactionDirection(buyer))
+}
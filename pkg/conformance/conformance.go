@@ -0,0 +1,286 @@
+package conformance
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+)
+
+const (
+	RuleID        Rule = "id"
+	RuleCoin      Rule = "coin"
+	RuleType      Rule = "type"
+	RuleAmount    Rule = "amount"
+	RuleStatus    Rule = "status"
+	RuleDirection Rule = "direction"
+	RuleAddresses Rule = "addresses"
+	RuleJSON      Rule = "json"
+)
+
+var matchAmount = regexp.MustCompile(`^\d+$`)
+
+type (
+	Rule string
+
+	// Violation describes a transaction which breaks the contract every platform must satisfy
+	Violation struct {
+		TxID    string
+		Rule    Rule
+		Message string
+	}
+
+	Violations []Violation
+)
+
+func (v Violation) String() string {
+	return fmt.Sprintf("tx %q: %s: %s", v.TxID, v.Rule, v.Message)
+}
+
+func (vs Violations) Error() string {
+	var buf bytes.Buffer
+	for i, v := range vs {
+		if i > 0 {
+			buf.WriteString("\n")
+		}
+		buf.WriteString(v.String())
+	}
+	return buf.String()
+}
+
+// CheckResult checks the transactions returned by a TxAPI or BlockAPI call, other results are ignored
+func CheckResult(p blockatlas.Platform, result interface{}) Violations {
+	switch r := result.(type) {
+	case blockatlas.TxPage:
+		return CheckTxs(p, r)
+	case []blockatlas.Tx:
+		return CheckTxs(p, r)
+	case *blockatlas.Block:
+		if r == nil {
+			return nil
+		}
+		return CheckTxs(p, r.Txs)
+	default:
+		return nil
+	}
+}
+
+func CheckTxs(p blockatlas.Platform, txs []blockatlas.Tx) Violations {
+	violations := make(Violations, 0)
+	for _, tx := range txs {
+		violations = append(violations, CheckTx(p, tx)...)
+	}
+	return violations
+}
+
+// CheckTx validates a normalized transaction emitted by the platform
+func CheckTx(p blockatlas.Platform, tx blockatlas.Tx) Violations {
+	c := checker{tx: tx}
+	if tx.ID == "" {
+		c.fail(RuleID, "empty id")
+	}
+	if tx.Coin != p.Coin().ID {
+		c.fail(RuleCoin, fmt.Sprintf("coin %d does not match platform coin %d", tx.Coin, p.Coin().ID))
+	}
+	c.checkType()
+	c.checkAmounts()
+	c.checkStatus()
+	c.checkDirection()
+	c.checkAddresses()
+	c.checkJSON()
+	return c.violations
+}
+
+type checker struct {
+	tx         blockatlas.Tx
+	violations Violations
+}
+
+func (c *checker) fail(rule Rule, message string) {
+	c.violations = append(c.violations, Violation{TxID: c.tx.ID, Rule: rule, Message: message})
+}
+
+func (c *checker) checkType() {
+	expected, ok := MetaType(c.tx.Meta)
+	if !ok {
+		c.fail(RuleType, fmt.Sprintf("unsupported metadata %T", c.tx.Meta))
+		return
+	}
+	// An empty type is derived from the metadata by Tx.MarshalJSON
+	if c.tx.Type != "" && c.tx.Type != expected {
+		c.fail(RuleType, fmt.Sprintf("type %q does not match metadata %T", c.tx.Type, c.tx.Meta))
+	}
+}
+
+func (c *checker) checkAmount(field string, amount blockatlas.Amount) {
+	if !matchAmount.MatchString(string(amount)) {
+		c.fail(RuleAmount, fmt.Sprintf("%s %q is not a positive integer", field, amount))
+	}
+}
+
+func (c *checker) checkAmounts() {
+	c.checkAmount("fee", c.tx.Fee)
+	for _, in := range c.tx.Inputs {
+		c.checkAmount("input value", in.Value)
+	}
+	for _, out := range c.tx.Outputs {
+		c.checkAmount("output value", out.Value)
+	}
+	for _, a := range metaAmounts(c.tx.Meta) {
+		c.checkAmount(a.field, a.amount)
+	}
+}
+
+func (c *checker) checkStatus() {
+	// An empty status defaults to completed in Tx.MarshalJSON
+	switch c.tx.Status {
+	case "", blockatlas.StatusCompleted, blockatlas.StatusPending, blockatlas.StatusError:
+	default:
+		c.fail(RuleStatus, fmt.Sprintf("invalid status %q", c.tx.Status))
+	}
+}
+
+func (c *checker) checkDirection() {
+	switch c.tx.Direction {
+	case "", blockatlas.DirectionOutgoing, blockatlas.DirectionIncoming, blockatlas.DirectionSelf:
+	default:
+		c.fail(RuleDirection, fmt.Sprintf("invalid direction %q", c.tx.Direction))
+	}
+}
+
+func (c *checker) checkAddresses() {
+	addresses := c.tx.GetAddresses()
+	if len(c.tx.Inputs) > 0 || len(c.tx.Outputs) > 0 {
+		addresses = append(addresses, c.tx.GetUtxoAddresses()...)
+	}
+	for _, a := range addresses {
+		if a != "" {
+			return
+		}
+	}
+	c.fail(RuleAddresses, "GetAddresses does not return any participant")
+}
+
+func (c *checker) checkJSON() {
+	tx := c.tx
+	first, err := tx.MarshalJSON()
+	if err != nil {
+		c.fail(RuleJSON, "marshal: "+err.Error())
+		return
+	}
+	var decoded blockatlas.Tx
+	if err := json.Unmarshal(first, &decoded); err != nil {
+		c.fail(RuleJSON, "unmarshal: "+err.Error())
+		return
+	}
+	second, err := decoded.MarshalJSON()
+	if err != nil {
+		c.fail(RuleJSON, "marshal decoded: "+err.Error())
+		return
+	}
+	if !bytes.Equal(first, second) {
+		c.fail(RuleJSON, fmt.Sprintf("round trip mismatch: %s != %s", first, second))
+	}
+}
+
+// MetaType returns the transaction type matching the metadata
+func MetaType(meta interface{}) (blockatlas.TransactionType, bool) {
+	switch meta.(type) {
+	case blockatlas.Transfer, *blockatlas.Transfer:
+		return blockatlas.TxTransfer, true
+	case blockatlas.MultiCurrencyTransfer, *blockatlas.MultiCurrencyTransfer:
+		return blockatlas.TxMultiCurrencyTransfer, true
+	case blockatlas.MultiTransfer, *blockatlas.MultiTransfer:
+		return blockatlas.TxMultiTransfer, true
+	case blockatlas.NativeTokenTransfer, *blockatlas.NativeTokenTransfer:
+		return blockatlas.TxNativeTokenTransfer, true
+	case blockatlas.TokenTransfer, *blockatlas.TokenTransfer:
+		return blockatlas.TxTokenTransfer, true
+	case blockatlas.CollectibleTransfer, *blockatlas.CollectibleTransfer:
+		return blockatlas.TxCollectibleTransfer, true
+	case blockatlas.TokenSwap, *blockatlas.TokenSwap:
+		return blockatlas.TxTokenSwap, true
+	case blockatlas.ContractCall, *blockatlas.ContractCall:
+		return blockatlas.TxContractCall, true
+	case blockatlas.AnyAction, *blockatlas.AnyAction:
+		return blockatlas.TxAnyAction, true
+	default:
+		return "", false
+	}
+}
+
+type namedAmount struct {
+	field  string
+	amount blockatlas.Amount
+}
+
+func metaAmounts(meta interface{}) []namedAmount {
+	switch m := meta.(type) {
+	case blockatlas.Transfer:
+		return []namedAmount{{"value", m.Value}}
+	case *blockatlas.Transfer:
+		return []namedAmount{{"value", m.Value}}
+	case blockatlas.NativeTokenTransfer:
+		return []namedAmount{{"value", m.Value}}
+	case *blockatlas.NativeTokenTransfer:
+		return []namedAmount{{"value", m.Value}}
+	case blockatlas.TokenTransfer:
+		return []namedAmount{{"value", m.Value}}
+	case *blockatlas.TokenTransfer:
+		return []namedAmount{{"value", m.Value}}
+	case blockatlas.CollectibleTransfer:
+		return []namedAmount{{"value", m.Value}}
+	case *blockatlas.CollectibleTransfer:
+		return []namedAmount{{"value", m.Value}}
+	case blockatlas.AnyAction:
+		return []namedAmount{{"value", m.Value}}
+	case *blockatlas.AnyAction:
+		return []namedAmount{{"value", m.Value}}
+	case blockatlas.ContractCall:
+		return []namedAmount{{"value", blockatlas.Amount(m.Value)}}
+	case *blockatlas.ContractCall:
+		return []namedAmount{{"value", blockatlas.Amount(m.Value)}}
+	case blockatlas.TokenSwap:
+		return swapAmounts(m)
+	case *blockatlas.TokenSwap:
+		return swapAmounts(*m)
+	case blockatlas.MultiCurrencyTransfer:
+		return currencyAmounts(m)
+	case *blockatlas.MultiCurrencyTransfer:
+		return currencyAmounts(*m)
+	case blockatlas.MultiTransfer:
+		return transferAmounts(m)
+	case *blockatlas.MultiTransfer:
+		return transferAmounts(*m)
+	default:
+		return nil
+	}
+}
+
+func swapAmounts(m blockatlas.TokenSwap) []namedAmount {
+	return []namedAmount{
+		{"input value", m.Input.Value},
+		{"output value", m.Output.Value},
+	}
+}
+
+func currencyAmounts(m blockatlas.MultiCurrencyTransfer) []namedAmount {
+	amounts := make([]namedAmount, 0, len(m.Currencies)+len(m.Fees))
+	for i, c := range m.Currencies {
+		amounts = append(amounts, namedAmount{fmt.Sprintf("currency %d value", i), c.Value})
+	}
+	for i, c := range m.Fees {
+		amounts = append(amounts, namedAmount{fmt.Sprintf("fee %d value", i), c.Value})
+	}
+	return amounts
+}
+
+func transferAmounts(m blockatlas.MultiTransfer) []namedAmount {
+	amounts := make([]namedAmount, 0, len(m.Transfers))
+	for i, t := range m.Transfers {
+		amounts = append(amounts, namedAmount{fmt.Sprintf("transfer %d value", i), t.Value})
+	}
+	return amounts
+}
@@ -0,0 +1,86 @@
+package conformance
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/coin"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+)
+
+type testPlatform struct{}
+
+func (testPlatform) Coin() coin.Coin {
+	return coin.Coins[coin.ATOM]
+}
+
+func validTx() blockatlas.Tx {
+	return blockatlas.Tx{
+		ID:     "E19B011D20D862DA0BEA7F24E3BC6DFF666EE6E044FCD9BD95B073478086DBB6",
+		Coin:   coin.ATOM,
+		From:   "cosmos1rw62phusuv9vzraezr55k0vsqssvz6ed52zyrl",
+		To:     "cosmos1nynns8ex9fq6sjjfj8k79ymkdz4sqth06xexae",
+		Fee:    "5000",
+		Date:   1556992677,
+		Block:  151980,
+		Status: blockatlas.StatusCompleted,
+		Type:   blockatlas.TxTransfer,
+		Meta: blockatlas.Transfer{
+			Value:    "2271999999",
+			Symbol:   "ATOM",
+			Decimals: 6,
+		},
+	}
+}
+
+func rules(vs Violations) []Rule {
+	result := make([]Rule, 0)
+	for _, v := range vs {
+		result = append(result, v.Rule)
+	}
+	return result
+}
+
+func TestCheckTx(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(tx *blockatlas.Tx)
+		want   []Rule
+	}{
+		{"valid", func(tx *blockatlas.Tx) {}, []Rule{}},
+		{"empty id", func(tx *blockatlas.Tx) { tx.ID = "" }, []Rule{RuleID}},
+		{"wrong coin", func(tx *blockatlas.Tx) { tx.Coin = coin.ETH }, []Rule{RuleCoin}},
+		{"type mismatch", func(tx *blockatlas.Tx) { tx.Type = blockatlas.TxTokenTransfer }, []Rule{RuleType}},
+		{"decimal fee", func(tx *blockatlas.Tx) { tx.Fee = "0.1" }, []Rule{RuleAmount, RuleJSON}},
+		{"empty value", func(tx *blockatlas.Tx) {
+			tx.Meta = blockatlas.Transfer{Value: "", Symbol: "ATOM", Decimals: 6}
+		}, []Rule{RuleAmount, RuleJSON}},
+		{"empty type", func(tx *blockatlas.Tx) { tx.Type = "" }, []Rule{}},
+		{"empty status", func(tx *blockatlas.Tx) { tx.Status = "" }, []Rule{}},
+		{"invalid status", func(tx *blockatlas.Tx) { tx.Status = "done" }, []Rule{RuleStatus}},
+		{"invalid direction", func(tx *blockatlas.Tx) { tx.Direction = "sideways" }, []Rule{RuleDirection}},
+		{"no participants", func(tx *blockatlas.Tx) { tx.From, tx.To = "", "" }, []Rule{RuleAddresses}},
+		{"token transfer without participants", func(tx *blockatlas.Tx) {
+			tx.Type = blockatlas.TxTokenTransfer
+			tx.Meta = blockatlas.TokenTransfer{Value: "1", TokenID: "token"}
+		}, []Rule{RuleAddresses}},
+		{"missing metadata", func(tx *blockatlas.Tx) { tx.Meta = nil }, []Rule{RuleType, RuleAddresses, RuleJSON}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := validTx()
+			tt.modify(&tx)
+			got := CheckTx(testPlatform{}, tx)
+			assert.Equal(t, tt.want, rules(got), got.Error())
+		})
+	}
+}
+
+func TestCheckResult(t *testing.T) {
+	invalid := validTx()
+	invalid.ID = ""
+
+	assert.Len(t, CheckResult(testPlatform{}, blockatlas.TxPage{validTx(), invalid}), 1)
+	assert.Len(t, CheckResult(testPlatform{}, &blockatlas.Block{Txs: []blockatlas.Tx{invalid}}), 1)
+	assert.Len(t, CheckResult(testPlatform{}, "0"), 0)
+}
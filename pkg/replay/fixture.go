@@ -0,0 +1,137 @@
+package replay
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+const (
+	maxNameLength = 80
+
+	bodyTypeJSON = "json"
+	bodyTypeText = "text"
+)
+
+var unsafeChars = regexp.MustCompile(`[^a-zA-Z0-9.\-]+`)
+
+type (
+	// Fixture is a single recorded upstream exchange
+	Fixture struct {
+		Request  FixtureRequest  `json:"request"`
+		Response FixtureResponse `json:"response"`
+	}
+
+	FixtureRequest struct {
+		Method string `json:"method"`
+		URL    string `json:"url"`
+		Body   string `json:"body,omitempty"`
+	}
+
+	FixtureResponse struct {
+		Status   int             `json:"status"`
+		BodyType string          `json:"body_type"`
+		Body     json.RawMessage `json:"body"`
+	}
+)
+
+// NormalizeURL sorts query parameters so that the same request always maps to the same fixture
+func NormalizeURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	u.RawQuery = u.Query().Encode()
+	u.ForceQuery = false
+	return u.String()
+}
+
+// NormalizeBody compacts JSON request bodies, other bodies are returned untouched
+func NormalizeBody(body []byte) string {
+	body = bytes.TrimSpace(body)
+	if len(body) == 0 {
+		return ""
+	}
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, body); err != nil {
+		return string(body)
+	}
+	return buf.String()
+}
+
+// FileName returns the fixture file name of a request
+func FileName(method, rawURL, body string) string {
+	normalized := NormalizeURL(rawURL)
+	hash := sha1.Sum([]byte(method + " " + normalized + "\n" + body))
+
+	name := normalized
+	if u, err := url.Parse(normalized); err == nil {
+		name = u.Host + u.Path
+	}
+	name = strings.Trim(unsafeChars.ReplaceAllString(name, "_"), "_")
+	if len(name) > maxNameLength {
+		name = name[:maxNameLength]
+	}
+	return fmt.Sprintf("%s_%s_%s.json", strings.ToLower(method), name, hex.EncodeToString(hash[:])[:10])
+}
+
+func newFixture(method, rawURL, reqBody string, status int, resBody []byte) Fixture {
+	f := Fixture{
+		Request: FixtureRequest{
+			Method: method,
+			URL:    NormalizeURL(rawURL),
+			Body:   reqBody,
+		},
+		Response: FixtureResponse{Status: status},
+	}
+	if json.Valid(resBody) {
+		f.Response.BodyType = bodyTypeJSON
+		f.Response.Body = resBody
+		return f
+	}
+	text, _ := json.Marshal(string(resBody))
+	f.Response.BodyType = bodyTypeText
+	f.Response.Body = text
+	return f
+}
+
+// ResponseBody returns the raw upstream response body
+func (f *Fixture) ResponseBody() ([]byte, error) {
+	if f.Response.BodyType != bodyTypeText {
+		return f.Response.Body, nil
+	}
+	var text string
+	if err := json.Unmarshal(f.Response.Body, &text); err != nil {
+		return nil, err
+	}
+	return []byte(text), nil
+}
+
+func readFixture(path string) (Fixture, error) {
+	var f Fixture
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return f, err
+	}
+	err = json.Unmarshal(b, &f)
+	return f, err
+}
+
+func writeFixture(path string, f Fixture) error {
+	b, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, append(b, '\n'), 0644)
+}
@@ -0,0 +1,193 @@
+package replay
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/errors"
+)
+
+const (
+	SuiteFile   = "suite.json"
+	FixturesDir = "fixtures"
+	GoldenDir   = "golden"
+
+	MethodTxsByAddress       = "GetTxsByAddress"
+	MethodCurrentBlockNumber = "CurrentBlockNumber"
+	MethodBlockByNumber      = "GetBlockByNumber"
+	MethodTokenList          = "GetTokenListByAddress"
+	MethodValidators         = "GetValidators"
+	MethodActiveValidators   = "GetActiveValidators"
+	MethodDelegations        = "GetDelegations"
+	MethodUndelegatedBalance = "UndelegatedBalance"
+	MethodStakingDetails     = "GetDetails"
+)
+
+type (
+	// Suite describes the golden cases of one platform, stored in <dir>/suite.json
+	Suite struct {
+		Platform string `json:"platform"`
+		// Config holds the viper values the platform is initialized with, e.g. "cosmos.api"
+		Config map[string]string `json:"config"`
+		// IgnoreQuery lists the query parameters which change on every run, see Transport.IgnoreQuery
+		IgnoreQuery []string `json:"ignore_query,omitempty"`
+		Cases       []Case   `json:"cases"`
+
+		Dir string `json:"-"`
+	}
+
+	// Case is a single platform call whose normalized output is compared with <dir>/golden/<name>.json
+	Case struct {
+		Name    string `json:"name"`
+		Method  string `json:"method"`
+		Address string `json:"address,omitempty"`
+		Block   int64  `json:"block,omitempty"`
+		// Unordered is set when the platform merges concurrent upstream responses, the order
+		// of the returned list or block transactions then changes between runs
+		Unordered bool `json:"unordered,omitempty"`
+	}
+)
+
+// LoadSuites reads every suite stored in the sub directories of root
+func LoadSuites(root string) ([]Suite, error) {
+	dirs, err := ioutil.ReadDir(root)
+	if err != nil {
+		return nil, err
+	}
+	suites := make([]Suite, 0, len(dirs))
+	for _, d := range dirs {
+		if !d.IsDir() {
+			continue
+		}
+		suite, err := LoadSuite(filepath.Join(root, d.Name()))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		suites = append(suites, suite)
+	}
+	return suites, nil
+}
+
+func LoadSuite(dir string) (Suite, error) {
+	var suite Suite
+	b, err := ioutil.ReadFile(filepath.Join(dir, SuiteFile))
+	if err != nil {
+		return suite, err
+	}
+	if err := json.Unmarshal(b, &suite); err != nil {
+		return suite, errors.E(err, "replay: invalid suite", errors.Params{"dir": dir})
+	}
+	suite.Dir = dir
+	return suite, nil
+}
+
+func (s Suite) FixturesPath() string {
+	return filepath.Join(s.Dir, FixturesDir)
+}
+
+func (s Suite) GoldenPath(c Case) string {
+	return filepath.Join(s.Dir, GoldenDir, c.Name+".json")
+}
+
+// Run calls the API method described by the case on the platform
+func Run(p blockatlas.Platform, c Case) (interface{}, error) {
+	switch c.Method {
+	case MethodTxsByAddress:
+		api, ok := p.(blockatlas.TxAPI)
+		if !ok {
+			return nil, notImplemented(p, c)
+		}
+		return api.GetTxsByAddress(c.Address)
+	case MethodCurrentBlockNumber:
+		api, ok := p.(blockatlas.BlockAPI)
+		if !ok {
+			return nil, notImplemented(p, c)
+		}
+		return api.CurrentBlockNumber()
+	case MethodBlockByNumber:
+		api, ok := p.(blockatlas.BlockAPI)
+		if !ok {
+			return nil, notImplemented(p, c)
+		}
+		return api.GetBlockByNumber(c.Block)
+	case MethodTokenList:
+		api, ok := p.(blockatlas.TokensAPI)
+		if !ok {
+			return nil, notImplemented(p, c)
+		}
+		return api.GetTokenListByAddress(c.Address)
+	}
+
+	api, ok := p.(blockatlas.StakeAPI)
+	if !ok {
+		return nil, notImplemented(p, c)
+	}
+	switch c.Method {
+	case MethodValidators:
+		return api.GetValidators()
+	case MethodActiveValidators:
+		return api.GetActiveValidators()
+	case MethodDelegations:
+		return api.GetDelegations(c.Address)
+	case MethodUndelegatedBalance:
+		return api.UndelegatedBalance(c.Address)
+	case MethodStakingDetails:
+		return api.GetDetails(), nil
+	default:
+		return nil, errors.E("replay: unknown method", errors.Params{"method": c.Method, "case": c.Name})
+	}
+}
+
+// Normalize renders a result as indented JSON. The top level list and the block transactions
+// keep the order of the platform, unless the case is unordered and they are sorted.
+func Normalize(result interface{}, c Case) ([]byte, error) {
+	if block, ok := result.(*blockatlas.Block); ok && block != nil && c.Unordered {
+		b := *block
+		b.Txs = sortTxs(b.Txs)
+		result = b
+	}
+	raw, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+	var list []json.RawMessage
+	if err := json.Unmarshal(raw, &list); err == nil && c.Unordered {
+		sort.Slice(list, func(i, j int) bool {
+			return bytes.Compare(list[i], list[j]) < 0
+		})
+		if raw, err = json.Marshal(list); err != nil {
+			return nil, err
+		}
+	}
+	var out bytes.Buffer
+	if err := json.Indent(&out, raw, "", "  "); err != nil {
+		return nil, err
+	}
+	out.WriteByte('\n')
+	return out.Bytes(), nil
+}
+
+func sortTxs(txs []blockatlas.Tx) []blockatlas.Tx {
+	sorted := make([]blockatlas.Tx, len(txs))
+	copy(sorted, txs)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].ID < sorted[j].ID
+	})
+	return sorted
+}
+
+func notImplemented(p blockatlas.Platform, c Case) error {
+	return errors.E("replay: platform does not implement method", errors.Params{
+		"platform": p.Coin().Handle,
+		"method":   c.Method,
+		"case":     c.Name,
+	})
+}
@@ -0,0 +1,106 @@
+package replay
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+// The ids of the JSON-RPC requests come from a global counter, they depend on the calls made before.
+// The fixtures store the position of the request in its batch instead, starting at 1, so a request
+// always maps to the same fixture and the replayed responses get the ids of the current requests.
+
+// positionRpcIDs replaces the ids of a JSON-RPC request or batch by their positions, the ids are
+// returned in the order of the requests. Other bodies are returned untouched.
+func positionRpcIDs(body string) (string, []json.RawMessage) {
+	requests, batch := decodeRpc([]byte(body))
+	if requests == nil {
+		return body, nil
+	}
+	ids := make([]json.RawMessage, 0, len(requests))
+	for i, r := range requests {
+		id, ok := r["id"]
+		if !ok {
+			continue
+		}
+		ids = append(ids, id)
+		r["id"] = json.RawMessage(strconv.Itoa(i + 1))
+	}
+	if len(ids) == 0 {
+		return body, nil
+	}
+	return encodeRpc(requests, batch, []byte(body)), ids
+}
+
+// replaceRpcIDs maps the ids of a JSON-RPC response or batch with the given function
+func replaceRpcIDs(body []byte, replace func(id json.RawMessage) json.RawMessage) []byte {
+	responses, batch := decodeRpc(body)
+	if responses == nil {
+		return body
+	}
+	for _, r := range responses {
+		if id, ok := r["id"]; ok {
+			r["id"] = replace(id)
+		}
+	}
+	return []byte(encodeRpc(responses, batch, body))
+}
+
+// positionsOf returns the position of a recorded id in the requests, the id is kept when unknown
+func positionsOf(ids []json.RawMessage) func(json.RawMessage) json.RawMessage {
+	return func(id json.RawMessage) json.RawMessage {
+		for i, requestID := range ids {
+			if string(requestID) == string(id) {
+				return json.RawMessage(strconv.Itoa(i + 1))
+			}
+		}
+		return id
+	}
+}
+
+// idsAt returns the id of the current request at a recorded position, the position is kept when unknown
+func idsAt(ids []json.RawMessage) func(json.RawMessage) json.RawMessage {
+	return func(id json.RawMessage) json.RawMessage {
+		position, err := strconv.Atoi(string(id))
+		if err != nil || position < 1 || position > len(ids) {
+			return id
+		}
+		return ids[position-1]
+	}
+}
+
+// decodeRpc returns the objects of a JSON-RPC message or batch, nil for other bodies
+func decodeRpc(body []byte) ([]map[string]json.RawMessage, bool) {
+	var single map[string]json.RawMessage
+	if err := json.Unmarshal(body, &single); err == nil {
+		if _, ok := single["jsonrpc"]; ok {
+			return []map[string]json.RawMessage{single}, false
+		}
+		return nil, false
+	}
+	var batch []map[string]json.RawMessage
+	if err := json.Unmarshal(body, &batch); err != nil || len(batch) == 0 {
+		return nil, false
+	}
+	for _, r := range batch {
+		if _, ok := r["jsonrpc"]; !ok {
+			return nil, false
+		}
+	}
+	return batch, true
+}
+
+func encodeRpc(messages []map[string]json.RawMessage, batch bool, fallback []byte) string {
+	var (
+		raw []byte
+		err error
+	)
+	if batch {
+		raw, err = json.Marshal(messages)
+	} else {
+		raw, err = json.Marshal(messages[0])
+	}
+	if err != nil {
+		return string(fallback)
+	}
+	return string(raw)
+}
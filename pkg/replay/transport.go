@@ -0,0 +1,145 @@
+package replay
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/trustwallet/blockatlas/pkg/errors"
+)
+
+const (
+	// ModeReplay serves upstream responses from fixture files only
+	ModeReplay Mode = "replay"
+	// ModeRecord forwards requests upstream and stores every exchange as a fixture file
+	ModeRecord Mode = "record"
+)
+
+type (
+	Mode string
+
+	// Transport is an http.RoundTripper that records upstream exchanges to fixture files,
+	// or replays them deterministically. Plug it into blockatlas.Request.HttpClient.
+	Transport struct {
+		Mode     Mode
+		Dir      string
+		Upstream http.RoundTripper
+		// IgnoreQuery lists the query parameters left out of the fixture names,
+		// e.g. the start of a time window computed from the current time
+		IgnoreQuery []string
+
+		mu sync.Mutex
+	}
+)
+
+// NewTransport creates a transport storing fixtures in dir
+func NewTransport(mode Mode, dir string) *Transport {
+	return &Transport{
+		Mode:     mode,
+		Dir:      dir,
+		Upstream: http.DefaultTransport,
+	}
+}
+
+// Client returns an http.Client using the transport
+func (t *Transport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var reqBody []byte
+	if req.Body != nil {
+		b, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		_ = req.Body.Close()
+		reqBody = b
+		req.Body = ioutil.NopCloser(bytes.NewReader(b))
+	}
+	body, rpcIDs := positionRpcIDs(NormalizeBody(reqBody))
+	rawURL := t.fixtureURL(req.URL)
+	path := filepath.Join(t.Dir, FileName(req.Method, rawURL, body))
+
+	if t.Mode == ModeRecord {
+		return t.record(req, rawURL, body, rpcIDs, path)
+	}
+	return t.replay(req, rpcIDs, path)
+}
+
+func (t *Transport) fixtureURL(u *url.URL) string {
+	if len(t.IgnoreQuery) == 0 {
+		return u.String()
+	}
+	stripped := *u
+	query := stripped.Query()
+	for _, key := range t.IgnoreQuery {
+		query.Del(key)
+	}
+	stripped.RawQuery = query.Encode()
+	return stripped.String()
+}
+
+func (t *Transport) record(req *http.Request, rawURL, reqBody string, rpcIDs []json.RawMessage, path string) (*http.Response, error) {
+	res, err := t.Upstream.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	resBody, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	t.mu.Lock()
+	stored := resBody
+	if rpcIDs != nil {
+		stored = replaceRpcIDs(resBody, positionsOf(rpcIDs))
+	}
+	err = writeFixture(path, newFixture(req.Method, rawURL, reqBody, res.StatusCode, stored))
+	t.mu.Unlock()
+	if err != nil {
+		return nil, errors.E(err, "replay: unable to write fixture", errors.Params{"file": path})
+	}
+
+	res.Body = ioutil.NopCloser(bytes.NewReader(resBody))
+	res.ContentLength = int64(len(resBody))
+	return res, nil
+}
+
+func (t *Transport) replay(req *http.Request, rpcIDs []json.RawMessage, path string) (*http.Response, error) {
+	f, err := readFixture(path)
+	if os.IsNotExist(err) {
+		return nil, errors.E("replay: no fixture recorded for request", errors.Params{
+			"method": req.Method,
+			"url":    req.URL.String(),
+			"file":   path,
+		})
+	}
+	if err != nil {
+		return nil, errors.E(err, "replay: unable to read fixture", errors.Params{"file": path})
+	}
+	resBody, err := f.ResponseBody()
+	if err != nil {
+		return nil, errors.E(err, "replay: invalid fixture body", errors.Params{"file": path})
+	}
+	if rpcIDs != nil {
+		resBody = replaceRpcIDs(resBody, idsAt(rpcIDs))
+	}
+	return &http.Response{
+		Status:        http.StatusText(f.Response.Status),
+		StatusCode:    f.Response.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": {"application/json"}},
+		Body:          ioutil.NopCloser(bytes.NewReader(resBody)),
+		ContentLength: int64(len(resBody)),
+		Request:       req,
+	}, nil
+}
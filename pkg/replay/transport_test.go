@@ -0,0 +1,168 @@
+package replay
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+)
+
+type testResult struct {
+	Path  string `json:"path"`
+	Query string `json:"query"`
+	Body  string `json:"body"`
+}
+
+func TestTransport_RecordAndReplay(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		body, _ := ioutil.ReadAll(r.Body)
+		_, _ = fmt.Fprintf(w, `{"path":%q,"query":%q,"body":%q}`, r.URL.Path, r.URL.RawQuery, string(body))
+	}))
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "replay")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	recorder := blockatlas.InitClient(server.URL)
+	recorder.HttpClient = NewTransport(ModeRecord, dir).Client()
+
+	var recorded testResult
+	assert.Nil(t, recorder.Get(&recorded, "txs", map[string][]string{"b": {"2"}, "a": {"1"}}))
+	assert.Equal(t, testResult{Path: "/txs", Query: "a=1&b=2"}, recorded)
+
+	var posted testResult
+	assert.Nil(t, recorder.Post(&posted, "rpc", map[string]string{"method": "test"}))
+	assert.Equal(t, 2, calls)
+
+	files, err := ioutil.ReadDir(dir)
+	assert.Nil(t, err)
+	assert.Len(t, files, 2)
+
+	server.Close()
+	player := blockatlas.InitClient(server.URL)
+	player.HttpClient = NewTransport(ModeReplay, dir).Client()
+
+	var replayed testResult
+	assert.Nil(t, player.Get(&replayed, "txs", map[string][]string{"a": {"1"}, "b": {"2"}}))
+	assert.Equal(t, recorded, replayed)
+
+	var replayedPost testResult
+	assert.Nil(t, player.Post(&replayedPost, "rpc", map[string]string{"method": "test"}))
+	assert.Equal(t, posted, replayedPost)
+
+	var missing testResult
+	assert.NotNil(t, player.Get(&missing, "blocks", nil))
+	assert.Equal(t, 2, calls)
+}
+
+func TestFixture_ResponseBody(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		bodyType string
+	}{
+		{"json body", `{"result":"0.07"}`, bodyTypeJSON},
+		{"text body", `not found`, bodyTypeText},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture("GET", "https://example.com/a", "", 200, []byte(tt.body))
+			assert.Equal(t, tt.bodyType, f.Response.BodyType)
+			got, err := f.ResponseBody()
+			assert.Nil(t, err)
+			assert.Equal(t, tt.body, string(got))
+		})
+	}
+}
+
+func TestFileName(t *testing.T) {
+	assert.Equal(t,
+		FileName("GET", "https://api.cosmos.network/txs?page=1&limit=25", ""),
+		FileName("GET", "https://api.cosmos.network/txs?limit=25&page=1", ""),
+	)
+	assert.Equal(t,
+		FileName("GET", "https://api.cosmos.network/staking/pool?", ""),
+		FileName("GET", "https://api.cosmos.network/staking/pool", ""),
+	)
+	assert.NotEqual(t,
+		FileName("POST", "https://api.cosmos.network/rpc", `{"id":1}`),
+		FileName("POST", "https://api.cosmos.network/rpc", `{"id":2}`),
+	)
+}
+
+func TestTransport_IgnoreQuery(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"query":%q}`, r.URL.RawQuery)
+	}))
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "replay")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	recorder := blockatlas.InitClient(server.URL)
+	transport := NewTransport(ModeRecord, dir)
+	transport.IgnoreQuery = []string{"startTime"}
+	recorder.HttpClient = transport.Client()
+
+	var recorded testResult
+	assert.Nil(t, recorder.Get(&recorded, "txs", map[string][]string{"address": {"bnb1"}, "startTime": {"1000"}}))
+	assert.Equal(t, "address=bnb1&startTime=1000", recorded.Query)
+
+	player := blockatlas.InitClient(server.URL)
+	replayer := NewTransport(ModeReplay, dir)
+	replayer.IgnoreQuery = []string{"startTime"}
+	player.HttpClient = replayer.Client()
+
+	var replayed testResult
+	assert.Nil(t, player.Get(&replayed, "txs", map[string][]string{"address": {"bnb1"}, "startTime": {"2000"}}))
+	assert.Equal(t, recorded, replayed)
+	assert.NotNil(t, player.Get(&replayed, "txs", map[string][]string{"address": {"bnb2"}, "startTime": {"2000"}}))
+}
+
+func TestTransport_RpcIDs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var requests []blockatlas.RpcRequest
+		_ = json.NewDecoder(r.Body).Decode(&requests)
+		responses := make([]blockatlas.RpcResponse, 0, len(requests))
+		for i := len(requests) - 1; i >= 0; i-- {
+			responses = append(responses, blockatlas.RpcResponse{JsonRpc: "2.0", Result: requests[i].Params, Id: requests[i].Id})
+		}
+		_ = json.NewEncoder(w).Encode(responses)
+	}))
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "replay")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	batch := func() blockatlas.RpcRequests {
+		return blockatlas.RpcRequests{{Method: "get", Params: "a"}, {Method: "get", Params: "b"}}
+	}
+	recorder := blockatlas.InitClient(server.URL)
+	recorder.HttpClient = NewTransport(ModeRecord, dir).Client()
+	_, err = recorder.RpcBatchCall(batch())
+	assert.Nil(t, err)
+
+	server.Close()
+	player := blockatlas.InitClient(server.URL)
+	player.HttpClient = NewTransport(ModeReplay, dir).Client()
+	requests := batch()
+	responses, err := player.RpcBatchCall(requests)
+	assert.Nil(t, err)
+	if assert.Len(t, responses, 2) {
+		assert.Equal(t, requests[1].Id, responses[0].Id)
+		assert.Equal(t, "b", responses[0].Result)
+		assert.Equal(t, requests[0].Id, responses[1].Id)
+		assert.Equal(t, "a", responses[1].Result)
+	}
+}
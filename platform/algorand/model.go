@@ -4,16 +4,34 @@ type TransactionType string
 
 const (
 	TransactionTypePay TransactionType = "pay"
+
+	AccountStatusOnline = "Online"
 )
 
 type Account struct {
-	Amount                      uint64 `json:"amount"`
-	Pendingrewards              uint64 `json:"pendingrewards"`
-	Address                     string `json:"address"`
-	Round                       uint64 `json:"round"`
-	Amountwithoutpendingrewards uint64 `json:"amountwithoutpendingrewards"`
-	Rewards                     uint64 `json:"rewards"`
-	Status                      string `json:"status"`
+	Amount                      uint64         `json:"amount"`
+	Pendingrewards              uint64         `json:"pendingrewards"`
+	Address                     string         `json:"address"`
+	Round                       uint64         `json:"round"`
+	Amountwithoutpendingrewards uint64         `json:"amountwithoutpendingrewards"`
+	Rewards                     uint64         `json:"rewards"`
+	Status                      string         `json:"status"`
+	Participation               *Participation `json:"participation,omitempty"`
+}
+
+// Participation holds the keys registered by an online account, they vote from VoteFirst to VoteLast rounds
+type Participation struct {
+	VoteFirst uint64 `json:"votefst"`
+	VoteLast  uint64 `json:"votelst"`
+}
+
+// ParticipationMetadata is the metadata of the delegation of an account, Online if it votes in the consensus
+type ParticipationMetadata struct {
+	Status    string `json:"status"`
+	Online    bool   `json:"online"`
+	VoteFirst uint64 `json:"vote_first,omitempty"`
+	VoteLast  uint64 `json:"vote_last,omitempty"`
+	Rewards   string `json:"rewards"`
 }
 
 type TransactionsResponse struct {
@@ -40,6 +40,61 @@ func (p *Platform) GetValidators() (blockatlas.ValidatorPage, error) {
 	return blockatlas.ValidatorPage{}, nil
 }
 
+// GetDelegations reports the balance earning the participation rewards, the account takes part in the
+// consensus itself when it is online
 func (p *Platform) GetDelegations(address string) (blockatlas.DelegationsPage, error) {
-	return blockatlas.DelegationsPage{}, nil
+	acc, err := p.client.GetAccount(address)
+	if err != nil {
+		return nil, err
+	}
+	return NormalizeDelegations(acc, p.GetDetails()), nil
+}
+
+func NormalizeDelegations(acc *Account, details blockatlas.StakingDetails) blockatlas.DelegationsPage {
+	results := make(blockatlas.DelegationsPage, 0)
+	if acc == nil || acc.Amountwithoutpendingrewards == 0 {
+		return results
+	}
+	metadata := ParticipationMetadata{
+		Status:  acc.Status,
+		Online:  acc.Status == AccountStatusOnline,
+		Rewards: strconv.FormatUint(acc.Rewards, 10),
+	}
+	if acc.Participation != nil {
+		metadata.VoteFirst = acc.Participation.VoteFirst
+		metadata.VoteLast = acc.Participation.VoteLast
+	}
+	return append(results, blockatlas.Delegation{
+		Delegator: blockatlas.StakeValidator{
+			ID:     acc.Address,
+			Status: metadata.Online,
+			Info: blockatlas.StakeValidatorInfo{
+				Name:        "Participation",
+				Description: acc.Status,
+			},
+			Details: details,
+		},
+		Value:    strconv.FormatUint(acc.Amountwithoutpendingrewards, 10),
+		Status:   blockatlas.DelegationStatusActive,
+		Metadata: metadata,
+	})
+}
+
+// GetPendingRewards returns the rewards accrued since the last transaction of the account, they are
+// added to the balance with its next transaction
+func (p *Platform) GetPendingRewards(address string) (blockatlas.PendingRewards, error) {
+	acc, err := p.client.GetAccount(address)
+	if err != nil {
+		return nil, err
+	}
+	results := make(blockatlas.PendingRewards, 0)
+	if acc.Pendingrewards == 0 {
+		return results, nil
+	}
+	return append(results, blockatlas.PendingReward{Value: strconv.FormatUint(acc.Pendingrewards, 10)}), nil
+}
+
+// GetRewardsHistory is empty, the node only keeps the total of the rewards, it is in the delegation metadata
+func (p *Platform) GetRewardsHistory(address string, page int) (blockatlas.RewardsPage, error) {
+	return make(blockatlas.RewardsPage, 0), nil
 }
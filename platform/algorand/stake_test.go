@@ -0,0 +1,61 @@
+package algorand
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+)
+
+const accountSrc = `
+{
+	"round": 8417442,
+	"address": "GYYQ4KJZ7UKBGZ2SQ7OWPK3HIIEZDRGRXBPPTEV6BO4C7JWMOM2YOHV5WQ",
+	"amount": 1289011432,
+	"pendingrewards": 1011432,
+	"amountwithoutpendingrewards": 1288000000,
+	"rewards": 23011432,
+	"status": "Online",
+	"participation": {
+		"partpkb64": "BgKXbgw6dUp3bJzDumhf6/8MZLc0gSWFsdfsWjjdnNg=",
+		"vrfpkb64": "+jMHcxEUTFyZ3ptRhCV2vGxvyBIhZgq2jz2/YNh+nDo=",
+		"votefst": 7800000,
+		"votelst": 10800000,
+		"votekd": 1733
+	}
+}`
+
+func TestNormalizeDelegations(t *testing.T) {
+	var acc Account
+	assert.NoError(t, json.Unmarshal([]byte(accountSrc), &acc))
+	details := blockatlas.StakingDetails{Reward: blockatlas.StakingReward{Annual: 6.1}, Type: blockatlas.DelegationTypeAuto}
+
+	assert.Equal(t, blockatlas.DelegationsPage{
+		{
+			Delegator: blockatlas.StakeValidator{
+				ID:      "GYYQ4KJZ7UKBGZ2SQ7OWPK3HIIEZDRGRXBPPTEV6BO4C7JWMOM2YOHV5WQ",
+				Status:  true,
+				Info:    blockatlas.StakeValidatorInfo{Name: "Participation", Description: "Online"},
+				Details: details,
+			},
+			Value:  "1288000000",
+			Status: blockatlas.DelegationStatusActive,
+			Metadata: ParticipationMetadata{
+				Status:    "Online",
+				Online:    true,
+				VoteFirst: 7800000,
+				VoteLast:  10800000,
+				Rewards:   "23011432",
+			},
+		},
+	}, NormalizeDelegations(&acc, details))
+
+	offline := Account{Address: acc.Address, Amountwithoutpendingrewards: 100, Status: "Offline"}
+	result := NormalizeDelegations(&offline, details)
+	assert.Len(t, result, 1)
+	assert.False(t, result[0].Delegator.Status)
+	assert.Equal(t, ParticipationMetadata{Status: "Offline", Rewards: "0"}, result[0].Metadata)
+
+	assert.Empty(t, NormalizeDelegations(&Account{Status: "Offline"}, details))
+}
@@ -2,15 +2,18 @@ package binance
 
 import (
 	"github.com/trustwallet/blockatlas/coin"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 )
 
 type Platform struct {
-	client Client
+	client        Client
+	stakingClient StakingClient
 }
 
-func Init(api string) *Platform {
+func Init(api, stakingAPI string) *Platform {
 	p := Platform{
-		client: InitClient(api),
+		client:        InitClient(api),
+		stakingClient: StakingClient{blockatlas.InitJSONClient(stakingAPI)},
 	}
 	return &p
 }
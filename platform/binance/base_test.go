@@ -6,13 +6,12 @@ import (
 )
 
 const (
-	//wantedBlock               = `{"number":104867508,"txs":[{"id":"4CD5BAA433BABA63D862141A4A2F9235B0BA5CBAB8114C93A0556ECA4EC7A68A","coin":714,"from":"bnb1l83kstts7lt9dpgawzechnrgjq54dql36dyspc","to":"","fee":"0","date":1596472337,"block":104867508,"status":"completed","sequence":1023322,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Cancel Order","key":"cancel_order","token_id":"AVA-645","name":"SELL","symbol":"AVA","decimals":8,"value":"0"}},{"id":"9B87D17581F2AC73D2999EDE56535E50D9D4DB75150A92A90122190F77D47755","coin":714,"from":"bnb1c4czpzvn0ttdcpnv3cy2858l2m9frxdfgk4jr0","to":"bnb1g2ukzn702napq3levm54m2z3p2gam7upern9aq","fee":"37500","date":1596472337,"block":104867508,"status":"completed","sequence":6,"type":"transfer","memo":"","metadata":{"value":"24481570","symbol":"BNB","decimals":8}},{"id":"5C0580AC983C1CF36F1656D9E8B062CD0578839BEFC839EFD6720FF315B45EEB","coin":714,"from":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","to":"","fee":"0","date":1596472337,"block":104867508,"status":"completed","sequence":1509154,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Place Order","key":"place_order","token_id":"AVA-645","name":"BUY","symbol":"AVA","decimals":8,"value":"10500000000"}}]}`
-	wantedBlockNoOrders = `{"number":104867508,"txs":[{"id":"9B87D17581F2AC73D2999EDE56535E50D9D4DB75150A92A90122190F77D47755","coin":714,"from":"bnb1c4czpzvn0ttdcpnv3cy2858l2m9frxdfgk4jr0","to":"bnb1g2ukzn702napq3levm54m2z3p2gam7upern9aq","fee":"37500","date":1596472337,"block":104867508,"status":"completed","sequence":6,"type":"transfer","memo":"","metadata":{"value":"24481570","symbol":"BNB","decimals":8}}]}`
-	wantedTxs           = `[{"id":"771B07C8D921B5995524C163E9D4504C31A9E07EC858263A53EA007484009C90","coin":714,"from":"bnb1d83u9afqw296ejw9jdfhc22f0ljr23nn7pradx","to":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","fee":"37500","date":1596826106,"block":105722032,"status":"completed","sequence":33,"type":"transfer","memo":"106890151","metadata":{"value":"120740436","symbol":"BNB","decimals":8}},{"id":"1C5682716D2D34DD01428AD8D4200081FBDA06CE886B77499F755E9D93B0FF20","coin":714,"from":"bnb1k9ktd79psysucucyxqcd5r9ahuuygk8gh3ly8q","to":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","fee":"37500","date":1596826069,"block":105721942,"status":"completed","sequence":64,"type":"transfer","memo":"103215089","metadata":{"value":"229350524","symbol":"BNB","decimals":8}},{"id":"C728F7C13977649FCDF9B8E983A9E3AA257EB5596DBCA2A2580584312B5AA535","coin":714,"from":"bnb155svs6sgxe55rnvs6ghprtqu0mh69kehphsppd","to":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","fee":"37500","date":1596826063,"block":105721925,"status":"completed","sequence":23121,"type":"transfer","memo":"101045880","metadata":{"value":"11964120000","symbol":"BNB","decimals":8}},{"id":"794302F9C6562358581A3A8432AF82CD70DF0DA345C917BDE21DDF9A9D4B9DE7","coin":714,"from":"bnb14gk6m77tswyks9nnadm92mdy3066wj42t60z0l","to":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","fee":"37500","date":1596826058,"block":105721913,"status":"completed","sequence":11228,"type":"transfer","memo":"100341541","metadata":{"value":"456000000","symbol":"BNB","decimals":8}},{"id":"2827377A04E3B22DD654A02AD4BA50B3CB83973B6B9BA1A423ABD5045873850C","coin":714,"from":"bnb132gvg9gdthtaf6xgkk9jkmsep56fv62vg95unv","to":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","fee":"37500","date":1596826024,"block":105721828,"status":"completed","sequence":4,"type":"transfer","memo":"105772095","metadata":{"value":"1045498916","symbol":"BNB","decimals":8}},{"id":"AACC80FDA9C45DCD7F6DBAA40A2EB600FF6EBB24A5A840578D4AB306C3427385","coin":714,"from":"bnb10y4hu5psc7hztlczrhzgghfjzufnqyfrrml3yn","to":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","fee":"37500","date":1596826006,"block":105721785,"status":"completed","sequence":65,"type":"transfer","memo":"109027392","metadata":{"value":"8750000000","symbol":"BNB","decimals":8}},{"id":"66CF0F65442FD35A3B91CC2655CE60E565D13EEFC6BDB1C5AF9F9E484C8B6295","coin":714,"from":"bnb17g92armmr926kd88umh7u90vglq4ghjtku6ssc","to":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","fee":"37500","date":1596825967,"block":105721686,"status":"completed","sequence":896,"type":"transfer","memo":"107780643","metadata":{"value":"878900000","symbol":"BNB","decimals":8}},{"id":"81EA185BE754809E40FCBF1B07A0C389F39DECD38D56302D980534850996B144","coin":714,"from":"bnb12nq7fhh3t8q0m9s2n5gqwd9gcx0j85yn0h5zcu","to":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","fee":"37500","date":1596825888,"block":105721490,"status":"completed","sequence":0,"type":"transfer","memo":"101210049","metadata":{"value":"4498159221","symbol":"BNB","decimals":8}},{"id":"24ED2153427ABACD7F6DB53A1339D9C2573D720FDE6EC45B0047CB146227FF9A","coin":714,"from":"bnb1s5qucugaxv7gkzyg4kacf225s6mhj0ysejn8jq","to":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","fee":"37500","date":1596825847,"block":105721385,"status":"completed","sequence":40,"type":"transfer","memo":"104298046","metadata":{"value":"34300000000","symbol":"BNB","decimals":8}},{"id":"411A27CE85D0928BC5908DA5BA288CB52E152C1E54FA35042147A90FCEBAB29C","coin":714,"from":"bnb1erj09eqrnz06jv2acxhhy7s3k0q6w4hfmj9rey","to":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","fee":"37500","date":1596825789,"block":105721238,"status":"completed","sequence":12,"type":"transfer","memo":"107303874","metadata":{"value":"6319074","symbol":"BNB","decimals":8}},{"id":"25359F7277760004BBD42557B3E50F86F06B096478840E057C6F394BF63F6081","coin":714,"from":"bnb10y4hu5psc7hztlczrhzgghfjzufnqyfrrml3yn","to":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","fee":"37500","date":1596825692,"block":105720997,"status":"completed","sequence":57,"type":"transfer","memo":"109027392","metadata":{"value":"1090000000","symbol":"BNB","decimals":8}},{"id":"1DB710AF4983D9CAFB24AC3DD7C2C6EBC7752F347E5A7599840AB3EFBD436C5D","coin":714,"from":"bnb10y4hu5psc7hztlczrhzgghfjzufnqyfrrml3yn","to":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","fee":"37500","date":1596825639,"block":105720867,"status":"completed","sequence":56,"type":"transfer","memo":"109027392","metadata":{"value":"2000000000","symbol":"BNB","decimals":8}},{"id":"B7C799B9853ECA41F02241B67E294E2642AE88BBF551DE188A73C48D1A0E821D","coin":714,"from":"bnb1q2994t0djzsy0q2fc62jruxr22nxq6y8l4503l","to":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","fee":"37500","date":1596825619,"block":105720816,"status":"completed","sequence":1,"type":"transfer","memo":"108202494","metadata":{"value":"295957149","symbol":"BNB","decimals":8}},{"id":"865C098D021CC649FC83B2442092E4D6F0777488163E5797DA9D264C87F1F4F4","coin":714,"from":"bnb16xqcchlutsm8g6gk7wvlzukeu2sy9xt2u77056","to":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","fee":"37500","date":1596825548,"block":105720641,"status":"completed","sequence":33,"type":"transfer","memo":"102080722","metadata":{"value":"1373501799","symbol":"BNB","decimals":8}},{"id":"1D4F777D1D227D57E4D4BAC3E83FB203147E7B0E7429E60A48A9053372359174","coin":714,"from":"bnb1t6tk66zncqqt7twnfyxx22fn9t3wz3nac5hkck","to":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","fee":"37500","date":1596825500,"block":105720519,"status":"completed","sequence":14,"type":"transfer","memo":"106456805","metadata":{"value":"1490609","symbol":"BNB","decimals":8}},{"id":"9A818687D52B4E9E0C5F962E6E464ECC6348D6A41AE2C5DD051A5EF71E15B1AC","coin":714,"from":"bnb1v47qw5acc72c34uwt7t9wm9ztqtg6678dmrssa","to":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","fee":"37500","date":1596825415,"block":105720307,"status":"completed","sequence":6273,"type":"transfer","memo":"104471384","metadata":{"value":"356700000","symbol":"BNB","decimals":8}},{"id":"6C7D4469525E44501ED03BA38A6D54A04DFD6AD2D13328761DA4602A3D777332","coin":714,"from":"bnb1tzet704pc6zjsl3xcwdexn6xlu0zc092y0n4ay","to":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","fee":"37500","date":1596825348,"block":105720136,"status":"completed","sequence":721,"type":"transfer","memo":"101245732","metadata":{"value":"93900000","symbol":"BNB","decimals":8}},{"id":"8C47875F09371B45C0A29D7C6EF308A1C499D1BBECD5A56E0561B40675AF2D26","coin":714,"from":"bnb1t7hpl286qgvsg08lvx6ac9ul0msy4k2ud8dukp","to":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","fee":"37500","date":1596825311,"block":105720042,"status":"completed","sequence":1912,"type":"transfer","memo":"101186586","metadata":{"value":"95193451","symbol":"BNB","decimals":8}},{"id":"39A48766F8ACC33B3BB1B4F442ABFDFBC695F440D72795794A01E8E446CE8EAE","coin":714,"from":"bnb10y4hu5psc7hztlczrhzgghfjzufnqyfrrml3yn","to":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","fee":"37500","date":1596825298,"block":105720010,"status":"completed","sequence":47,"type":"transfer","memo":"109027392","metadata":{"value":"480000000","symbol":"BNB","decimals":8}},{"id":"54248E3C09713E3870F661749A8690DAD46A8078C7346C497EB4E166564420F4","coin":714,"from":"bnb1hc304lytvp9jnumnjmppq97erm70r7muzv6y98","to":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","fee":"37500","date":1596825237,"block":105719860,"status":"completed","sequence":1,"type":"transfer","memo":"105261446","metadata":{"value":"10210962500","symbol":"BNB","decimals":8}},{"id":"ADDDB705AB8C2B1AF58D7EDCAEC00C893A6CD87281D7A7146689E9FE1A846EAE","coin":714,"from":"bnb17g92armmr926kd88umh7u90vglq4ghjtku6ssc","to":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","fee":"37500","date":1596825173,"block":105719705,"status":"completed","sequence":895,"type":"transfer","memo":"103617121","metadata":{"value":"297900000","symbol":"BNB","decimals":8}},{"id":"7C338C78BBBCD998628CCAF85211883AACB159201755501139F56E0D2A7941A4","coin":714,"from":"bnb1pys084nlc8gqm7lvjje4kt39dn84vd2xsvnwqa","to":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","fee":"37500","date":1596825147,"block":105719641,"status":"completed","sequence":18,"type":"transfer","memo":"108802310","metadata":{"value":"200000000","symbol":"BNB","decimals":8}},{"id":"6DD7120E43CD5031E2DD6C7977D141B74CA41C8E476D8AE972A9DA2D2EFD2D84","coin":714,"from":"bnb17g92armmr926kd88umh7u90vglq4ghjtku6ssc","to":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","fee":"37500","date":1596825061,"block":105719428,"status":"completed","sequence":894,"type":"transfer","memo":"102393444","metadata":{"value":"677900000","symbol":"BNB","decimals":8}},{"id":"86FB14C637D7B0A4AA123FFF76C5C541C70568B67E3F5BC63EB0FDEDEF30301F","coin":714,"from":"bnb17nak8gnucl6lcze0d04def4de77a33qh29f6ur","to":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","fee":"37500","date":1596825051,"block":105719403,"status":"completed","sequence":3,"type":"transfer","memo":"109823910","metadata":{"value":"900000","symbol":"BNB","decimals":8}},{"id":"1DEEFC0A838F80704574951DF15969CCE34654BE2007BF77F9521A1B4686BE33","coin":714,"from":"bnb1yjlk7f47qf0z97ph6pxc00s2s0yw048edmtjtw","to":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","fee":"37500","date":1596825014,"block":105719312,"status":"completed","sequence":1431,"type":"transfer","memo":"103268674","metadata":{"value":"315739000","symbol":"BNB","decimals":8}}]`
-	wantedTokens        = `[{"name":"Travala.com Token","symbol":"AVA","decimals":8,"token_id":"AVA-645","coin":714,"type":"BEP2"},{"name":"Binance Chain Native Token","symbol":"BNB","decimals":8,"token_id":"BNB","coin":714,"type":"BEP2"},{"name":"Binance USD","symbol":"BUSD","decimals":8,"token_id":"BUSD-BD1","coin":714,"type":"BEP2"}]`
-	//wantedTxsAva              = `[{"id":"2BF49DF1D10D9A20438376E760352734DAEBD5D92D6CAA14735EE095A3F65FD3","coin":714,"from":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","to":"","fee":"0","date":1596827621,"block":105725696,"status":"completed","sequence":1594673,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Place Order","key":"place_order","token_id":"AVA-645","name":"BUY","symbol":"AVA","decimals":8,"value":"2700000000"}},{"id":"C858D15E2745A61D0D3D354750E4462792160FFBB9927739587FFAAEDEFA00FF","coin":714,"from":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","to":"","fee":"0","date":1596827612,"block":105725676,"status":"completed","sequence":1594670,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Place Order","key":"place_order","token_id":"AVA-645","name":"SELL","symbol":"AVA","decimals":8,"value":"13100000000"}},{"id":"9BDCF416622AA4E8F11162747614585FD840F5721D7163A68BC03EC94E855DEE","coin":714,"from":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","to":"","fee":"0","date":1596827611,"block":105725673,"status":"completed","sequence":1594669,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Place Order","key":"place_order","token_id":"AVA-645","name":"BUY","symbol":"AVA","decimals":8,"value":"1700000000"}},{"id":"52512D9498D4CF51997A5A62C0A55252776B00C888D2D01502C68B56E892F42E","coin":714,"from":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","to":"","fee":"0","date":1596827603,"block":105725652,"status":"completed","sequence":1594666,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Place Order","key":"place_order","token_id":"AVA-645","name":"SELL","symbol":"AVA","decimals":8,"value":"2200000000"}},{"id":"1BAF96AB01E7AB5A7746CA7E76B293CD636D55713C7CCE71FD0E0ED8ACE05C92","coin":714,"from":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","to":"","fee":"0","date":1596827600,"block":105725646,"status":"completed","sequence":1594665,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Place Order","key":"place_order","token_id":"AVA-645","name":"BUY","symbol":"AVA","decimals":8,"value":"1500000000"}},{"id":"9990419EFE1B327966EBAAFFC2771046E1AC2E4DDFAD8A93C55DE105A2948A8D","coin":714,"from":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","to":"","fee":"0","date":1596827591,"block":105725624,"status":"completed","sequence":1594662,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Place Order","key":"place_order","token_id":"AVA-645","name":"SELL","symbol":"AVA","decimals":8,"value":"10800000000"}},{"id":"922F86E110E897233A7B43C0D31397923298C176EBE6F33D98B70ACB911A497A","coin":714,"from":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","to":"","fee":"0","date":1596827589,"block":105725620,"status":"completed","sequence":1594661,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Place Order","key":"place_order","token_id":"AVA-645","name":"BUY","symbol":"AVA","decimals":8,"value":"1800000000"}},{"id":"925A208AFDCD718F8383F7559C7BBA5AE616B693A6029F57BFB67AEBB1CE5F3C","coin":714,"from":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","to":"","fee":"0","date":1596827580,"block":105725597,"status":"completed","sequence":1594658,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Place Order","key":"place_order","token_id":"AVA-645","name":"BUY","symbol":"AVA","decimals":8,"value":"8200000000"}},{"id":"2B69327D6C0C7B9061AAD962BF4838E3B630A844439013E0EBEFC5B722205774","coin":714,"from":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","to":"","fee":"0","date":1596827571,"block":105725575,"status":"completed","sequence":1594655,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Place Order","key":"place_order","token_id":"AVA-645","name":"BUY","symbol":"AVA","decimals":8,"value":"1500000000"}},{"id":"BB6FD86C9738C2991F587996ABBAC1151DF1F9DE57FDCC977BB0D8FBAD31188A","coin":714,"from":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","to":"","fee":"0","date":1596827552,"block":105725529,"status":"completed","sequence":1594653,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Place Order","key":"place_order","token_id":"AVA-645","name":"SELL","symbol":"AVA","decimals":8,"value":"2500000000"}},{"id":"97856635BDBA4B0B3EAAA76EA46D848A2DFE3AAE554A33D308CFC4474C769290","coin":714,"from":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","to":"","fee":"0","date":1596827550,"block":105725526,"status":"completed","sequence":1594652,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Place Order","key":"place_order","token_id":"AVA-645","name":"BUY","symbol":"AVA","decimals":8,"value":"5500000000"}},{"id":"4B4EC65A5972CC15C4B410339ED0081C4BB57F4E08F2A35744845F5A71531B59","coin":714,"from":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","to":"","fee":"0","date":1596827537,"block":105725493,"status":"completed","sequence":1594648,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Place Order","key":"place_order","token_id":"AVA-645","name":"SELL","symbol":"AVA","decimals":8,"value":"1700000000"}},{"id":"86B5FFDDB06E0973A05434DDB858868A45EDA8B07E0D75125FEAEBA1093D4EA5","coin":714,"from":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","to":"","fee":"0","date":1596827535,"block":105725489,"status":"completed","sequence":1594647,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Place Order","key":"place_order","token_id":"AVA-645","name":"BUY","symbol":"AVA","decimals":8,"value":"11800000000"}},{"id":"D20757EB130E8146D1A12E869BE69EC2CB03193D8E62467327B09A5D73BBC6F8","coin":714,"from":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","to":"","fee":"0","date":1596827529,"block":105725473,"status":"completed","sequence":1594645,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Place Order","key":"place_order","token_id":"AVA-645","name":"SELL","symbol":"AVA","decimals":8,"value":"3300000000"}},{"id":"04291A7AE08A78C5E5AE216C67D17B6A4260950FFAB6FD3D1C1B991E3B7BB935","coin":714,"from":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","to":"","fee":"0","date":1596827527,"block":105725470,"status":"completed","sequence":1594644,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Place Order","key":"place_order","token_id":"AVA-645","name":"BUY","symbol":"AVA","decimals":8,"value":"14600000000"}},{"id":"31E16E3F53DD476475256C8D872AF3FD86DC44F3085EF87DDE3643E20C0CE624","coin":714,"from":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","to":"","fee":"0","date":1596827518,"block":105725448,"status":"completed","sequence":1594641,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Place Order","key":"place_order","token_id":"AVA-645","name":"SELL","symbol":"AVA","decimals":8,"value":"1800000000"}},{"id":"1D61A9E35893BB51DE4AE63FA6898FCAE14B6D48F7A2EFD0B51DCCACF6E36B88","coin":714,"from":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","to":"","fee":"0","date":1596827517,"block":105725446,"status":"completed","sequence":1594640,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Place Order","key":"place_order","token_id":"AVA-645","name":"BUY","symbol":"AVA","decimals":8,"value":"13700000000"}},{"id":"AB9E29B110844BD76F58B058CC20F1E13B37A1AAC4F09978BDE159F1B09B585E","coin":714,"from":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","to":"","fee":"0","date":1596827509,"block":105725425,"status":"completed","sequence":1594637,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Place Order","key":"place_order","token_id":"AVA-645","name":"SELL","symbol":"AVA","decimals":8,"value":"1500000000"}},{"id":"F81649BAF3D888E3772C8708D255FE91E0CDB2818006A1FC96FFBDAC24BDE675","coin":714,"from":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","to":"","fee":"0","date":1596827508,"block":105725422,"status":"completed","sequence":1594636,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Place Order","key":"place_order","token_id":"AVA-645","name":"BUY","symbol":"AVA","decimals":8,"value":"8700000000"}},{"id":"71B25BF61219BC87980EB67064C346801C01B00FFE52932799E28FB20DAF41D8","coin":714,"from":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","to":"","fee":"0","date":1596827499,"block":105725401,"status":"completed","sequence":1594633,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Place Order","key":"place_order","token_id":"AVA-645","name":"SELL","symbol":"AVA","decimals":8,"value":"1200000000"}},{"id":"3424BFCA860A878FDCF304AD414EDE2AB3B0FF258CA940CD1269F469ED7C422C","coin":714,"from":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","to":"","fee":"0","date":1596827490,"block":105725379,"status":"completed","sequence":1594630,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Place Order","key":"place_order","token_id":"AVA-645","name":"SELL","symbol":"AVA","decimals":8,"value":"7600000000"}},{"id":"46FFA039B955953D4F19BE7D56FA793B71F34BFB460D9B84A152B554E9219C44","coin":714,"from":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","to":"","fee":"0","date":1596827488,"block":105725375,"status":"completed","sequence":1594629,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Place Order","key":"place_order","token_id":"AVA-645","name":"BUY","symbol":"AVA","decimals":8,"value":"13900000000"}},{"id":"701100D3783744D633FF34A6E211CEB923EA9BCE6D87A64211801E71F71AEE03","coin":714,"from":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","to":"","fee":"0","date":1596827469,"block":105725327,"status":"completed","sequence":1594627,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Place Order","key":"place_order","token_id":"AVA-645","name":"SELL","symbol":"AVA","decimals":8,"value":"4900000000"}},{"id":"B12B30509B45CEFE526DAADDAC6399F902B30418859042D7A23F9A2FB311A6C8","coin":714,"from":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","to":"","fee":"0","date":1596827467,"block":105725323,"status":"completed","sequence":1594626,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Place Order","key":"place_order","token_id":"AVA-645","name":"BUY","symbol":"AVA","decimals":8,"value":"2700000000"}},{"id":"933819F35F0F89FB2D58DC50CD15FABB3DA0B817BFCD39A8DDC99DFEC1663F7D","coin":714,"from":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","to":"","fee":"0","date":1596827458,"block":105725301,"status":"completed","sequence":1594623,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Place Order","key":"place_order","token_id":"AVA-645","name":"SELL","symbol":"AVA","decimals":8,"value":"9500000000"}}]`
-	//wantedBlockMulti          = `{"number":105529271,"txs":[{"id":"432FF828B1DC1C4DAF13A51B6AE7ADD9932B7FC6526C28F7FE9C905F95472820","coin":714,"from":"bnb1z35wusfv8twfele77vddclka9z84ugywug48gn","to":"","fee":"0","date":1596746326,"block":105529271,"status":"completed","sequence":11317170,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Cancel Order","key":"cancel_order","token_id":"","name":"","symbol":"","decimals":8,"value":"0"}},{"id":"CC7C3EF1407373FDA74B005E64683AB5865126DE93A5FAF755FF5CC948992067","coin":714,"from":"bnb15qced76xere38hmmpe644u5kd8v4lzl9gsex9w","to":"bnb15qced76xere38hmmpe644u5kd8v4lzl9gsex9w","fee":"60000","date":1596746326,"block":105529271,"status":"completed","sequence":2300,"type":"transfer","memo":"0","metadata":{"value":"1","symbol":"BNB","decimals":8}},{"id":"CC7C3EF1407373FDA74B005E64683AB5865126DE93A5FAF755FF5CC948992067","coin":714,"from":"bnb1t38ccns9var4ac4yj2ylmu99r9ecmggr8ye5e5","to":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","fee":"0","date":1596746326,"block":105529271,"status":"completed","sequence":2300,"type":"transfer","memo":"0","metadata":{"value":"39421249","symbol":"BNB","decimals":8}}]}`
-	wantedBlockMultiNoOrders  = `{"number":105529271,"txs":[{"id":"CC7C3EF1407373FDA74B005E64683AB5865126DE93A5FAF755FF5CC948992067","coin":714,"from":"bnb15qced76xere38hmmpe644u5kd8v4lzl9gsex9w","to":"bnb15qced76xere38hmmpe644u5kd8v4lzl9gsex9w","fee":"60000","date":1596746326,"block":105529271,"status":"completed","sequence":2300,"type":"transfer","memo":"0","metadata":{"value":"1","symbol":"BNB","decimals":8}},{"id":"CC7C3EF1407373FDA74B005E64683AB5865126DE93A5FAF755FF5CC948992067","coin":714,"from":"bnb1t38ccns9var4ac4yj2ylmu99r9ecmggr8ye5e5","to":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","fee":"0","date":1596746326,"block":105529271,"status":"completed","sequence":2300,"type":"transfer","memo":"0","metadata":{"value":"39421249","symbol":"BNB","decimals":8}}]}`
+	mockedBlockTradesResponse = `{"total":1,"trade":[{"tradeId":"104867508-0","blockHeight":104867508,"symbol":"AVA-645_BNB","price":"0.08500000","quantity":"105.00000000","baseAsset":"AVA-645","quoteAsset":"BNB","buyerOrderId":"77BB8148DC7D2CBC504C0CC5699D7A593FE53E70-1509154","sellerOrderId":"F9E3682D70F7D65685D1D8B38BCD1A48295683F1-1023321","buyerId":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","sellerId":"bnb1l83kstts7lt9dpgawzechnrgjq54dql36dyspc","buyFee":"BNB:0.00892500;","sellFee":"BNB:0.00892500;","time":1596472337000}]}`
+	wantedBlock               = `{"number":104867508,"txs":[{"id":"4CD5BAA433BABA63D862141A4A2F9235B0BA5CBAB8114C93A0556ECA4EC7A68A","coin":714,"from":"bnb1l83kstts7lt9dpgawzechnrgjq54dql36dyspc","to":"","fee":"0","date":1596472337,"block":104867508,"status":"completed","sequence":1023322,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Cancel Order","key":"cancel_order","token_id":"AVA-645","name":"SELL","symbol":"AVA","decimals":8,"value":"0"}},{"id":"9B87D17581F2AC73D2999EDE56535E50D9D4DB75150A92A90122190F77D47755","coin":714,"from":"bnb1c4czpzvn0ttdcpnv3cy2858l2m9frxdfgk4jr0","to":"bnb1g2ukzn702napq3levm54m2z3p2gam7upern9aq","fee":"37500","date":1596472337,"block":104867508,"status":"completed","sequence":6,"type":"transfer","memo":"","metadata":{"value":"24481570","symbol":"BNB","decimals":8}},{"id":"5C0580AC983C1CF36F1656D9E8B062CD0578839BEFC839EFD6720FF315B45EEB","coin":714,"from":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","to":"","fee":"0","date":1596472337,"block":104867508,"status":"completed","sequence":1509154,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Place Order","key":"place_order","token_id":"AVA-645","name":"BUY","symbol":"AVA","decimals":8,"value":"10500000000"}}]}`
+	wantedTxs                 = `[{"id":"771B07C8D921B5995524C163E9D4504C31A9E07EC858263A53EA007484009C90","coin":714,"from":"bnb1d83u9afqw296ejw9jdfhc22f0ljr23nn7pradx","to":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","fee":"37500","date":1596826106,"block":105722032,"status":"completed","sequence":33,"type":"transfer","memo":"106890151","metadata":{"value":"120740436","symbol":"BNB","decimals":8}},{"id":"1C5682716D2D34DD01428AD8D4200081FBDA06CE886B77499F755E9D93B0FF20","coin":714,"from":"bnb1k9ktd79psysucucyxqcd5r9ahuuygk8gh3ly8q","to":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","fee":"37500","date":1596826069,"block":105721942,"status":"completed","sequence":64,"type":"transfer","memo":"103215089","metadata":{"value":"229350524","symbol":"BNB","decimals":8}},{"id":"C728F7C13977649FCDF9B8E983A9E3AA257EB5596DBCA2A2580584312B5AA535","coin":714,"from":"bnb155svs6sgxe55rnvs6ghprtqu0mh69kehphsppd","to":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","fee":"37500","date":1596826063,"block":105721925,"status":"completed","sequence":23121,"type":"transfer","memo":"101045880","metadata":{"value":"11964120000","symbol":"BNB","decimals":8}},{"id":"794302F9C6562358581A3A8432AF82CD70DF0DA345C917BDE21DDF9A9D4B9DE7","coin":714,"from":"bnb14gk6m77tswyks9nnadm92mdy3066wj42t60z0l","to":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","fee":"37500","date":1596826058,"block":105721913,"status":"completed","sequence":11228,"type":"transfer","memo":"100341541","metadata":{"value":"456000000","symbol":"BNB","decimals":8}},{"id":"2827377A04E3B22DD654A02AD4BA50B3CB83973B6B9BA1A423ABD5045873850C","coin":714,"from":"bnb132gvg9gdthtaf6xgkk9jkmsep56fv62vg95unv","to":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","fee":"37500","date":1596826024,"block":105721828,"status":"completed","sequence":4,"type":"transfer","memo":"105772095","metadata":{"value":"1045498916","symbol":"BNB","decimals":8}},{"id":"AACC80FDA9C45DCD7F6DBAA40A2EB600FF6EBB24A5A840578D4AB306C3427385","coin":714,"from":"bnb10y4hu5psc7hztlczrhzgghfjzufnqyfrrml3yn","to":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","fee":"37500","date":1596826006,"block":105721785,"status":"completed","sequence":65,"type":"transfer","memo":"109027392","metadata":{"value":"8750000000","symbol":"BNB","decimals":8}},{"id":"66CF0F65442FD35A3B91CC2655CE60E565D13EEFC6BDB1C5AF9F9E484C8B6295","coin":714,"from":"bnb17g92armmr926kd88umh7u90vglq4ghjtku6ssc","to":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","fee":"37500","date":1596825967,"block":105721686,"status":"completed","sequence":896,"type":"transfer","memo":"107780643","metadata":{"value":"878900000","symbol":"BNB","decimals":8}},{"id":"81EA185BE754809E40FCBF1B07A0C389F39DECD38D56302D980534850996B144","coin":714,"from":"bnb12nq7fhh3t8q0m9s2n5gqwd9gcx0j85yn0h5zcu","to":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","fee":"37500","date":1596825888,"block":105721490,"status":"completed","sequence":0,"type":"transfer","memo":"101210049","metadata":{"value":"4498159221","symbol":"BNB","decimals":8}},{"id":"24ED2153427ABACD7F6DB53A1339D9C2573D720FDE6EC45B0047CB146227FF9A","coin":714,"from":"bnb1s5qucugaxv7gkzyg4kacf225s6mhj0ysejn8jq","to":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","fee":"37500","date":1596825847,"block":105721385,"status":"completed","sequence":40,"type":"transfer","memo":"104298046","metadata":{"value":"34300000000","symbol":"BNB","decimals":8}},{"id":"411A27CE85D0928BC5908DA5BA288CB52E152C1E54FA35042147A90FCEBAB29C","coin":714,"from":"bnb1erj09eqrnz06jv2acxhhy7s3k0q6w4hfmj9rey","to":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","fee":"37500","date":1596825789,"block":105721238,"status":"completed","sequence":12,"type":"transfer","memo":"107303874","metadata":{"value":"6319074","symbol":"BNB","decimals":8}},{"id":"25359F7277760004BBD42557B3E50F86F06B096478840E057C6F394BF63F6081","coin":714,"from":"bnb10y4hu5psc7hztlczrhzgghfjzufnqyfrrml3yn","to":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","fee":"37500","date":1596825692,"block":105720997,"status":"completed","sequence":57,"type":"transfer","memo":"109027392","metadata":{"value":"1090000000","symbol":"BNB","decimals":8}},{"id":"1DB710AF4983D9CAFB24AC3DD7C2C6EBC7752F347E5A7599840AB3EFBD436C5D","coin":714,"from":"bnb10y4hu5psc7hztlczrhzgghfjzufnqyfrrml3yn","to":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","fee":"37500","date":1596825639,"block":105720867,"status":"completed","sequence":56,"type":"transfer","memo":"109027392","metadata":{"value":"2000000000","symbol":"BNB","decimals":8}},{"id":"B7C799B9853ECA41F02241B67E294E2642AE88BBF551DE188A73C48D1A0E821D","coin":714,"from":"bnb1q2994t0djzsy0q2fc62jruxr22nxq6y8l4503l","to":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","fee":"37500","date":1596825619,"block":105720816,"status":"completed","sequence":1,"type":"transfer","memo":"108202494","metadata":{"value":"295957149","symbol":"BNB","decimals":8}},{"id":"865C098D021CC649FC83B2442092E4D6F0777488163E5797DA9D264C87F1F4F4","coin":714,"from":"bnb16xqcchlutsm8g6gk7wvlzukeu2sy9xt2u77056","to":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","fee":"37500","date":1596825548,"block":105720641,"status":"completed","sequence":33,"type":"transfer","memo":"102080722","metadata":{"value":"1373501799","symbol":"BNB","decimals":8}},{"id":"1D4F777D1D227D57E4D4BAC3E83FB203147E7B0E7429E60A48A9053372359174","coin":714,"from":"bnb1t6tk66zncqqt7twnfyxx22fn9t3wz3nac5hkck","to":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","fee":"37500","date":1596825500,"block":105720519,"status":"completed","sequence":14,"type":"transfer","memo":"106456805","metadata":{"value":"1490609","symbol":"BNB","decimals":8}},{"id":"9A818687D52B4E9E0C5F962E6E464ECC6348D6A41AE2C5DD051A5EF71E15B1AC","coin":714,"from":"bnb1v47qw5acc72c34uwt7t9wm9ztqtg6678dmrssa","to":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","fee":"37500","date":1596825415,"block":105720307,"status":"completed","sequence":6273,"type":"transfer","memo":"104471384","metadata":{"value":"356700000","symbol":"BNB","decimals":8}},{"id":"6C7D4469525E44501ED03BA38A6D54A04DFD6AD2D13328761DA4602A3D777332","coin":714,"from":"bnb1tzet704pc6zjsl3xcwdexn6xlu0zc092y0n4ay","to":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","fee":"37500","date":1596825348,"block":105720136,"status":"completed","sequence":721,"type":"transfer","memo":"101245732","metadata":{"value":"93900000","symbol":"BNB","decimals":8}},{"id":"8C47875F09371B45C0A29D7C6EF308A1C499D1BBECD5A56E0561B40675AF2D26","coin":714,"from":"bnb1t7hpl286qgvsg08lvx6ac9ul0msy4k2ud8dukp","to":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","fee":"37500","date":1596825311,"block":105720042,"status":"completed","sequence":1912,"type":"transfer","memo":"101186586","metadata":{"value":"95193451","symbol":"BNB","decimals":8}},{"id":"39A48766F8ACC33B3BB1B4F442ABFDFBC695F440D72795794A01E8E446CE8EAE","coin":714,"from":"bnb10y4hu5psc7hztlczrhzgghfjzufnqyfrrml3yn","to":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","fee":"37500","date":1596825298,"block":105720010,"status":"completed","sequence":47,"type":"transfer","memo":"109027392","metadata":{"value":"480000000","symbol":"BNB","decimals":8}},{"id":"54248E3C09713E3870F661749A8690DAD46A8078C7346C497EB4E166564420F4","coin":714,"from":"bnb1hc304lytvp9jnumnjmppq97erm70r7muzv6y98","to":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","fee":"37500","date":1596825237,"block":105719860,"status":"completed","sequence":1,"type":"transfer","memo":"105261446","metadata":{"value":"10210962500","symbol":"BNB","decimals":8}},{"id":"ADDDB705AB8C2B1AF58D7EDCAEC00C893A6CD87281D7A7146689E9FE1A846EAE","coin":714,"from":"bnb17g92armmr926kd88umh7u90vglq4ghjtku6ssc","to":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","fee":"37500","date":1596825173,"block":105719705,"status":"completed","sequence":895,"type":"transfer","memo":"103617121","metadata":{"value":"297900000","symbol":"BNB","decimals":8}},{"id":"7C338C78BBBCD998628CCAF85211883AACB159201755501139F56E0D2A7941A4","coin":714,"from":"bnb1pys084nlc8gqm7lvjje4kt39dn84vd2xsvnwqa","to":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","fee":"37500","date":1596825147,"block":105719641,"status":"completed","sequence":18,"type":"transfer","memo":"108802310","metadata":{"value":"200000000","symbol":"BNB","decimals":8}},{"id":"6DD7120E43CD5031E2DD6C7977D141B74CA41C8E476D8AE972A9DA2D2EFD2D84","coin":714,"from":"bnb17g92armmr926kd88umh7u90vglq4ghjtku6ssc","to":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","fee":"37500","date":1596825061,"block":105719428,"status":"completed","sequence":894,"type":"transfer","memo":"102393444","metadata":{"value":"677900000","symbol":"BNB","decimals":8}},{"id":"86FB14C637D7B0A4AA123FFF76C5C541C70568B67E3F5BC63EB0FDEDEF30301F","coin":714,"from":"bnb17nak8gnucl6lcze0d04def4de77a33qh29f6ur","to":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","fee":"37500","date":1596825051,"block":105719403,"status":"completed","sequence":3,"type":"transfer","memo":"109823910","metadata":{"value":"900000","symbol":"BNB","decimals":8}},{"id":"1DEEFC0A838F80704574951DF15969CCE34654BE2007BF77F9521A1B4686BE33","coin":714,"from":"bnb1yjlk7f47qf0z97ph6pxc00s2s0yw048edmtjtw","to":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","fee":"37500","date":1596825014,"block":105719312,"status":"completed","sequence":1431,"type":"transfer","memo":"103268674","metadata":{"value":"315739000","symbol":"BNB","decimals":8}}]`
+	wantedTokens              = `[{"name":"Travala.com Token","symbol":"AVA","decimals":8,"token_id":"AVA-645","coin":714,"type":"BEP2"},{"name":"Binance Chain Native Token","symbol":"BNB","decimals":8,"token_id":"BNB","coin":714,"type":"BEP2"},{"name":"Binance USD","symbol":"BUSD","decimals":8,"token_id":"BUSD-BD1","coin":714,"type":"BEP2"}]`
+	wantedTxsAva              = `[{"id":"2BF49DF1D10D9A20438376E760352734DAEBD5D92D6CAA14735EE095A3F65FD3","coin":714,"from":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","to":"","fee":"0","date":1596827621,"block":105725696,"status":"completed","sequence":1594673,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Place Order","key":"place_order","token_id":"AVA-645","name":"BUY","symbol":"AVA","decimals":8,"value":"2700000000"}},{"id":"C858D15E2745A61D0D3D354750E4462792160FFBB9927739587FFAAEDEFA00FF","coin":714,"from":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","to":"","fee":"0","date":1596827612,"block":105725676,"status":"completed","sequence":1594670,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Place Order","key":"place_order","token_id":"AVA-645","name":"SELL","symbol":"AVA","decimals":8,"value":"13100000000"}},{"id":"9BDCF416622AA4E8F11162747614585FD840F5721D7163A68BC03EC94E855DEE","coin":714,"from":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","to":"","fee":"0","date":1596827611,"block":105725673,"status":"completed","sequence":1594669,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Place Order","key":"place_order","token_id":"AVA-645","name":"BUY","symbol":"AVA","decimals":8,"value":"1700000000"}},{"id":"52512D9498D4CF51997A5A62C0A55252776B00C888D2D01502C68B56E892F42E","coin":714,"from":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","to":"","fee":"0","date":1596827603,"block":105725652,"status":"completed","sequence":1594666,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Place Order","key":"place_order","token_id":"AVA-645","name":"SELL","symbol":"AVA","decimals":8,"value":"2200000000"}},{"id":"1BAF96AB01E7AB5A7746CA7E76B293CD636D55713C7CCE71FD0E0ED8ACE05C92","coin":714,"from":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","to":"","fee":"0","date":1596827600,"block":105725646,"status":"completed","sequence":1594665,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Place Order","key":"place_order","token_id":"AVA-645","name":"BUY","symbol":"AVA","decimals":8,"value":"1500000000"}},{"id":"9990419EFE1B327966EBAAFFC2771046E1AC2E4DDFAD8A93C55DE105A2948A8D","coin":714,"from":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","to":"","fee":"0","date":1596827591,"block":105725624,"status":"completed","sequence":1594662,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Place Order","key":"place_order","token_id":"AVA-645","name":"SELL","symbol":"AVA","decimals":8,"value":"10800000000"}},{"id":"922F86E110E897233A7B43C0D31397923298C176EBE6F33D98B70ACB911A497A","coin":714,"from":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","to":"","fee":"0","date":1596827589,"block":105725620,"status":"completed","sequence":1594661,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Place Order","key":"place_order","token_id":"AVA-645","name":"BUY","symbol":"AVA","decimals":8,"value":"1800000000"}},{"id":"925A208AFDCD718F8383F7559C7BBA5AE616B693A6029F57BFB67AEBB1CE5F3C","coin":714,"from":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","to":"","fee":"0","date":1596827580,"block":105725597,"status":"completed","sequence":1594658,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Place Order","key":"place_order","token_id":"AVA-645","name":"BUY","symbol":"AVA","decimals":8,"value":"8200000000"}},{"id":"2B69327D6C0C7B9061AAD962BF4838E3B630A844439013E0EBEFC5B722205774","coin":714,"from":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","to":"","fee":"0","date":1596827571,"block":105725575,"status":"completed","sequence":1594655,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Place Order","key":"place_order","token_id":"AVA-645","name":"BUY","symbol":"AVA","decimals":8,"value":"1500000000"}},{"id":"BB6FD86C9738C2991F587996ABBAC1151DF1F9DE57FDCC977BB0D8FBAD31188A","coin":714,"from":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","to":"","fee":"0","date":1596827552,"block":105725529,"status":"completed","sequence":1594653,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Place Order","key":"place_order","token_id":"AVA-645","name":"SELL","symbol":"AVA","decimals":8,"value":"2500000000"}},{"id":"97856635BDBA4B0B3EAAA76EA46D848A2DFE3AAE554A33D308CFC4474C769290","coin":714,"from":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","to":"","fee":"0","date":1596827550,"block":105725526,"status":"completed","sequence":1594652,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Place Order","key":"place_order","token_id":"AVA-645","name":"BUY","symbol":"AVA","decimals":8,"value":"5500000000"}},{"id":"4B4EC65A5972CC15C4B410339ED0081C4BB57F4E08F2A35744845F5A71531B59","coin":714,"from":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","to":"","fee":"0","date":1596827537,"block":105725493,"status":"completed","sequence":1594648,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Place Order","key":"place_order","token_id":"AVA-645","name":"SELL","symbol":"AVA","decimals":8,"value":"1700000000"}},{"id":"86B5FFDDB06E0973A05434DDB858868A45EDA8B07E0D75125FEAEBA1093D4EA5","coin":714,"from":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","to":"","fee":"0","date":1596827535,"block":105725489,"status":"completed","sequence":1594647,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Place Order","key":"place_order","token_id":"AVA-645","name":"BUY","symbol":"AVA","decimals":8,"value":"11800000000"}},{"id":"D20757EB130E8146D1A12E869BE69EC2CB03193D8E62467327B09A5D73BBC6F8","coin":714,"from":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","to":"","fee":"0","date":1596827529,"block":105725473,"status":"completed","sequence":1594645,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Place Order","key":"place_order","token_id":"AVA-645","name":"SELL","symbol":"AVA","decimals":8,"value":"3300000000"}},{"id":"04291A7AE08A78C5E5AE216C67D17B6A4260950FFAB6FD3D1C1B991E3B7BB935","coin":714,"from":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","to":"","fee":"0","date":1596827527,"block":105725470,"status":"completed","sequence":1594644,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Place Order","key":"place_order","token_id":"AVA-645","name":"BUY","symbol":"AVA","decimals":8,"value":"14600000000"}},{"id":"31E16E3F53DD476475256C8D872AF3FD86DC44F3085EF87DDE3643E20C0CE624","coin":714,"from":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","to":"","fee":"0","date":1596827518,"block":105725448,"status":"completed","sequence":1594641,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Place Order","key":"place_order","token_id":"AVA-645","name":"SELL","symbol":"AVA","decimals":8,"value":"1800000000"}},{"id":"1D61A9E35893BB51DE4AE63FA6898FCAE14B6D48F7A2EFD0B51DCCACF6E36B88","coin":714,"from":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","to":"","fee":"0","date":1596827517,"block":105725446,"status":"completed","sequence":1594640,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Place Order","key":"place_order","token_id":"AVA-645","name":"BUY","symbol":"AVA","decimals":8,"value":"13700000000"}},{"id":"AB9E29B110844BD76F58B058CC20F1E13B37A1AAC4F09978BDE159F1B09B585E","coin":714,"from":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","to":"","fee":"0","date":1596827509,"block":105725425,"status":"completed","sequence":1594637,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Place Order","key":"place_order","token_id":"AVA-645","name":"SELL","symbol":"AVA","decimals":8,"value":"1500000000"}},{"id":"F81649BAF3D888E3772C8708D255FE91E0CDB2818006A1FC96FFBDAC24BDE675","coin":714,"from":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","to":"","fee":"0","date":1596827508,"block":105725422,"status":"completed","sequence":1594636,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Place Order","key":"place_order","token_id":"AVA-645","name":"BUY","symbol":"AVA","decimals":8,"value":"8700000000"}},{"id":"71B25BF61219BC87980EB67064C346801C01B00FFE52932799E28FB20DAF41D8","coin":714,"from":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","to":"","fee":"0","date":1596827499,"block":105725401,"status":"completed","sequence":1594633,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Place Order","key":"place_order","token_id":"AVA-645","name":"SELL","symbol":"AVA","decimals":8,"value":"1200000000"}},{"id":"3424BFCA860A878FDCF304AD414EDE2AB3B0FF258CA940CD1269F469ED7C422C","coin":714,"from":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","to":"","fee":"0","date":1596827490,"block":105725379,"status":"completed","sequence":1594630,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Place Order","key":"place_order","token_id":"AVA-645","name":"SELL","symbol":"AVA","decimals":8,"value":"7600000000"}},{"id":"46FFA039B955953D4F19BE7D56FA793B71F34BFB460D9B84A152B554E9219C44","coin":714,"from":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","to":"","fee":"0","date":1596827488,"block":105725375,"status":"completed","sequence":1594629,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Place Order","key":"place_order","token_id":"AVA-645","name":"BUY","symbol":"AVA","decimals":8,"value":"13900000000"}},{"id":"701100D3783744D633FF34A6E211CEB923EA9BCE6D87A64211801E71F71AEE03","coin":714,"from":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","to":"","fee":"0","date":1596827469,"block":105725327,"status":"completed","sequence":1594627,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Place Order","key":"place_order","token_id":"AVA-645","name":"SELL","symbol":"AVA","decimals":8,"value":"4900000000"}},{"id":"B12B30509B45CEFE526DAADDAC6399F902B30418859042D7A23F9A2FB311A6C8","coin":714,"from":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","to":"","fee":"0","date":1596827467,"block":105725323,"status":"completed","sequence":1594626,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Place Order","key":"place_order","token_id":"AVA-645","name":"BUY","symbol":"AVA","decimals":8,"value":"2700000000"}},{"id":"933819F35F0F89FB2D58DC50CD15FABB3DA0B817BFCD39A8DDC99DFEC1663F7D","coin":714,"from":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","to":"","fee":"0","date":1596827458,"block":105725301,"status":"completed","sequence":1594623,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Place Order","key":"place_order","token_id":"AVA-645","name":"SELL","symbol":"AVA","decimals":8,"value":"9500000000"}}]`
+	wantedBlockMulti          = `{"number":105529271,"txs":[{"id":"432FF828B1DC1C4DAF13A51B6AE7ADD9932B7FC6526C28F7FE9C905F95472820","coin":714,"from":"bnb1z35wusfv8twfele77vddclka9z84ugywug48gn","to":"","fee":"0","date":1596746326,"block":105529271,"status":"completed","sequence":11317170,"type":"any_action","direction":"outgoing","memo":"","metadata":{"coin":714,"title":"Cancel Order","key":"cancel_order","token_id":"","name":"","symbol":"","decimals":8,"value":"0"}},{"id":"CC7C3EF1407373FDA74B005E64683AB5865126DE93A5FAF755FF5CC948992067","coin":714,"from":"bnb15qced76xere38hmmpe644u5kd8v4lzl9gsex9w","to":"bnb15qced76xere38hmmpe644u5kd8v4lzl9gsex9w","fee":"60000","date":1596746326,"block":105529271,"status":"completed","sequence":2300,"type":"transfer","memo":"0","metadata":{"value":"1","symbol":"BNB","decimals":8}},{"id":"CC7C3EF1407373FDA74B005E64683AB5865126DE93A5FAF755FF5CC948992067","coin":714,"from":"bnb1t38ccns9var4ac4yj2ylmu99r9ecmggr8ye5e5","to":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","fee":"0","date":1596746326,"block":105529271,"status":"completed","sequence":2300,"type":"transfer","memo":"0","metadata":{"value":"39421249","symbol":"BNB","decimals":8}}]}`
 	wantedTxsResponse         = `{"tx":[{"txHash":"771B07C8D921B5995524C163E9D4504C31A9E07EC858263A53EA007484009C90","blockHeight":105722032,"txType":"TRANSFER","timeStamp":"2020-08-07T18:48:26.284Z","fromAddr":"bnb1d83u9afqw296ejw9jdfhc22f0ljr23nn7pradx","toAddr":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","value":"1.20740436","txAsset":"BNB","txFee":"0.00037500","proposalId":null,"txAge":104,"orderId":null,"code":0,"data":null,"confirmBlocks":0,"memo":"106890151","source":1,"sequence":33},{"txHash":"1C5682716D2D34DD01428AD8D4200081FBDA06CE886B77499F755E9D93B0FF20","blockHeight":105721942,"txType":"TRANSFER","timeStamp":"2020-08-07T18:47:49.895Z","fromAddr":"bnb1k9ktd79psysucucyxqcd5r9ahuuygk8gh3ly8q","toAddr":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","value":"2.29350524","txAsset":"BNB","txFee":"0.00037500","proposalId":null,"txAge":140,"orderId":null,"code":0,"data":null,"confirmBlocks":0,"memo":"103215089","source":2,"sequence":64},{"txHash":"C728F7C13977649FCDF9B8E983A9E3AA257EB5596DBCA2A2580584312B5AA535","blockHeight":105721925,"txType":"TRANSFER","timeStamp":"2020-08-07T18:47:43.111Z","fromAddr":"bnb155svs6sgxe55rnvs6ghprtqu0mh69kehphsppd","toAddr":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","value":"119.64120000","txAsset":"BNB","txFee":"0.00037500","proposalId":null,"txAge":147,"orderId":null,"code":0,"data":null,"confirmBlocks":0,"memo":"101045880","source":1,"sequence":23121},{"txHash":"794302F9C6562358581A3A8432AF82CD70DF0DA345C917BDE21DDF9A9D4B9DE7","blockHeight":105721913,"txType":"TRANSFER","timeStamp":"2020-08-07T18:47:38.279Z","fromAddr":"bnb14gk6m77tswyks9nnadm92mdy3066wj42t60z0l","toAddr":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","value":"4.56000000","txAsset":"BNB","txFee":"0.00037500","proposalId":null,"txAge":152,"orderId":null,"code":0,"data":null,"confirmBlocks":0,"memo":"100341541","source":1,"sequence":11228},{"txHash":"2827377A04E3B22DD654A02AD4BA50B3CB83973B6B9BA1A423ABD5045873850C","blockHeight":105721828,"txType":"TRANSFER","timeStamp":"2020-08-07T18:47:04.205Z","fromAddr":"bnb132gvg9gdthtaf6xgkk9jkmsep56fv62vg95unv","toAddr":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","value":"10.45498916","txAsset":"BNB","txFee":"0.00037500","proposalId":null,"txAge":186,"orderId":null,"code":0,"data":null,"confirmBlocks":0,"memo":"105772095","source":2,"sequence":4},{"txHash":"AACC80FDA9C45DCD7F6DBAA40A2EB600FF6EBB24A5A840578D4AB306C3427385","blockHeight":105721785,"txType":"TRANSFER","timeStamp":"2020-08-07T18:46:46.832Z","fromAddr":"bnb10y4hu5psc7hztlczrhzgghfjzufnqyfrrml3yn","toAddr":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","value":"87.50000000","txAsset":"BNB","txFee":"0.00037500","proposalId":null,"txAge":203,"orderId":null,"code":0,"data":null,"confirmBlocks":0,"memo":"109027392","source":1,"sequence":65},{"txHash":"66CF0F65442FD35A3B91CC2655CE60E565D13EEFC6BDB1C5AF9F9E484C8B6295","blockHeight":105721686,"txType":"TRANSFER","timeStamp":"2020-08-07T18:46:07.122Z","fromAddr":"bnb17g92armmr926kd88umh7u90vglq4ghjtku6ssc","toAddr":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","value":"8.78900000","txAsset":"BNB","txFee":"0.00037500","proposalId":null,"txAge":243,"orderId":null,"code":0,"data":null,"confirmBlocks":0,"memo":"107780643","source":0,"sequence":896},{"txHash":"81EA185BE754809E40FCBF1B07A0C389F39DECD38D56302D980534850996B144","blockHeight":105721490,"txType":"TRANSFER","timeStamp":"2020-08-07T18:44:48.958Z","fromAddr":"bnb12nq7fhh3t8q0m9s2n5gqwd9gcx0j85yn0h5zcu","toAddr":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","value":"44.98159221","txAsset":"BNB","txFee":"0.00037500","proposalId":null,"txAge":321,"orderId":null,"code":0,"data":null,"confirmBlocks":0,"memo":"101210049","source":0,"sequence":0},{"txHash":"24ED2153427ABACD7F6DB53A1339D9C2573D720FDE6EC45B0047CB146227FF9A","blockHeight":105721385,"txType":"TRANSFER","timeStamp":"2020-08-07T18:44:07.201Z","fromAddr":"bnb1s5qucugaxv7gkzyg4kacf225s6mhj0ysejn8jq","toAddr":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","value":"343.00000000","txAsset":"BNB","txFee":"0.00037500","proposalId":null,"txAge":363,"orderId":null,"code":0,"data":null,"confirmBlocks":0,"memo":"104298046","source":2,"sequence":40},{"txHash":"411A27CE85D0928BC5908DA5BA288CB52E152C1E54FA35042147A90FCEBAB29C","blockHeight":105721238,"txType":"TRANSFER","timeStamp":"2020-08-07T18:43:09.084Z","fromAddr":"bnb1erj09eqrnz06jv2acxhhy7s3k0q6w4hfmj9rey","toAddr":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","value":"0.06319074","txAsset":"BNB","txFee":"0.00037500","proposalId":null,"txAge":421,"orderId":null,"code":0,"data":null,"confirmBlocks":0,"memo":"107303874","source":2,"sequence":12},{"txHash":"25359F7277760004BBD42557B3E50F86F06B096478840E057C6F394BF63F6081","blockHeight":105720997,"txType":"TRANSFER","timeStamp":"2020-08-07T18:41:32.193Z","fromAddr":"bnb10y4hu5psc7hztlczrhzgghfjzufnqyfrrml3yn","toAddr":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","value":"10.90000000","txAsset":"BNB","txFee":"0.00037500","proposalId":null,"txAge":518,"orderId":null,"code":0,"data":null,"confirmBlocks":0,"memo":"109027392","source":1,"sequence":57},{"txHash":"1DB710AF4983D9CAFB24AC3DD7C2C6EBC7752F347E5A7599840AB3EFBD436C5D","blockHeight":105720867,"txType":"TRANSFER","timeStamp":"2020-08-07T18:40:39.623Z","fromAddr":"bnb10y4hu5psc7hztlczrhzgghfjzufnqyfrrml3yn","toAddr":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","value":"20.00000000","txAsset":"BNB","txFee":"0.00037500","proposalId":null,"txAge":570,"orderId":null,"code":0,"data":null,"confirmBlocks":0,"memo":"109027392","source":1,"sequence":56},{"txHash":"B7C799B9853ECA41F02241B67E294E2642AE88BBF551DE188A73C48D1A0E821D","blockHeight":105720816,"txType":"TRANSFER","timeStamp":"2020-08-07T18:40:19.123Z","fromAddr":"bnb1q2994t0djzsy0q2fc62jruxr22nxq6y8l4503l","toAddr":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","value":"2.95957149","txAsset":"BNB","txFee":"0.00037500","proposalId":null,"txAge":591,"orderId":null,"code":0,"data":null,"confirmBlocks":0,"memo":"108202494","source":0,"sequence":1},{"txHash":"865C098D021CC649FC83B2442092E4D6F0777488163E5797DA9D264C87F1F4F4","blockHeight":105720641,"txType":"TRANSFER","timeStamp":"2020-08-07T18:39:08.972Z","fromAddr":"bnb16xqcchlutsm8g6gk7wvlzukeu2sy9xt2u77056","toAddr":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","value":"13.73501799","txAsset":"BNB","txFee":"0.00037500","proposalId":null,"txAge":661,"orderId":null,"code":0,"data":null,"confirmBlocks":0,"memo":"102080722","source":1,"sequence":33},{"txHash":"1D4F777D1D227D57E4D4BAC3E83FB203147E7B0E7429E60A48A9053372359174","blockHeight":105720519,"txType":"TRANSFER","timeStamp":"2020-08-07T18:38:20.149Z","fromAddr":"bnb1t6tk66zncqqt7twnfyxx22fn9t3wz3nac5hkck","toAddr":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","value":"0.01490609","txAsset":"BNB","txFee":"0.00037500","proposalId":null,"txAge":710,"orderId":null,"code":0,"data":null,"confirmBlocks":0,"memo":"106456805","source":2,"sequence":14},{"txHash":"9A818687D52B4E9E0C5F962E6E464ECC6348D6A41AE2C5DD051A5EF71E15B1AC","blockHeight":105720307,"txType":"TRANSFER","timeStamp":"2020-08-07T18:36:55.571Z","fromAddr":"bnb1v47qw5acc72c34uwt7t9wm9ztqtg6678dmrssa","toAddr":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","value":"3.56700000","txAsset":"BNB","txFee":"0.00037500","proposalId":null,"txAge":794,"orderId":null,"code":0,"data":null,"confirmBlocks":0,"memo":"104471384","source":2,"sequence":6273},{"txHash":"6C7D4469525E44501ED03BA38A6D54A04DFD6AD2D13328761DA4602A3D777332","blockHeight":105720136,"txType":"TRANSFER","timeStamp":"2020-08-07T18:35:48.142Z","fromAddr":"bnb1tzet704pc6zjsl3xcwdexn6xlu0zc092y0n4ay","toAddr":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","value":"0.93900000","txAsset":"BNB","txFee":"0.00037500","proposalId":null,"txAge":862,"orderId":null,"code":0,"data":null,"confirmBlocks":0,"memo":"101245732","source":0,"sequence":721},{"txHash":"8C47875F09371B45C0A29D7C6EF308A1C499D1BBECD5A56E0561B40675AF2D26","blockHeight":105720042,"txType":"TRANSFER","timeStamp":"2020-08-07T18:35:11.247Z","fromAddr":"bnb1t7hpl286qgvsg08lvx6ac9ul0msy4k2ud8dukp","toAddr":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","value":"0.95193451","txAsset":"BNB","txFee":"0.00037500","proposalId":null,"txAge":899,"orderId":null,"code":0,"data":null,"confirmBlocks":0,"memo":"101186586","source":2,"sequence":1912},{"txHash":"39A48766F8ACC33B3BB1B4F442ABFDFBC695F440D72795794A01E8E446CE8EAE","blockHeight":105720010,"txType":"TRANSFER","timeStamp":"2020-08-07T18:34:58.634Z","fromAddr":"bnb10y4hu5psc7hztlczrhzgghfjzufnqyfrrml3yn","toAddr":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","value":"4.80000000","txAsset":"BNB","txFee":"0.00037500","proposalId":null,"txAge":911,"orderId":null,"code":0,"data":null,"confirmBlocks":0,"memo":"109027392","source":1,"sequence":47},{"txHash":"54248E3C09713E3870F661749A8690DAD46A8078C7346C497EB4E166564420F4","blockHeight":105719860,"txType":"TRANSFER","timeStamp":"2020-08-07T18:33:57.102Z","fromAddr":"bnb1hc304lytvp9jnumnjmppq97erm70r7muzv6y98","toAddr":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","value":"102.10962500","txAsset":"BNB","txFee":"0.00037500","proposalId":null,"txAge":973,"orderId":null,"code":0,"data":null,"confirmBlocks":0,"memo":"105261446","source":0,"sequence":1},{"txHash":"ADDDB705AB8C2B1AF58D7EDCAEC00C893A6CD87281D7A7146689E9FE1A846EAE","blockHeight":105719705,"txType":"TRANSFER","timeStamp":"2020-08-07T18:32:53.527Z","fromAddr":"bnb17g92armmr926kd88umh7u90vglq4ghjtku6ssc","toAddr":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","value":"2.97900000","txAsset":"BNB","txFee":"0.00037500","proposalId":null,"txAge":1036,"orderId":null,"code":0,"data":null,"confirmBlocks":0,"memo":"103617121","source":0,"sequence":895},{"txHash":"7C338C78BBBCD998628CCAF85211883AACB159201755501139F56E0D2A7941A4","blockHeight":105719641,"txType":"TRANSFER","timeStamp":"2020-08-07T18:32:27.476Z","fromAddr":"bnb1pys084nlc8gqm7lvjje4kt39dn84vd2xsvnwqa","toAddr":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","value":"2.00000000","txAsset":"BNB","txFee":"0.00037500","proposalId":null,"txAge":1062,"orderId":null,"code":0,"data":null,"confirmBlocks":0,"memo":"108802310","source":0,"sequence":18},{"txHash":"6DD7120E43CD5031E2DD6C7977D141B74CA41C8E476D8AE972A9DA2D2EFD2D84","blockHeight":105719428,"txType":"TRANSFER","timeStamp":"2020-08-07T18:31:01.143Z","fromAddr":"bnb17g92armmr926kd88umh7u90vglq4ghjtku6ssc","toAddr":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","value":"6.77900000","txAsset":"BNB","txFee":"0.00037500","proposalId":null,"txAge":1149,"orderId":null,"code":0,"data":null,"confirmBlocks":0,"memo":"102393444","source":0,"sequence":894},{"txHash":"86FB14C637D7B0A4AA123FFF76C5C541C70568B67E3F5BC63EB0FDEDEF30301F","blockHeight":105719403,"txType":"TRANSFER","timeStamp":"2020-08-07T18:30:51.089Z","fromAddr":"bnb17nak8gnucl6lcze0d04def4de77a33qh29f6ur","toAddr":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","value":"0.00900000","txAsset":"BNB","txFee":"0.00037500","proposalId":null,"txAge":1159,"orderId":null,"code":0,"data":null,"confirmBlocks":0,"memo":"109823910","source":2,"sequence":3},{"txHash":"1DEEFC0A838F80704574951DF15969CCE34654BE2007BF77F9521A1B4686BE33","blockHeight":105719312,"txType":"TRANSFER","timeStamp":"2020-08-07T18:30:14.498Z","fromAddr":"bnb1yjlk7f47qf0z97ph6pxc00s2s0yw048edmtjtw","toAddr":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","value":"3.15739000","txAsset":"BNB","txFee":"0.00037500","proposalId":null,"txAge":1195,"orderId":null,"code":0,"data":null,"confirmBlocks":0,"memo":"103268674","source":2,"sequence":1431}],"total":1636}`
 	wantedAccountMetaResponse = `{"account_number":398176,"address":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","balances":[{"free":"366.87270502","frozen":"0.00000000","locked":"2226.00000000","symbol":"AVA-645"},{"free":"6.51198688","frozen":"0.00000000","locked":"0.00000000","symbol":"BNB"},{"free":"850.41375978","frozen":"0.00000000","locked":"3220.00483000","symbol":"BUSD-BD1"}],"flags":0,"public_key":[2,241,253,162,68,84,67,180,235,15,238,212,39,75,236,33,202,249,109,68,247,56,104,66,240,219,8,22,245,187,84,46,54],"sequence":1595533}`
 	wantedTokensResponse      = `[{"mintable":true,"name":"Africa Stable-Coin","original_symbol":"ABCD","owner":"bnb1ujvzeuft0ezf9fu4u0mk52t8mc7t8geyfkevms","symbol":"ABCD-5D8","total_supply":"3000000.00000000"},{"mintable":false,"name":"Aditus","original_symbol":"ADI","owner":"bnb1djdymfgzknmcsu9dzm9s0uavdszn0cl82z4hps","symbol":"ADI-6BB","total_supply":"750000000.00000000"},{"mintable":false,"name":"Aergo","original_symbol":"AERGO","owner":"bnb1llqhwwwmh878844tm3g8v47k0t7xtnhl4hggjl","symbol":"AERGO-46B","total_supply":"500000000.00000000"},{"mintable":false,"name":"Alaris","original_symbol":"ALA","owner":"bnb1pmdkvw6cquwylr46wcrl82xzmul0y2jpj5cwx7","symbol":"ALA-DCD","total_supply":"60000000.00000000"},{"mintable":false,"name":"ANKR","original_symbol":"ANKR","owner":"bnb1hvg059mkwleum35j6y2qjn4fvmgl7zxtlah4tn","symbol":"ANKR-E97","total_supply":"10000000000.00000000"},{"mintable":false,"name":"Aeron","original_symbol":"ARN","owner":"bnb1dq8ae0ayztqp99peggq5sygzf3n7u2ze4t0jne","symbol":"ARN-71B","total_supply":"20000000.00000000"},{"mintable":true,"name":"ARPA","original_symbol":"ARPA","owner":"bnb1mecnt25u3j9ne7th5av7hqvnmzvyrr7ny8hg8c","symbol":"ARPA-575","total_supply":"12000000.00000000"},{"mintable":false,"name":"Maecenas ART Token","original_symbol":"ART","owner":"bnb13plj9kycvcew5v0achpatnd5l5pacys9h0gu8l","symbol":"ART-3C9","total_supply":"100000000.00000000"},{"mintable":true,"name":"Atlas Protocol","original_symbol":"ATP","owner":"bnb1msw3avv894nlpeu0vn4qlkl0r65a3rp7gtz5hf","symbol":"ATP-38C","total_supply":"40000000.00000000"},{"mintable":false,"name":"Travala.com Token","original_symbol":"AVA","owner":"bnb1dm9c7gccgd07td5r69m50u8fg8danfgqvlhj6c","symbol":"AVA-645","total_supply":"61228716.00000000"},{"mintable":true,"name":"“Atomic","original_symbol":"AWC","owner":"bnb1g5xj69c0s0x646hug7j3vr6eamlkf7jw3cr3yw","symbol":"AWC-8B2","total_supply":"147.00000000"},{"mintable":false,"name":"Atomic Wallet Token","original_symbol":"AWC","owner":"bnb1g5xj69c0s0x646hug7j3vr6eamlkf7jw3cr3yw","symbol":"AWC-986","total_supply":"50000000.00000000"},{"mintable":false,"name":"AXPR.B","original_symbol":"AXPR","owner":"bnb1zpnmet0vhfupn9ysu26gukzj7a2xkkcry22n9t","symbol":"AXPR-777","total_supply":"347955111.02000000"},{"mintable":true,"name":"BAWnetwork","original_symbol":"BAW","owner":"bnb1umdp5z4hugur26tcgf48fhr0548fv0q0fga84u","symbol":"BAW-DFB","total_supply":"25000000000.00000000"},{"mintable":true,"name":"BCH BEP2","original_symbol":"BCH","owner":"bnb15tjhzw85wyywwp7zvc4l3ux3j0393rzp9exl0p","symbol":"BCH-1FD","total_supply":"5000.00000000"},{"mintable":false,"name":"Blockmason Credit Protocol","original_symbol":"BCPT","owner":"bnb1ed7sfac04uzkg8lsxmgl7sxlj8pvyrpnyjm9ew","symbol":"BCPT-95A","total_supply":"116158667.00000000"},{"mintable":true,"name":"3X Short Bitcoin Token","original_symbol":"BEAR","owner":"bnb1ff4r0t7j8ll8lf3gm2ltdu3hjy4w690j7vvees","symbol":"BEAR-14C","total_supply":"50301.00000000"},{"mintable":false,"name":"EOSBet Token","original_symbol":"BET","owner":"bnb1rgylg0f3ka24a63rnq926quvet438fxrz3320c","symbol":"BET-844","total_supply":"88000000.00000000"},{"mintable":false,"name":"BETX Token","original_symbol":"BETX","owner":"bnb15v9e3c4wy8vpex0c5fj702lexjesh30v2203f2","symbol":"BETX-A0C","total_supply":"200000000.00000000"},{"mintable":true,"name":"Binance GBP Stable Coin","original_symbol":"BGBP","owner":"bnb1r4ag7kd90rptlhcuuc8trh60v4m4vvzrfyecta","symbol":"BGBP-CF3","total_supply":"200.00000000"},{"mintable":true,"name":"Humanity First Token","original_symbol":"BHFT","owner":"bnb148t3u8zxa44vhydes5qa8xnxuzuq6zgyxmzt6d","symbol":"BHFT-BBE","total_supply":"636425000.00000000"},{"mintable":true,"name":"BIDR BEP2","original_symbol":"BIDR","owner":"bnb1v7hlk89x4t6wtfx89wrvhxj5wcv7sxjrve6dav","symbol":"BIDR-0E9","total_supply":"13700000000.00000000"},{"mintable":false,"name":"Bitwires Token","original_symbol":"BKBT","owner":"bnb104p50kz2uvep5s5u6j0lr6vkl6rp5g4653d7w4","symbol":"BKBT-3A6","total_supply":"10000000000.00000000"},{"mintable":true,"name":"Binance KRW","original_symbol":"BKRW","owner":"bnb18kha55gvsxl7gkdh8y329hu3p6wndh6jkwqnxn","symbol":"BKRW-AB7","total_supply":"1571020711.00000000"},{"mintable":false,"name":"Blockmason Link","original_symbol":"BLINK","owner":"bnb1ed7sfac04uzkg8lsxmgl7sxlj8pvyrpnyjm9ew","symbol":"BLINK-9C6","total_supply":"5000000000.00000000"},{"mintable":false,"name":"Binance Chain Native Token","original_symbol":"BNB","owner":"bnb1ultyhpw2p2ktvr68swz56570lgj2rdsadq3ym2","symbol":"BNB","total_supply":"176406560.90000000"},{"mintable":false,"name":"BOLT Token","original_symbol":"BOLT","owner":"bnb177ujwmshxu8r9za4vy9ztqn65tmr54ddw958rt","symbol":"BOLT-4C6","total_supply":"980230000.00000000"},{"mintable":false,"name":"Bitcloud Pro","original_symbol":"BPRO","owner":"bnb1482svhhrffpga5wmqw8068af4c9u2q9dp3hg4m","symbol":"BPRO-5A6","total_supply":"5000000000.00000000"},{"mintable":true,"name":"BQTX","original_symbol":"BQTX","owner":"bnb1j42h6j40htujnjmtp4ckw4zx27vp0f93cvmua8","symbol":"BQTX-235","total_supply":"1000000.00000000"},{"mintable":false,"name":"BOOSTO","original_symbol":"BST2","owner":"bnb19k2av7cmdvp9f0qkeu5vfl59yp8ftqv2s55dzs","symbol":"BST2-2F2","total_supply":"500000000.00000000"},{"mintable":true,"name":"Bitcoin BEP2","original_symbol":"BTCB","owner":"bnb1akey87kt0r8y3fmhu2l8eyzdjvt9ptl5cppz0v","symbol":"BTCB-1DE","total_supply":"9001.00000000"},{"mintable":true,"name":"BTTB","original_symbol":"BTTB","owner":"bnb1srm577fgsjg363vsqt8td4tat5arzfvkjchqgq","symbol":"BTTB-D31","total_supply":"1000000000.00000000"},{"mintable":true,"name":"3x Long Bitcoin Token","original_symbol":"BULL","owner":"bnb1ff4r0t7j8ll8lf3gm2ltdu3hjy4w690j7vvees","symbol":"BULL-BE4","total_supply":"604.80000000"},{"mintable":true,"name":"Binance USD","original_symbol":"BUSD","owner":"bnb19v2ayq6k6e5x6ny3jdutdm6kpqn3n6mxheegvj","symbol":"BUSD-BD1","total_supply":"26000000.00000000"},{"mintable":false,"name":"Bezant Token","original_symbol":"BZNT","owner":"bnb1w5a5jywe3cu20uq6n6x3vmzcq342s6st4cz73s","symbol":"BZNT-464","total_supply":"964511442.00000000"},{"mintable":false,"name":"CanYaCoin","original_symbol":"CAN","owner":"bnb16w59lfh4y2cqvu8f7yr000ll37ldh4w6hnz7l0","symbol":"CAN-677","total_supply":"95827000.00000000"},{"mintable":false,"name":"CASHAA","original_symbol":"CAS","owner":"bnb1xkw2sagpx6t0cmwzapxpv94tupvqk7tpgy72ku","symbol":"CAS-167","total_supply":"1000000000.00000000"},{"mintable":false,"name":"Cubiex","original_symbol":"CBIX","owner":"bnb1jlm66w38gpfuqr4s2jcfwlcrlx46p05thdnv7g","symbol":"CBIX-3C9","total_supply":"150000000.00000000"},{"mintable":false,"name":"CryptoBonusMiles","original_symbol":"CBM","owner":"bnb1dq8ae0ayztqp99peggq5sygzf3n7u2ze4t0jne","symbol":"CBM-4B2","total_supply":"5000000000.00000000"},{"mintable":false,"name":"Clipper Coin","original_symbol":"CCCX","owner":"bnb1ry99rte8gfnn9c6at9mlmrrq6p2k4u7732j9h7","symbol":"CCCX-10D","total_supply":"5000000000.00000000"},{"mintable":false,"name":"Chiliz","original_symbol":"CHZ","owner":"bnb1cghr4z8ag440tv4wnk3l6wzynytlpvfqltm9ph","symbol":"CHZ-ECD","total_supply":"8888888888.00000000"},{"mintable":false,"name":"Crypto Neo-value Neural System","original_symbol":"CNNS","owner":"bnb193wdp4gdnm58urnsjf8nv57lxt58sckt2k50ss","symbol":"CNNS-E16","total_supply":"10000000000.00000000"},{"mintable":false,"name":"Contentos","original_symbol":"COS","owner":"bnb1u9j9hkst6gf09dkdvxlj7puk8c7vh68a0kkmht","symbol":"COS-2E4","total_supply":"9400000000.00000000"},{"mintable":true,"name":"COTI","original_symbol":"COTI","owner":"bnb1kn733gkku9xsqkuk6wcz86gftqtl4qvthvrj5m","symbol":"COTI-CBB","total_supply":"80000000.00000000"},{"mintable":false,"name":"Covalent Token","original_symbol":"COVA","owner":"bnb1pucvxaf3l9rslupza75r9fca9h5892ntumszfm","symbol":"COVA-218","total_supply":"6500000000.00000000"},{"mintable":false,"name":"CPChain","original_symbol":"CPC","owner":"bnb1wq4rlwrmvvltlarvypql8wrr4vlh0dczd8uksg","symbol":"CPC-FED","total_supply":"150000000.00000000"},{"mintable":false,"name":"Crypterium Token","original_symbol":"CRPT","owner":"bnb17fk3uvagucxzpvmdvd373fapqsahxvzevdard9","symbol":"CRPT-8C9","total_supply":"99968575.14285720"},{"mintable":false,"name":"“Consentium”","original_symbol":"CSM","owner":"bnb1gguz7vcrlf7a87et8u5gt40f0890qvkpkn9y79","symbol":"CSM-734","total_supply":"84000000.00000000"},{"mintable":true,"name":"Carbon Dollar","original_symbol":"CUSD","owner":"bnb1y9797dtklkm3haajsfnevm9ruuxs5fyf5rpj67","symbol":"CUSD-24B","total_supply":"9999999999.00000000"},{"mintable":false,"name":"Konstellation Network","original_symbol":"DARC","owner":"bnb1gyhnhdns4vf63nfzfq7g25czj8swjgrz3rhah8","symbol":"DARC-24B","total_supply":"1000000000.00000000"},{"mintable":true,"name":"DeepCloud","original_symbol":"DEEP","owner":"bnb1t0ws9gvnjm7j7qssk8te7m2dt5hmm8s3amqk2d","symbol":"DEEP-9D3","total_supply":"200000000.00000000"},{"mintable":false,"name":"DeFi Token","original_symbol":"DEFI","owner":"bnb1q5xefr07503pqtfrl5sfyyhlghxwc80d4vpas2","symbol":"DEFI-FA5","total_supply":"2500000000.00000000"},{"mintable":true,"name":"DOS Network Token","original_symbol":"DOS","owner":"bnb13gse9n7mvrjg5w2cymnt4nmxkgj200k9k2l2nh","symbol":"DOS-120","total_supply":"1000000000.00000000"},{"mintable":false,"name":"DREP","original_symbol":"DREP","owner":"bnb1ez5s9v4rcgsmhwr4fkrnlv6zwsukjnh4y754kn","symbol":"DREP-7D2","total_supply":"10000000000.00000000"},{"mintable":true,"name":"Dusk Network","original_symbol":"DUSK","owner":"bnb1dfls6c8y39l7qq4gj2479wkehg85pt5m07y94g","symbol":"DUSK-45E","total_supply":"50000000.00000000"},{"mintable":false,"name":"eBoost","original_symbol":"EBST","owner":"bnb1pmdkvw6cquwylr46wcrl82xzmul0y2jpj5cwx7","symbol":"EBST-783","total_supply":"80838159.07000000"},{"mintable":true,"name":"Ormeus Ecosystem","original_symbol":"ECO","owner":"bnb1tr49nv08k828n2lqfw0vrgvwj7xtep5kg8wr4c","symbol":"ECO-083","total_supply":"5000000000.00000000"},{"mintable":false,"name":"Energy Eco Token","original_symbol":"EET","owner":"bnb1pt353m8ygvvgy4f2ud9xx85tl7fqewkrksh6r5","symbol":"EET-45C","total_supply":"600000000.00000000"},{"mintable":false,"name":"Hut34 Entropy","original_symbol":"ENTRP","owner":"bnb1wu0hu9pelx3yvplysx0je7d93htcandpj86aev","symbol":"ENTRP-C8D","total_supply":"100000000.00000000"},{"mintable":true,"name":"EOS BEP2","original_symbol":"EOS","owner":"bnb1la8alalwjzkchd67wza3r75lj5rm7m9e85ffqr","symbol":"EOS-CDD","total_supply":"500000.00000000"},{"mintable":true,"name":"3X Short EOS Token","original_symbol":"EOSBEAR","owner":"bnb1ff4r0t7j8ll8lf3gm2ltdu3hjy4w690j7vvees","symbol":"EOSBEAR-721","total_supply":"32301.00000000"},{"mintable":true,"name":"3X Long EOS Token","original_symbol":"EOSBULL","owner":"bnb1ff4r0t7j8ll8lf3gm2ltdu3hjy4w690j7vvees","symbol":"EOSBULL-F0D","total_supply":"456191.00000000"},{"mintable":false,"name":"EQUAL","original_symbol":"EQL","owner":"bnb1uz0s54rzv022dh66l7atwk83wqcet9qstgg358","symbol":"EQL-586","total_supply":"675259060.00000000"},{"mintable":true,"name":"Elrond","original_symbol":"ERD","owner":"bnb1m5uzzfxs7x05sl28gg96zyecn9jwgtkpyeftyn","symbol":"ERD-D06","total_supply":"13000000000.00000000"},{"mintable":true,"name":"ETH BEP2","original_symbol":"ETH","owner":"bnb1yss2345dphss8c823dh2jzje2w8k8x4jguuxhf","symbol":"ETH-1C9","total_supply":"10000.00000000"},{"mintable":true,"name":"3X Short Ethereum Token","original_symbol":"ETHBEAR","owner":"bnb1ff4r0t7j8ll8lf3gm2ltdu3hjy4w690j7vvees","symbol":"ETHBEAR-B2B","total_supply":"61821.00000000"},{"mintable":true,"name":"3X Long Ethereum Token","original_symbol":"ETHBULL","owner":"bnb1ff4r0t7j8ll8lf3gm2ltdu3hjy4w690j7vvees","symbol":"ETHBULL-D33","total_supply":"33684.00000000"},{"mintable":true,"name":"everiToken","original_symbol":"EVT","owner":"bnb1v3fl4kuwuhzf3g7ghscsq7uzmu5dw50waseptd","symbol":"EVT-49B","total_supply":"1000000000.00000000"},{"mintable":true,"name":"The Force Token","original_symbol":"FOR","owner":"bnb1c46nhwdwm3u2mlfhx6t07fls25shnvktpr9w9m","symbol":"FOR-997","total_supply":"100000000.00000000"},{"mintable":false,"name":"Ferrum Network Token","original_symbol":"FRM","owner":"bnb1um8ntkgwle8yrdk0yn5hwdf7hckjpyjjg29k2p","symbol":"FRM-DE7","total_supply":"164609374.50000000"},{"mintable":false,"name":"Fusion","original_symbol":"FSN","owner":"bnb17mnutyduat9fe02r2dawp3kn4rnaqamp5kpg0c","symbol":"FSN-E14","total_supply":"57344000.00000000"},{"mintable":true,"name":"Fantom","original_symbol":"FTM","owner":"bnb1f6sxnf3nhn9fcfwkuccrzvl2pgu3sq0m8pyjhw","symbol":"FTM-A64","total_supply":"952500000.00000000"},{"mintable":true,"name":"FTX Token","original_symbol":"FTT","owner":"bnb1msxdh7e7smpg68gxxhs0p3fhuj9tzhrxa4c2x2","symbol":"FTT-F11","total_supply":"10000000.00000000"},{"mintable":true,"name":"Givly Coin","original_symbol":"GIV","owner":"bnb13jzr6sqz72fl0edg2tpqp8tddyzvyt4su2490m","symbol":"GIV-94E","total_supply":"1000000000.00000000"},{"mintable":false,"name":"GoWithMi","original_symbol":"GMAT","owner":"bnb1yltla9mnk8999ygmjjn3kwmmz2zs94a9v20sca","symbol":"GMAT-FC8","total_supply":"14900000000.00000000"},{"mintable":false,"name":"Global Gaming","original_symbol":"GMNG","owner":"bnb1qe6zxqptfxw0kh38t8pg6c3qa527n2x2a87qvm","symbol":"GMNG-F3E","total_supply":"5000000000.00000000"},{"mintable":false,"name":"GTEX","original_symbol":"GTEX","owner":"bnb1nksrzfl24he9xtvdvpypsl6r5jnh5x2uf9s82z","symbol":"GTEX-71B","total_supply":"4000000000.00000000"},{"mintable":false,"name":"Gifto","original_symbol":"GTO","owner":"bnb1lvp8k3zenlfp2pl2nyaf428xjgh385m258gzvq","symbol":"GTO-908","total_supply":"1000000000.00000000"},{"mintable":true,"name":"“Hermes","original_symbol":"HEC","owner":"bnb1dfyydqkmsv5m0rs0pa4uut2gwrcsahppktns2t","symbol":"HEC-1A9","total_supply":"100000000.00000000"},{"mintable":false,"name":"Honest","original_symbol":"HNST","owner":"bnb1k9fv2hz0w3l9v9z4g9samg3gtc7nc2xgyqw5u0","symbol":"HNST-3C9","total_supply":"400000000.00000000"},{"mintable":true,"name":"Hyperion Token","original_symbol":"HYN","owner":"bnb1q5cqecuy2g7syl8fssp9a7v2sjamtrzlr3pa0n","symbol":"HYN-F21","total_supply":"10000000000.00000000"},{"mintable":true,"name":"Rupiah Token","original_symbol":"IDRTB","owner":"bnb1wc44duax6pygh23psx0u945skvs3eh7w59e4sp","symbol":"IDRTB-178","total_supply":"90000000000.00000000"},{"mintable":false,"name":"IKU","original_symbol":"IKU","owner":"bnb1f52tc9l0qg337qtgu4n024ayllc78wxpc5xhvd","symbol":"IKU-416","total_supply":"300000000.00000000"},{"mintable":true,"name":"IRIS Network","original_symbol":"IRIS","owner":"bnb1dcpm0jjj8el8g6ekr3mvjxa8kptgu4e5xzvqv8","symbol":"IRIS-D88","total_supply":"2000000000.00000000"},{"mintable":false,"name":"JDXUCoin","original_symbol":"JDXU","owner":"bnb1dwcsg0t86g7935zpxc054n97styzgdtnu2kzg6","symbol":"JDXU-706","total_supply":"1000000000.00000000"},{"mintable":false,"name":"Kambria Token","original_symbol":"KAT","owner":"bnb1l68n6equtr925lhnentyq54zfrzqyj45lg8uwj","symbol":"KAT-7BB","total_supply":"3700000000.00000000"},{"mintable":true,"name":"Kava BEP2 Token","original_symbol":"KAVA","owner":"bnb1uyekdn62yur9zuctzqyd9ckasfvqttjz9c33me","symbol":"KAVA-10C","total_supply":"271190.72181900"},{"mintable":false,"name":"Sessia Kicks","original_symbol":"KICKS","owner":"bnb130tmwjd3fc79eh6f5ezl2326ur8rqpsxeeq30x","symbol":"KICKS-162","total_supply":"5000000.00000000"},{"mintable":true,"name":"Lambda","original_symbol":"LAMB","owner":"bnb19vnwdjwthm9unxe9hxdxmgm6qw0d42d2lmcesw","symbol":"LAMB-46C","total_supply":"5000000.00000000"},{"mintable":false,"name":"Lend-Borrow-Asset","original_symbol":"LBA","owner":"bnb1m8r74hr532lfwtaf5e88cxeakd36ut0ufpd4yu","symbol":"LBA-340","total_supply":"1000000000.00000000"},{"mintable":true,"name":"LITION","original_symbol":"LIT","owner":"bnb1fhlxwqlwd7cm5fmurg0wmsaalshnp7lwu46nk9","symbol":"LIT-099","total_supply":"145061313.45061312"},{"mintable":true,"name":"Loki","original_symbol":"LOKI","owner":"bnb1j5sft8wp7tktjwauy30x79f3tqa53fycmgxxs0","symbol":"LOKI-6A9","total_supply":"3000000.00000000"},{"mintable":true,"name":"LTC BEP2","original_symbol":"LTC","owner":"bnb1cn4sqm79wqmr8rey923r34cp2wrtyhlr9easpg","symbol":"LTC-F07","total_supply":"18500.00000000"},{"mintable":false,"name":"LTO Network","original_symbol":"LTO","owner":"bnb1ac6p45m00pv36y9mu48e5xr73fyxke3zv2rhmq","symbol":"LTO-BDF","total_supply":"500000000.00000000"},{"mintable":false,"name":"LYFE","original_symbol":"LYFE","owner":"bnb1k0779dltjkl6a05v5uq06zym62hcufzcqu6gq7","symbol":"LYFE-6AB","total_supply":"231250000.00000000"},{"mintable":false,"name":"Matic Token","original_symbol":"MATIC","owner":"bnb1a6nkf3g7c2z0jcrqhp8c9upcwmme0y49qx58nz","symbol":"MATIC-84A","total_supply":"10000000000.00000000"},{"mintable":false,"name":"Moviebloc","original_symbol":"MBL","owner":"bnb17p8rc0z5vlysff2wc7xehff464dm0v7nhl27xq","symbol":"MBL-2D2","total_supply":"30000000000.00000000"},{"mintable":true,"name":"Mcashchain","original_symbol":"MCASH","owner":"bnb1q420q7qpyv7tghfp6aac7vnjq74dhkeutdhqsg","symbol":"MCASH-869","total_supply":"200000000.00000000"},{"mintable":false,"name":"Magic Cube Token","original_symbol":"MCC","owner":"bnb14nt79d6hzhjefkys2cgrc9nrzugdjwwtggfmu4","symbol":"MCC-33B","total_supply":"20000000000.00000000"},{"mintable":false,"name":"MDAB","original_symbol":"MDAB","owner":"bnb1m3edd4q4nd3wxg9vm3xe8pnfnetu5yjmhtnrqz","symbol":"MDAB-D42","total_supply":"1000000000.00000000"}]`
@@ -83,5 +82,16 @@ func createMockedAPI() http.Handler {
 		}
 	})
 
+	r.HandleFunc("/v1/trades", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		response := `{"total":0,"trade":[]}`
+		if r.URL.Query().Get("height") == "104867508" {
+			response = mockedBlockTradesResponse
+		}
+		if _, err := fmt.Fprint(w, response); err != nil {
+			panic(err)
+		}
+	})
+
 	return r
 }
@@ -2,6 +2,7 @@ package binance
 
 import (
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/logger"
 )
 
 func (p *Platform) CurrentBlockNumber() (int64, error) {
@@ -18,5 +19,15 @@ func (p *Platform) GetBlockByNumber(num int64) (*blockatlas.Block, error) {
 		return nil, err
 	}
 	block := normalizeBlock(transactionInBlockResponse)
+	if !hasOrders(transactionInBlockResponse.Tx) {
+		return &block, nil
+	}
+	// The trades are best effort, the transfers of the block are returned without them
+	trades, err := p.client.FetchTradesInBlock(num)
+	if err != nil {
+		logger.Error(err, "Failed to fetch the trades of the block", logger.Params{"block": num})
+		return &block, nil
+	}
+	block.Txs = append(block.Txs, normalizeBlockTrades(trades)...)
 	return &block, nil
 }
@@ -3,6 +3,8 @@ package binance
 import (
 	"encoding/json"
 	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"net/http"
 	"net/http/httptest"
 	"testing"
 )
@@ -10,7 +12,7 @@ import (
 func TestPlatform_CurrentBlockNumber(t *testing.T) {
 	server := httptest.NewServer(createMockedAPI())
 	defer server.Close()
-	p := Init(server.URL)
+	p := Init(server.URL, server.URL)
 	number, err := p.CurrentBlockNumber()
 	assert.Nil(t, err)
 	assert.Equal(t, int64(104867535), number)
@@ -19,16 +21,42 @@ func TestPlatform_CurrentBlockNumber(t *testing.T) {
 func TestPlatform_GetBlockByNumber(t *testing.T) {
 	server := httptest.NewServer(createMockedAPI())
 	defer server.Close()
-	p := Init(server.URL)
+	p := Init(server.URL, server.URL)
 	block, err := p.GetBlockByNumber(104867508)
 	assert.Nil(t, err)
+	if assert.Len(t, block.Txs, 5) {
+		buy, sell := block.Txs[3], block.Txs[4]
+		assert.Equal(t, blockatlas.TxTokenSwap, buy.Type)
+		assert.Equal(t, "bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg", buy.From)
+		assert.Equal(t, "bnb1l83kstts7lt9dpgawzechnrgjq54dql36dyspc", sell.From)
+		assert.Equal(t, buy.ID, sell.ID)
+		block.Txs = block.Txs[:3]
+	}
 	res, err := json.Marshal(block)
 	assert.Nil(t, err)
-	assert.Equal(t, wantedBlockNoOrders, string(res))
+	assert.Equal(t, wantedBlock, string(res))
 
 	blockMulti, err := p.GetBlockByNumber(105529271)
 	assert.Nil(t, err)
 	resMulti, err := json.Marshal(blockMulti)
 	assert.Nil(t, err)
-	assert.Equal(t, wantedBlockMultiNoOrders, string(resMulti))
+	assert.Equal(t, wantedBlockMulti, string(resMulti))
+}
+
+func TestPlatform_GetBlockByNumber_TradesUnavailable(t *testing.T) {
+	api := createMockedAPI()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/trades" {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		api.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+	p := Init(server.URL, server.URL)
+	block, err := p.GetBlockByNumber(104867508)
+	assert.Nil(t, err)
+	res, err := json.Marshal(block)
+	assert.Nil(t, err)
+	assert.Equal(t, wantedBlock, string(res))
 }
@@ -65,6 +65,43 @@ func (c Client) FetchTransactionsByAddressAndTokenID(address, tokenID string) ([
 	return result.Tx, nil
 }
 
+// FetchTradesByAddress returns the trades of the last 3 months, they are cached for a minute
+// so the history of every token of the address is served by one call
+func (c Client) FetchTradesByAddress(address string) ([]Trade, error) {
+	key := "trades:" + address
+	if cached, ok := c.Cache.Get(key); ok {
+		return cached.([]Trade), nil
+	}
+	startTime := strconv.Itoa(int(time.Now().AddDate(0, -3, 0).Unix() * 1000))
+	limit := strconv.Itoa(blockatlas.TxPerPage)
+	params := url.Values{"address": {address}, "start": {startTime}, "limit": {limit}}
+	trades, err := c.fetchTrades(params)
+	if err != nil {
+		return nil, err
+	}
+	c.Cache.Set(key, trades, tradesCacheTTL)
+	return trades, nil
+}
+
+func (c Client) FetchTradesInBlock(blockNumber int64) ([]Trade, error) {
+	params := url.Values{"height": {strconv.FormatInt(blockNumber, 10)}, "limit": {tradesLimit}}
+	return c.fetchTrades(params)
+}
+
+func (c Client) fetchTrades(params url.Values) ([]Trade, error) {
+	resp, err := req.Get(c.url+"/v1/trades", params)
+	if err != nil {
+		return nil, err
+	}
+	var result TradesResponse
+	if err := resp.ToJSON(&result); err != nil {
+		logger.Error("URL: " + resp.Request().URL.String())
+		logger.Error("Status code: " + resp.Response().Status)
+		return nil, err
+	}
+	return result.Trades, nil
+}
+
 func (c Client) FetchAccountMeta(address string) (AccountMeta, error) {
 	resp, err := req.Get(c.url+fmt.Sprintf("/v1/account/%s", address), nil)
 	if err != nil {
@@ -98,3 +135,32 @@ func (c Client) FetchTokens() (Tokens, error) {
 	c.Cache.Set("tokens", *result, cache.DefaultExpiration)
 	return *result, nil
 }
+
+// StakingClient reads the delegations of Binance Chain to the validators of Binance Smart Chain
+type StakingClient struct {
+	blockatlas.Request
+}
+
+func (c StakingClient) GetValidators() ([]StakingValidator, error) {
+	var result StakingValidatorsResponse
+	query := url.Values{"limit": {strconv.Itoa(stakingPerPage)}, "offset": {"0"}}
+	path := fmt.Sprintf("v1/staking/chains/%s/validators", stakingChain)
+	err := c.GetWithCache(&result, path, query, time.Minute*10)
+	return result.Validators, err
+}
+
+func (c StakingClient) GetDelegations(address string) ([]StakingDelegation, error) {
+	var result StakingDelegationsResponse
+	query := url.Values{"limit": {strconv.Itoa(stakingPerPage)}, "offset": {"0"}}
+	path := fmt.Sprintf("v1/staking/chains/%s/delegators/%s/delegations", stakingChain, address)
+	err := c.Get(&result, path, query)
+	return result.Delegations, err
+}
+
+func (c StakingClient) GetUnbondings(address string) ([]StakingUnbonding, error) {
+	var result StakingUnbondingsResponse
+	query := url.Values{"limit": {strconv.Itoa(stakingPerPage)}, "offset": {"0"}}
+	path := fmt.Sprintf("v1/staking/chains/%s/delegators/%s/ubds", stakingChain, address)
+	err := c.Get(&result, path, query)
+	return result.UnbondingDelegations, err
+}
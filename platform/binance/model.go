@@ -1,6 +1,8 @@
 package binance
 
 import (
+	"encoding/json"
+	"github.com/shopspring/decimal"
 	"github.com/trustwallet/blockatlas/coin"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 	"github.com/trustwallet/blockatlas/pkg/numbers"
@@ -10,14 +12,24 @@ import (
 )
 
 const (
-	NewOrder    TxType = "NEW_ORDER"
-	CancelOrder TxType = "CANCEL_ORDER"
-	Transfer    TxType = "TRANSFER"
+	NewOrder      TxType = "NEW_ORDER"
+	CancelOrder   TxType = "CANCEL_ORDER"
+	Transfer      TxType = "TRANSFER"
+	IssueToken    TxType = "ISSUE_TOKEN"
+	BurnToken     TxType = "BURN_TOKEN"
+	MintToken     TxType = "MINT"
+	FreezeToken   TxType = "FREEZE_TOKEN"
+	UnfreezeToken TxType = "UN_FREEZE_TOKEN"
+	TimeLock      TxType = "TIME_LOCK"
+	TimeUnlock    TxType = "TIME_UNLOCK"
+	TimeRelock    TxType = "TIME_RELOCK"
 )
 
 const (
-	BNBAsset    = "BNB"
-	tokensLimit = "1000"
+	BNBAsset       = "BNB"
+	tokensLimit    = "1000"
+	tradesLimit    = "1000"
+	tradesCacheTTL = time.Minute
 )
 
 type (
@@ -100,8 +112,45 @@ type (
 		Symbol         string `json:"symbol"`
 		TotalSupply    string `json:"total_supply"`
 	}
+
+	TradesResponse struct {
+		Total  int     `json:"total"`
+		Trades []Trade `json:"trade"`
+	}
+
+	Trade struct {
+		TradeID     string `json:"tradeId"`
+		BlockHeight int    `json:"blockHeight"`
+		Symbol      string `json:"symbol"`
+		Price       string `json:"price"`
+		Quantity    string `json:"quantity"`
+		BaseAsset   string `json:"baseAsset"`
+		QuoteAsset  string `json:"quoteAsset"`
+		BuyerID     string `json:"buyerId"`
+		SellerID    string `json:"sellerId"`
+		BuyFee      string `json:"buyFee"`
+		SellFee     string `json:"sellFee"`
+		Time        int64  `json:"time"`
+	}
+
+	tokenAction struct {
+		key   blockatlas.KeyType
+		title blockatlas.KeyTitle
+	}
 )
 
+// tokenActions maps the token lifecycle transaction types to their AnyAction key and title
+var tokenActions = map[TxType]tokenAction{
+	IssueToken:    {blockatlas.KeyIssueToken, blockatlas.KeyTitleIssueToken},
+	BurnToken:     {blockatlas.KeyBurnToken, blockatlas.KeyTitleBurnToken},
+	MintToken:     {blockatlas.KeyMintToken, blockatlas.KeyTitleMintToken},
+	FreezeToken:   {blockatlas.KeyFreezeToken, blockatlas.KeyTitleFreezeToken},
+	UnfreezeToken: {blockatlas.KeyUnfreezeToken, blockatlas.KeyTitleUnfreezeToken},
+	TimeLock:      {blockatlas.KeyTimeLock, blockatlas.KeyTitleTimeLock},
+	TimeUnlock:    {blockatlas.KeyTimeUnlock, blockatlas.KeyTitleTimeUnlock},
+	TimeRelock:    {blockatlas.KeyTimeRelock, blockatlas.KeyTitleTimeRelock},
+}
+
 func normalizeBlock(response TransactionsInBlockResponse) blockatlas.Block {
 	result := blockatlas.Block{
 		Number: int64(response.BlockHeight),
@@ -116,14 +165,19 @@ func normalizeTransactions(txs []Tx) []blockatlas.Tx {
 		var txs []blockatlas.Tx
 		switch t.TxType {
 		case CancelOrder, NewOrder:
-			//txs = append(txs, normalizeOrderTransaction(t))
-			continue
+			txs = append(txs, normalizeOrderTransaction(t))
 		case Transfer:
 			if len(t.SubTransactions) > 0 {
 				txs = normalizeMultiTransferTransaction(t)
 			} else {
 				txs = append(txs, normalizeTransferTransaction(t))
 			}
+		default:
+			action, ok := tokenActions[t.TxType]
+			if !ok {
+				continue
+			}
+			txs = append(txs, normalizeTokenActionTransaction(t, action))
 		}
 		totalTxs = append(totalTxs, txs...)
 	}
@@ -132,8 +186,7 @@ func normalizeTransactions(txs []Tx) []blockatlas.Tx {
 
 func normalizeTransferTransaction(t Tx) blockatlas.Tx {
 	tx := normalizeBaseOfTransaction(t)
-	tx.To = t.ToAddr.(string)
-	tx.From = t.FromAddr.(string)
+	tx.To = getAddress(t.ToAddr)
 	switch {
 	case t.TxAsset == BNBAsset:
 		tx.Type = blockatlas.TxTransfer
@@ -146,9 +199,9 @@ func normalizeTransferTransaction(t Tx) blockatlas.Tx {
 		tx.Type = blockatlas.TxNativeTokenTransfer
 		tx.Meta = blockatlas.NativeTokenTransfer{
 			Decimals: coin.Binance().Decimals,
-			From:     t.FromAddr.(string),
+			From:     tx.From,
 			Symbol:   getTokenSymbolFromID(t.TxAsset),
-			To:       t.ToAddr.(string),
+			To:       tx.To,
 			TokenID:  t.TxAsset,
 			Value:    normalizeAmount(t.Value),
 		}
@@ -201,7 +254,7 @@ func normalizeBaseOfTransaction(t Tx) blockatlas.Tx {
 	return blockatlas.Tx{
 		ID:       t.TxHash,
 		Coin:     coin.Binance().ID,
-		From:     t.FromAddr.(string),
+		From:     getAddress(t.FromAddr),
 		Fee:      normalizeFee(t.TxFee),
 		Date:     t.TimeStamp.Unix(),
 		Block:    uint64(t.BlockHeight),
@@ -211,36 +264,168 @@ func normalizeBaseOfTransaction(t Tx) blockatlas.Tx {
 	}
 }
 
-//func normalizeOrderTransaction(t Tx) blockatlas.Tx {
-//	tx := normalizeBaseOfTransaction(t)
-//	tx.Type = blockatlas.TxAnyAction
-//	meta := blockatlas.AnyAction{
-//		Coin:     coin.Binance().ID,
-//		Decimals: coin.Binance().Decimals,
-//	}
-//
-//	data, err := getTransactionData(t.Data)
-//	if err == nil {
-//		base, _ := getTokenIDsFromPair(data.OrderData.Symbol)
-//		meta.TokenID = base
-//		meta.Value = blockatlas.Amount(numbers.FromDecimalExp(data.OrderData.Quantity, int(coin.Binance().Decimals)))
-//		meta.Name = data.OrderData.Side
-//		meta.Symbol = getTokenSymbolFromID(base)
-//	}
-//	switch t.TxType {
-//	case CancelOrder:
-//		meta.Title = blockatlas.KeyTitleCancelOrder
-//		meta.Key = blockatlas.KeyCancelOrder
-//		meta.Value = "0"
-//	case NewOrder:
-//		meta.Title = blockatlas.KeyTitlePlaceOrder
-//		meta.Key = blockatlas.KeyPlaceOrder
-//	}
-//
-//	tx.Meta = meta
-//	tx.Direction = blockatlas.DirectionOutgoing
-//	return tx
-//}
+func normalizeOrderTransaction(t Tx) blockatlas.Tx {
+	tx := normalizeBaseOfTransaction(t)
+	tx.Type = blockatlas.TxAnyAction
+	meta := blockatlas.AnyAction{
+		Coin:     coin.Binance().ID,
+		Decimals: coin.Binance().Decimals,
+		Value:    "0",
+	}
+
+	data, err := getTransactionData(t.Data)
+	if err == nil && data.OrderData.Symbol != "" {
+		base, _ := getTokenIDsFromPair(data.OrderData.Symbol)
+		meta.TokenID = base
+		meta.Name = data.OrderData.Side
+		meta.Symbol = getTokenSymbolFromID(base)
+		meta.Value = normalizeOptionalAmount(data.OrderData.Quantity)
+	}
+	switch t.TxType {
+	case CancelOrder:
+		meta.Title = blockatlas.KeyTitleCancelOrder
+		meta.Key = blockatlas.KeyCancelOrder
+		meta.Value = "0"
+	case NewOrder:
+		meta.Title = blockatlas.KeyTitlePlaceOrder
+		meta.Key = blockatlas.KeyPlaceOrder
+	}
+
+	tx.Meta = meta
+	tx.Direction = blockatlas.DirectionOutgoing
+	return tx
+}
+
+func normalizeTokenActionTransaction(t Tx, action tokenAction) blockatlas.Tx {
+	tx := normalizeBaseOfTransaction(t)
+	tx.To = getAddress(t.ToAddr)
+	tx.Type = blockatlas.TxAnyAction
+	tx.Meta = blockatlas.AnyAction{
+		Coin:     coin.Binance().ID,
+		Title:    action.title,
+		Key:      action.key,
+		TokenID:  t.TxAsset,
+		Symbol:   getTokenSymbolFromID(t.TxAsset),
+		Decimals: coin.Binance().Decimals,
+		Value:    normalizeOptionalAmount(t.Value),
+	}
+	tx.Direction = blockatlas.DirectionOutgoing
+	return tx
+}
+
+// normalizeTrades returns the trades of the address which involve the asset as token swaps
+func normalizeTrades(trades []Trade, address, asset string) []blockatlas.Tx {
+	txs := make([]blockatlas.Tx, 0, len(trades))
+	for _, t := range trades {
+		if t.BaseAsset != asset && t.QuoteAsset != asset {
+			continue
+		}
+		tx, ok := normalizeTrade(t, address)
+		if !ok {
+			continue
+		}
+		txs = append(txs, tx)
+	}
+	return txs
+}
+
+// normalizeBlockTrades returns a swap for the buyer and one for the seller of every trade
+func normalizeBlockTrades(trades []Trade) []blockatlas.Tx {
+	txs := make([]blockatlas.Tx, 0, len(trades)*2)
+	for _, t := range trades {
+		for _, address := range []string{t.BuyerID, t.SellerID} {
+			tx, ok := normalizeTrade(t, address)
+			if !ok {
+				continue
+			}
+			txs = append(txs, tx)
+			if t.BuyerID == t.SellerID {
+				break
+			}
+		}
+	}
+	return txs
+}
+
+// hasOrders reports whether the transactions place orders, trades are only matched against a new order
+func hasOrders(txs []Tx) bool {
+	for _, t := range txs {
+		if t.TxType == NewOrder {
+			return true
+		}
+	}
+	return false
+}
+
+func normalizeTrade(t Trade, address string) (blockatlas.Tx, bool) {
+	price, err := decimal.NewFromString(t.Price)
+	if err != nil {
+		return blockatlas.Tx{}, false
+	}
+	quantity, err := decimal.NewFromString(t.Quantity)
+	if err != nil {
+		return blockatlas.Tx{}, false
+	}
+	base := tradeLeg(t.BaseAsset, quantity)
+	quote := tradeLeg(t.QuoteAsset, price.Mul(quantity))
+
+	var (
+		swap              blockatlas.TokenSwap
+		counterparty, fee string
+	)
+	switch address {
+	case t.BuyerID:
+		counterparty, fee = t.SellerID, t.BuyFee
+		swap = blockatlas.TokenSwap{Input: quote, Output: base}
+	case t.SellerID:
+		counterparty, fee = t.BuyerID, t.SellFee
+		swap = blockatlas.TokenSwap{Input: base, Output: quote}
+	default:
+		return blockatlas.Tx{}, false
+	}
+	swap.Input.From, swap.Input.To = address, counterparty
+	swap.Output.From, swap.Output.To = counterparty, address
+
+	return blockatlas.Tx{
+		ID:        t.TradeID,
+		Coin:      coin.Binance().ID,
+		From:      address,
+		To:        counterparty,
+		Fee:       normalizeTradeFee(fee),
+		Date:      t.Time / 1000,
+		Block:     uint64(t.BlockHeight),
+		Status:    blockatlas.StatusCompleted,
+		Type:      blockatlas.TxTokenSwap,
+		Direction: blockatlas.DirectionOutgoing,
+		Meta:      swap,
+	}, true
+}
+
+func tradeLeg(asset string, amount decimal.Decimal) blockatlas.TokenTransfer {
+	return blockatlas.TokenTransfer{
+		Symbol:   getTokenSymbolFromID(asset),
+		TokenID:  asset,
+		Decimals: coin.Binance().Decimals,
+		Value:    blockatlas.Amount(amount.Shift(int32(coin.Binance().Decimals)).Truncate(0).String()),
+	}
+}
+
+// normalizeTradeFee returns the BNB part of a trade fee, e.g. "BNB:0.00001000;AVA-645:0.1"
+func normalizeTradeFee(fee string) blockatlas.Amount {
+	total := decimal.Zero
+	for _, part := range strings.Split(fee, ";") {
+		assetFee := strings.Split(part, ":")
+		if len(assetFee) != 2 || assetFee[0] != BNBAsset {
+			continue
+		}
+		amount, err := decimal.NewFromString(assetFee[1])
+		if err != nil {
+			continue
+		}
+		total = total.Add(amount)
+	}
+	return blockatlas.Amount(total.Shift(int32(coin.Binance().Decimals)).Truncate(0).String())
+}
 
 func normalizeTokens(srcBalance []TokenBalance, tokens Tokens) []blockatlas.Token {
 	tokensList := make([]blockatlas.Token, 0, len(srcBalance))
@@ -277,19 +462,28 @@ func normalizeToken(srcToken TokenBalance, tokens Tokens) (blockatlas.Token, boo
 	return result, true
 }
 
-//func getTransactionData(rawOrderData string) (TransactionData, error) {
-//	var result TransactionData
-//	err := json.Unmarshal([]byte(rawOrderData), &result)
-//	return result, err
-//}
-//
-//func getTokenIDsFromPair(pair string) (string, string) {
-//	result := strings.Split(pair, "_")
-//	if len(result) == 1 || len(result) == 0 {
-//		return pair, pair
-//	}
-//	return result[0], result[1]
-//}
+func getTransactionData(rawOrderData string) (TransactionData, error) {
+	var result TransactionData
+	err := json.Unmarshal([]byte(rawOrderData), &result)
+	return result, err
+}
+
+func getTokenIDsFromPair(pair string) (string, string) {
+	result := strings.Split(pair, "_")
+	if len(result) == 1 || len(result) == 0 {
+		return pair, pair
+	}
+	return result[0], result[1]
+}
+
+// getAddress returns the address of a transaction side, the explorer sends null when there is none
+func getAddress(addr interface{}) string {
+	address, ok := addr.(string)
+	if !ok {
+		return ""
+	}
+	return address
+}
 
 func getTokenSymbolFromID(tokenID string) string {
 	s := strings.Split(tokenID, "-")
@@ -304,6 +498,14 @@ func normalizeAmount(amount string) blockatlas.Amount {
 	return blockatlas.Amount(val)
 }
 
+// normalizeOptionalAmount normalizes amounts the explorer may omit, e.g. for cancelled orders
+func normalizeOptionalAmount(amount string) blockatlas.Amount {
+	if amount == "" {
+		return "0"
+	}
+	return normalizeAmount(amount)
+}
+
 func normalizeFee(amount string) blockatlas.Amount {
 	a, err := numbers.StringNumberToFloat64(amount)
 	if a != 0 && err == nil {
@@ -332,3 +534,55 @@ func (page Tokens) findTokenBySymbol(symbol string) (Token, bool) {
 	}
 	return Token{}, false
 }
+
+const (
+	stakingChain   = "bsc"
+	stakingPerPage = 100
+
+	validatorStatusBonded = 0
+)
+
+type (
+	StakingValidatorsResponse struct {
+		Total      int                `json:"total"`
+		Validators []StakingValidator `json:"validators"`
+	}
+
+	// StakingValidator reports the amounts in BNB and the commission and apr as fractions
+	StakingValidator struct {
+		Validator     string  `json:"validator"`
+		ValName       string  `json:"valName"`
+		Commission    float64 `json:"commission"`
+		VotingPower   float64 `json:"votingPower"`
+		SelfDelegated float64 `json:"selfDelegated"`
+		Status        int     `json:"status"`
+		Jailed        bool    `json:"jailed"`
+		Apr           float64 `json:"apr"`
+	}
+
+	StakingDelegationsResponse struct {
+		Total       int                 `json:"total"`
+		Delegations []StakingDelegation `json:"delegations"`
+	}
+
+	StakingDelegation struct {
+		Delegator string  `json:"delegator"`
+		Validator string  `json:"validator"`
+		ValName   string  `json:"valName"`
+		Shares    float64 `json:"shares"`
+		Amount    float64 `json:"amount"`
+	}
+
+	StakingUnbondingsResponse struct {
+		Total                int                `json:"total"`
+		UnbondingDelegations []StakingUnbonding `json:"unbondingDelegations"`
+	}
+
+	StakingUnbonding struct {
+		Delegator string    `json:"delegator"`
+		Validator string    `json:"validator"`
+		ValName   string    `json:"valName"`
+		Balance   float64   `json:"balance"`
+		MinTime   time.Time `json:"minTime"`
+	}
+)
@@ -1,6 +1,10 @@
 package binance
 
-import "testing"
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"testing"
+)
 
 func Test_isZeroBalance(t *testing.T) {
 	type testZeroStruct struct {
@@ -49,3 +53,123 @@ func Test_isZeroBalance(t *testing.T) {
 		})
 	}
 }
+
+func Test_normalizeTokenActionTransactions(t *testing.T) {
+	tests := []struct {
+		name   string
+		txType TxType
+		value  string
+		asset  string
+		fee    string
+		want   blockatlas.AnyAction
+	}{
+		{"Issue token", IssueToken, "1000000.00000000", "AVA-645", "1000.00000000", blockatlas.AnyAction{
+			Coin: 714, Title: blockatlas.KeyTitleIssueToken, Key: blockatlas.KeyIssueToken, TokenID: "AVA-645", Symbol: "AVA", Decimals: 8, Value: "100000000000000"}},
+		{"Burn token", BurnToken, "10.50000000", "AVA-645", "0.01000000", blockatlas.AnyAction{
+			Coin: 714, Title: blockatlas.KeyTitleBurnToken, Key: blockatlas.KeyBurnToken, TokenID: "AVA-645", Symbol: "AVA", Decimals: 8, Value: "1050000000"}},
+		{"Mint token", MintToken, "5.00000000", "AVA-645", "200.00000000", blockatlas.AnyAction{
+			Coin: 714, Title: blockatlas.KeyTitleMintToken, Key: blockatlas.KeyMintToken, TokenID: "AVA-645", Symbol: "AVA", Decimals: 8, Value: "500000000"}},
+		{"Freeze token", FreezeToken, "1.00000000", "BNB", "0.01000000", blockatlas.AnyAction{
+			Coin: 714, Title: blockatlas.KeyTitleFreezeToken, Key: blockatlas.KeyFreezeToken, TokenID: "BNB", Symbol: "BNB", Decimals: 8, Value: "100000000"}},
+		{"Unfreeze token", UnfreezeToken, "1.00000000", "BNB", "0.01000000", blockatlas.AnyAction{
+			Coin: 714, Title: blockatlas.KeyTitleUnfreezeToken, Key: blockatlas.KeyUnfreezeToken, TokenID: "BNB", Symbol: "BNB", Decimals: 8, Value: "100000000"}},
+		{"Time lock", TimeLock, "2.00000000", "BNB", "0.01000000", blockatlas.AnyAction{
+			Coin: 714, Title: blockatlas.KeyTitleTimeLock, Key: blockatlas.KeyTimeLock, TokenID: "BNB", Symbol: "BNB", Decimals: 8, Value: "200000000"}},
+		{"Time unlock without value", TimeUnlock, "", "", "0.01000000", blockatlas.AnyAction{
+			Coin: 714, Title: blockatlas.KeyTitleTimeUnlock, Key: blockatlas.KeyTimeUnlock, Decimals: 8, Value: "0"}},
+		{"Time relock", TimeRelock, "2.00000000", "BNB", "0.01000000", blockatlas.AnyAction{
+			Coin: 714, Title: blockatlas.KeyTitleTimeRelock, Key: blockatlas.KeyTimeRelock, TokenID: "BNB", Symbol: "BNB", Decimals: 8, Value: "200000000"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			txs := normalizeTransactions([]Tx{{
+				TxHash:      "4CD5BAA433BABA63D862141A4A2F9235B0BA5CBAB8114C93A0556ECA4EC7A68A",
+				BlockHeight: 104867508,
+				TxType:      tt.txType,
+				FromAddr:    "bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg",
+				Value:       tt.value,
+				TxAsset:     tt.asset,
+				TxFee:       tt.fee,
+			}})
+			assert.Len(t, txs, 1)
+			assert.Equal(t, blockatlas.TxAnyAction, txs[0].Type)
+			assert.Equal(t, blockatlas.DirectionOutgoing, txs[0].Direction)
+			assert.Equal(t, "bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg", txs[0].From)
+			assert.Equal(t, "", txs[0].To)
+			assert.Equal(t, normalizeFee(tt.fee), txs[0].Fee)
+			assert.Equal(t, tt.want, txs[0].Meta)
+		})
+	}
+}
+
+func Test_normalizeTransactions_SkipsUnknownTypes(t *testing.T) {
+	txs := normalizeTransactions([]Tx{{TxType: "PROPOSAL", FromAddr: "bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg"}})
+	assert.Empty(t, txs)
+}
+
+func Test_normalizeTrades(t *testing.T) {
+	const (
+		buyer  = "bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg"
+		seller = "bnb1l83kstts7lt9dpgawzechnrgjq54dql36dyspc"
+	)
+	trades := []Trade{
+		{TradeID: "105725696-0", BlockHeight: 105725696, Symbol: "AVA-645_BNB", Price: "0.0850", Quantity: "27",
+			BaseAsset: "AVA-645", QuoteAsset: "BNB", BuyerID: buyer, SellerID: seller,
+			BuyFee: "BNB:0.00229500;", SellFee: "AVA-645:0.027;", Time: 1596827621390},
+		{TradeID: "105725697-0", BlockHeight: 105725697, Symbol: "AVA-645_BUSD-BD1", Price: "1.77721", Quantity: "27",
+			BaseAsset: "AVA-645", QuoteAsset: "BUSD-BD1", BuyerID: buyer, SellerID: seller, Time: 1596827622000},
+	}
+
+	buys := normalizeTrades(trades, buyer, "BNB")
+	assert.Equal(t, []blockatlas.Tx{{
+		ID:        "105725696-0",
+		Coin:      714,
+		From:      buyer,
+		To:        seller,
+		Fee:       "229500",
+		Date:      1596827621,
+		Block:     105725696,
+		Status:    blockatlas.StatusCompleted,
+		Type:      blockatlas.TxTokenSwap,
+		Direction: blockatlas.DirectionOutgoing,
+		Meta: blockatlas.TokenSwap{
+			Input:  blockatlas.TokenTransfer{Symbol: "BNB", TokenID: "BNB", Decimals: 8, Value: "229500000", From: buyer, To: seller},
+			Output: blockatlas.TokenTransfer{Symbol: "AVA", TokenID: "AVA-645", Decimals: 8, Value: "2700000000", From: seller, To: buyer},
+		},
+	}}, buys)
+
+	sells := normalizeTrades(trades, seller, "AVA-645")
+	assert.Len(t, sells, 2)
+	assert.Equal(t, blockatlas.Amount("0"), sells[0].Fee)
+	assert.Equal(t, blockatlas.TokenSwap{
+		Input:  blockatlas.TokenTransfer{Symbol: "AVA", TokenID: "AVA-645", Decimals: 8, Value: "2700000000", From: seller, To: buyer},
+		Output: blockatlas.TokenTransfer{Symbol: "BUSD", TokenID: "BUSD-BD1", Decimals: 8, Value: "4798467000", From: buyer, To: seller},
+	}, sells[1].Meta)
+
+	assert.Empty(t, normalizeTrades(trades, "bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23", "BNB"))
+}
+
+func Test_normalizeBlockTrades(t *testing.T) {
+	const (
+		buyer  = "bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg"
+		seller = "bnb1l83kstts7lt9dpgawzechnrgjq54dql36dyspc"
+	)
+	trades := []Trade{
+		{TradeID: "105725696-0", BlockHeight: 105725696, Price: "0.0850", Quantity: "27",
+			BaseAsset: "AVA-645", QuoteAsset: "BNB", BuyerID: buyer, SellerID: seller, Time: 1596827621390},
+		{TradeID: "105725696-1", BlockHeight: 105725696, Price: "0.0850", Quantity: "1",
+			BaseAsset: "AVA-645", QuoteAsset: "BNB", BuyerID: buyer, SellerID: buyer, Time: 1596827621390},
+	}
+	txs := normalizeBlockTrades(trades)
+	assert.Len(t, txs, 3)
+	assert.Equal(t, buyer, txs[0].From)
+	assert.Equal(t, seller, txs[1].From)
+	assert.Equal(t, blockatlas.TokenTransfer{Symbol: "AVA", TokenID: "AVA-645", Decimals: 8, Value: "2700000000", From: seller, To: buyer},
+		txs[1].Meta.(blockatlas.TokenSwap).Input)
+	assert.Equal(t, "105725696-1", txs[2].ID)
+}
+
+func Test_hasOrders(t *testing.T) {
+	assert.True(t, hasOrders([]Tx{{TxType: Transfer}, {TxType: NewOrder}}))
+	assert.False(t, hasOrders([]Tx{{TxType: Transfer}, {TxType: CancelOrder}}))
+}
@@ -0,0 +1,179 @@
+package binance
+
+import (
+	"github.com/trustwallet/blockatlas/coin"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/logger"
+	"github.com/trustwallet/blockatlas/pkg/numbers"
+	"github.com/trustwallet/blockatlas/services/assets"
+	"github.com/trustwallet/blockatlas/services/economics"
+)
+
+const (
+	lockTime      = 604800 // in seconds (7 days)
+	minimumAmount = "100000000"
+)
+
+func (p *Platform) GetDetails() blockatlas.StakingDetails {
+	return getDetails(economics.Annual(p.Coin().Handle, blockatlas.DefaultAnnualReward))
+}
+
+func getDetails(annual float64) blockatlas.StakingDetails {
+	return blockatlas.StakingDetails{
+		Reward:        blockatlas.StakingReward{Annual: annual},
+		MinimumAmount: minimumAmount,
+		LockTime:      lockTime,
+		Type:          blockatlas.DelegationTypeDelegate,
+	}
+}
+
+func (p *Platform) GetValidators() (blockatlas.ValidatorPage, error) {
+	validators, err := p.stakingClient.GetValidators()
+	if err != nil {
+		return nil, err
+	}
+	results := make(blockatlas.ValidatorPage, 0, len(validators))
+	for _, v := range validators {
+		results = append(results, normalizeValidator(v))
+	}
+	results.SetStakeShares()
+	return results, nil
+}
+
+func normalizeValidator(v StakingValidator) blockatlas.Validator {
+	return blockatlas.Validator{
+		ID:      v.Validator,
+		Status:  v.Status == validatorStatusBonded && !v.Jailed,
+		Details: getDetails(v.Apr * 100),
+		Metrics: &blockatlas.ValidatorMetrics{
+			Commission:    v.Commission * 100,
+			HasCommission: true,
+			VotingPower:   toAmount(v.VotingPower),
+			Jailed:        v.Jailed,
+			SelfBond:      toAmount(v.SelfDelegated),
+		},
+	}
+}
+
+// GetEconomics uses the yields reported by the staking API, the network yield is the one of the best validator
+func (p *Platform) GetEconomics() (blockatlas.StakingEconomics, error) {
+	validators, err := p.stakingClient.GetValidators()
+	if err != nil {
+		return blockatlas.StakingEconomics{}, err
+	}
+	return NormalizeEconomics(validators), nil
+}
+
+func NormalizeEconomics(validators []StakingValidator) blockatlas.StakingEconomics {
+	result := blockatlas.StakingEconomics{Validators: make(map[string]float64, len(validators))}
+	for _, v := range validators {
+		if v.Status != validatorStatusBonded || v.Jailed {
+			result.Validators[v.Validator] = 0
+			continue
+		}
+		annual := v.Apr * 100
+		result.Validators[v.Validator] = annual
+		if annual > result.Annual {
+			result.Annual = annual
+		}
+	}
+	return result
+}
+
+func (p *Platform) GetActiveValidators() (blockatlas.StakeValidators, error) {
+	validators, err := assets.GetValidatorsMap(p)
+	if err != nil {
+		return nil, err
+	}
+	result := make(blockatlas.StakeValidators, 0, len(validators))
+	for _, v := range validators {
+		result = append(result, v)
+	}
+	return result, nil
+}
+
+func (p *Platform) GetDelegations(address string) (blockatlas.DelegationsPage, error) {
+	results := make(blockatlas.DelegationsPage, 0)
+	delegations, err := p.stakingClient.GetDelegations(address)
+	if err != nil {
+		return nil, err
+	}
+	unbondings, err := p.stakingClient.GetUnbondings(address)
+	if err != nil {
+		return nil, err
+	}
+	if len(delegations) == 0 && len(unbondings) == 0 {
+		return results, nil
+	}
+	validators, err := assets.GetValidatorsMap(p)
+	if err != nil {
+		return nil, err
+	}
+	results = append(results, NormalizeDelegations(delegations, validators)...)
+	results = append(results, NormalizeUnbondings(unbondings, validators)...)
+	return results, nil
+}
+
+func NormalizeDelegations(delegations []StakingDelegation, validators blockatlas.ValidatorMap) blockatlas.DelegationsPage {
+	results := make(blockatlas.DelegationsPage, 0, len(delegations))
+	for _, d := range delegations {
+		results = append(results, blockatlas.Delegation{
+			Delegator: validator(d.Validator, d.Delegator, validators),
+			Value:     toAmount(d.Amount),
+			Status:    blockatlas.DelegationStatusActive,
+		})
+	}
+	return results
+}
+
+func NormalizeUnbondings(unbondings []StakingUnbonding, validators blockatlas.ValidatorMap) blockatlas.DelegationsPage {
+	results := make(blockatlas.DelegationsPage, 0, len(unbondings))
+	for _, u := range unbondings {
+		results = append(results, blockatlas.Delegation{
+			Delegator: validator(u.Validator, u.Delegator, validators),
+			Value:     toAmount(u.Balance),
+			Status:    blockatlas.DelegationStatusPending,
+			Metadata:  blockatlas.DelegationMetaDataPending{AvailableDate: uint(u.MinTime.Unix())},
+		})
+	}
+	return results
+}
+
+func validator(address, delegator string, validators blockatlas.ValidatorMap) blockatlas.StakeValidator {
+	v, ok := validators[address]
+	if !ok {
+		logger.Warn("Validator not found", logger.Params{"address": address, "platform": "binance", "delegation": delegator})
+		return getUnknownValidator(address)
+	}
+	return v
+}
+
+func getUnknownValidator(address string) blockatlas.StakeValidator {
+	return blockatlas.StakeValidator{
+		ID:     address,
+		Status: false,
+		Info: blockatlas.StakeValidatorInfo{
+			Name:        "Decommissioned",
+			Description: "Decommissioned",
+		},
+		Details: getDetails(0),
+	}
+}
+
+func (p *Platform) UndelegatedBalance(address string) (string, error) {
+	account, err := p.client.FetchAccountMeta(address)
+	if err != nil {
+		return "0", err
+	}
+	for _, b := range account.Balances {
+		if b.Symbol == BNBAsset {
+			return numbers.FromDecimalExp(b.Free, int(coin.Binance().Decimals)), nil
+		}
+	}
+	return "0", nil
+}
+
+// toAmount converts the amounts of the staking API, reported in BNB
+func toAmount(value float64) string {
+	return numbers.FromDecimalExp(numbers.Float64toString(value), int(coin.Binance().Decimals))
+}
@@ -0,0 +1,108 @@
+package binance
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+)
+
+const (
+	mockedStakingValidators = `{"total":2,"validators":[
+		{"validator":"bva1xnudjls7x4p48qrk0j247htt7rl2k2dzp3mr3j","valName":"Ciscox","commission":0.1,"votingPower":4234567.5,"selfDelegated":20000,"status":0,"jailed":false,"apr":0.0432},
+		{"validator":"bva1ahmnj6yh2cd3esns2qr9hutnmxlrh5r0mrftxp","valName":"Fuji","commission":0.2,"votingPower":1411522.5,"selfDelegated":10000.5,"status":0,"jailed":true,"apr":0.0521}]}`
+	mockedStakingDelegations = `{"total":1,"delegations":[{"delegator":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","validator":"bva1xnudjls7x4p48qrk0j247htt7rl2k2dzp3mr3j","valName":"Ciscox","shares":12.5,"amount":12.5}]}`
+	mockedStakingUnbondings  = `{"total":1,"unbondingDelegations":[{"delegator":"bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg","validator":"bva1ahmnj6yh2cd3esns2qr9hutnmxlrh5r0mrftxp","valName":"Fuji","balance":1.00000001,"minTime":"2020-10-21T08:12:05Z"}]}`
+	mockedStakingAccount     = `{"balances":[{"free":"6.51198688","frozen":"0.00000000","locked":"0.00000000","symbol":"AVA-645"},{"free":"1.25000000","frozen":"0.00000000","locked":"0.00000000","symbol":"BNB"}]}`
+)
+
+func createMockedStakingAPI() http.Handler {
+	r := http.NewServeMux()
+	responses := map[string]string{
+		"/v1/staking/chains/bsc/validators": mockedStakingValidators,
+		"/v1/staking/chains/bsc/delegators/bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg/delegations": mockedStakingDelegations,
+		"/v1/staking/chains/bsc/delegators/bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg/ubds":        mockedStakingUnbondings,
+		"/v1/account/bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg":                                   mockedStakingAccount,
+	}
+	for path, response := range responses {
+		response := response
+		r.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			if _, err := fmt.Fprint(w, response); err != nil {
+				panic(err)
+			}
+		})
+	}
+	return r
+}
+
+func TestPlatform_GetValidators(t *testing.T) {
+	server := httptest.NewServer(createMockedStakingAPI())
+	defer server.Close()
+	p := Init(server.URL, server.URL)
+
+	validators, err := p.GetValidators()
+	assert.Nil(t, err)
+	assert.Len(t, validators, 2)
+	assert.Equal(t, blockatlas.Validator{
+		ID:      "bva1xnudjls7x4p48qrk0j247htt7rl2k2dzp3mr3j",
+		Status:  true,
+		Details: getDetails(4.32),
+		Metrics: &blockatlas.ValidatorMetrics{
+			Commission:    10,
+			HasCommission: true,
+			VotingPower:   "423456750000000",
+			StakeShare:    75,
+			SelfBond:      "2000000000000",
+		},
+	}, validators[0])
+	assert.False(t, validators[1].Status)
+	assert.True(t, validators[1].Metrics.Jailed)
+	assert.Equal(t, "1000050000000", validators[1].Metrics.SelfBond)
+
+	economics, err := p.GetEconomics()
+	assert.Nil(t, err)
+	assert.Equal(t, 4.32, economics.Annual)
+	assert.Equal(t, map[string]float64{
+		"bva1xnudjls7x4p48qrk0j247htt7rl2k2dzp3mr3j": 4.32,
+		"bva1ahmnj6yh2cd3esns2qr9hutnmxlrh5r0mrftxp": 0,
+	}, economics.Validators)
+}
+
+func TestNormalizeDelegations(t *testing.T) {
+	server := httptest.NewServer(createMockedStakingAPI())
+	defer server.Close()
+	p := Init(server.URL, server.URL)
+
+	delegations, err := p.stakingClient.GetDelegations("bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg")
+	assert.Nil(t, err)
+	unbondings, err := p.stakingClient.GetUnbondings("bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg")
+	assert.Nil(t, err)
+
+	known := blockatlas.StakeValidator{ID: "bva1xnudjls7x4p48qrk0j247htt7rl2k2dzp3mr3j", Status: true, Info: blockatlas.StakeValidatorInfo{Name: "Ciscox"}}
+	validators := blockatlas.ValidatorMap{known.ID: known}
+
+	assert.Equal(t, blockatlas.DelegationsPage{
+		{Delegator: known, Value: "1250000000", Status: blockatlas.DelegationStatusActive},
+	}, NormalizeDelegations(delegations, validators))
+	assert.Equal(t, blockatlas.DelegationsPage{
+		{
+			Delegator: getUnknownValidator("bva1ahmnj6yh2cd3esns2qr9hutnmxlrh5r0mrftxp"),
+			Value:     "100000001",
+			Status:    blockatlas.DelegationStatusPending,
+			Metadata:  blockatlas.DelegationMetaDataPending{AvailableDate: 1603267925},
+		},
+	}, NormalizeUnbondings(unbondings, validators))
+}
+
+func TestPlatform_UndelegatedBalance(t *testing.T) {
+	server := httptest.NewServer(createMockedStakingAPI())
+	defer server.Close()
+	p := Init(server.URL, server.URL)
+
+	balance, err := p.UndelegatedBalance("bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg")
+	assert.Nil(t, err)
+	assert.Equal(t, "125000000", balance)
+}
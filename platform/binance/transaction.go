@@ -3,20 +3,29 @@ package binance
 import (
 	"github.com/trustwallet/blockatlas/coin"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/logger"
 )
 
 func (p *Platform) GetTxsByAddress(address string) (blockatlas.TxPage, error) {
-	txsFromClient, err := p.client.FetchTransactionsByAddressAndTokenID(address, coin.Binance().Symbol)
-	if err != nil {
-		return nil, err
-	}
-	return normalizeTransactions(txsFromClient), nil
+	return p.getTxsByAddressAndToken(address, coin.Binance().Symbol)
 }
 
 func (p *Platform) GetTokenTxsByAddress(address, token string) (blockatlas.TxPage, error) {
+	return p.getTxsByAddressAndToken(address, token)
+}
+
+func (p *Platform) getTxsByAddressAndToken(address, token string) (blockatlas.TxPage, error) {
 	txsFromClient, err := p.client.FetchTransactionsByAddressAndTokenID(address, token)
 	if err != nil {
 		return nil, err
 	}
-	return normalizeTransactions(txsFromClient), nil
+	txs := normalizeTransactions(txsFromClient)
+
+	trades, err := p.client.FetchTradesByAddress(address)
+	if err != nil {
+		logger.Error(err, "Binance: unable to fetch trades", logger.Params{"address": address})
+		return txs, nil
+	}
+	txs = append(txs, normalizeTrades(trades, address, token)...)
+	return blockatlas.TxPage(blockatlas.Txs(txs).SortByDate()), nil
 }
@@ -10,7 +10,7 @@ import (
 func TestPlatform_GetTxsByAddress(t *testing.T) {
 	server := httptest.NewServer(createMockedAPI())
 	defer server.Close()
-	p := Init(server.URL)
+	p := Init(server.URL, server.URL)
 	txs, err := p.GetTxsByAddress("bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23")
 	assert.Nil(t, err)
 	res, err := json.Marshal(txs)
@@ -21,11 +21,10 @@ func TestPlatform_GetTxsByAddress(t *testing.T) {
 func TestPlatform_GetTokenTxsByAddress(t *testing.T) {
 	server := httptest.NewServer(createMockedAPI())
 	defer server.Close()
-	p := Init(server.URL)
+	p := Init(server.URL, server.URL)
 	txs, err := p.GetTokenTxsByAddress("bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg", "AVA-645")
 	assert.Nil(t, err)
 	res, err := json.Marshal(txs)
 	assert.Nil(t, err)
-	assert.Len(t, res, 2)
-	//assert.Equal(t, wantedTxsAva, string(res))
+	assert.Equal(t, wantedTxsAva, string(res))
 }
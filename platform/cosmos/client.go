@@ -85,8 +85,34 @@ func (c *Client) GetUnbondingDelegations(address string) (delegations UnbondingD
 	return
 }
 
+func (c *Client) GetRedelegations(address string) (redelegations Redelegations, err error) {
+	err = c.Get(&redelegations, "staking/redelegations", url.Values{"delegator": {address}})
+	if err != nil {
+		logger.Error(err, "Cosmos: Failed to get redelegations for address")
+	}
+	return
+}
+
 func (c *Client) GetAccount(address string) (result AuthAccount, err error) {
 	path := fmt.Sprintf("auth/accounts/%s", address)
 	err = c.Get(&result, path, nil)
 	return
 }
+
+func (c *Client) GetRewards(address string) (rewards DelegatorRewards, err error) {
+	path := fmt.Sprintf("distribution/delegators/%s/rewards", address)
+	err = c.Get(&rewards, path, nil)
+	return
+}
+
+// GetRewardTxs returns the page of the reward withdrawals of the address, pages are ordered from the oldest
+func (c *Client) GetRewardTxs(address string, page int) (txs TxPage, err error) {
+	query := url.Values{
+		"message.action": {"withdraw_delegator_reward"},
+		"message.sender": {address},
+		"page":           {strconv.Itoa(page)},
+		"limit":          {strconv.Itoa(blockatlas.RewardsPerPage)},
+	}
+	err = c.Get(&txs, "txs", query)
+	return
+}
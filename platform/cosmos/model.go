@@ -128,6 +128,14 @@ type MessageValueDelegate struct {
 	Amount        Amount `json:"amount,omitempty"`
 }
 
+// MessageValueRedelegate - delegator, source and destination validators, and amount
+type MessageValueRedelegate struct {
+	DelegatorAddr    string `json:"delegator_address"`
+	ValidatorSrcAddr string `json:"validator_src_address"`
+	ValidatorDstAddr string `json:"validator_dst_address"`
+	Amount           Amount `json:"amount,omitempty"`
+}
+
 // Fee - also references the "amount" struct
 type Fee struct {
 	FeeAmount []Amount `json:"amount"`
@@ -156,6 +164,8 @@ type Validators struct {
 
 type Validator struct {
 	Status     int              `json:"status"`
+	Jailed     bool             `json:"jailed"`
+	Tokens     string           `json:"tokens"`
 	Address    string           `json:"operator_address"`
 	Commission CosmosCommission `json:"commission"`
 }
@@ -197,6 +207,36 @@ type UnbondingDelegationEntry struct {
 	Balance          string `json:"balance"`
 }
 
+type Redelegations struct {
+	List []Redelegation `json:"result"`
+}
+
+type Redelegation struct {
+	DelegatorAddress    string              `json:"delegator_address"`
+	ValidatorSrcAddress string              `json:"validator_src_address"`
+	ValidatorDstAddress string              `json:"validator_dst_address"`
+	Entries             []RedelegationEntry `json:"entries"`
+}
+
+type RedelegationEntry struct {
+	CompletionTime string `json:"completion_time"`
+	Balance        string `json:"balance"`
+}
+
+type DelegatorRewards struct {
+	Result DelegatorRewardsResult `json:"result"`
+}
+
+type DelegatorRewardsResult struct {
+	Rewards []ValidatorReward `json:"rewards"`
+}
+
+// ValidatorReward - the reward amounts are decimals, e.g. "1234.567000000000000000"
+type ValidatorReward struct {
+	ValidatorAddress string   `json:"validator_address"`
+	Reward           []Amount `json:"reward"`
+}
+
 type StakingPool struct {
 	Pool Pool `json:"result"`
 }
@@ -240,6 +280,10 @@ func (m *Message) UnmarshalJSON(buf []byte) error {
 		var msgDelegate MessageValueDelegate
 		err = json.Unmarshal(messageInternal.Value, &msgDelegate)
 		m.Value = msgDelegate
+	case MsgBeginRedelegate:
+		var msgRedelegate MessageValueRedelegate
+		err = json.Unmarshal(messageInternal.Value, &msgRedelegate)
+		m.Value = msgRedelegate
 	case MsgSend:
 		var msgTransfer MessageValueTransfer
 		err = json.Unmarshal(messageInternal.Value, &msgTransfer)
@@ -0,0 +1,130 @@
+package cosmos
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+)
+
+func (p *Platform) GetPendingRewards(address string) (blockatlas.PendingRewards, error) {
+	rewards, err := p.client.GetRewards(address)
+	if err != nil {
+		return nil, err
+	}
+	return NormalizePendingRewards(rewards.Result.Rewards, p.Denom()), nil
+}
+
+// GetRewardsHistory returns the withdrawn rewards, the first page is the latest one
+func (p *Platform) GetRewardsHistory(address string, page int) (blockatlas.RewardsPage, error) {
+	txs, err := p.client.GetRewardTxs(address, 1)
+	if err != nil {
+		return nil, err
+	}
+	totalPages, err := strconv.Atoi(txs.PageTotal)
+	if err != nil || totalPages < 1 {
+		totalPages = 1
+	}
+	if page > totalPages {
+		return make(blockatlas.RewardsPage, 0), nil
+	}
+	// gaia doesn't sort, pages are counted from the last one
+	if serverPage := totalPages - page + 1; serverPage != 1 {
+		txs, err = p.client.GetRewardTxs(address, serverPage)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return NormalizeRewards(txs.Txs, p.Denom()), nil
+}
+
+func NormalizePendingRewards(rewards []ValidatorReward, denom DenomType) blockatlas.PendingRewards {
+	results := make(blockatlas.PendingRewards, 0)
+	for _, r := range rewards {
+		for _, amount := range r.Reward {
+			if amount.Denom != string(denom) {
+				continue
+			}
+			value := integerPart(amount.Quantity)
+			if value == "0" {
+				continue
+			}
+			results = append(results, blockatlas.PendingReward{Validator: r.ValidatorAddress, Value: value})
+		}
+	}
+	return results
+}
+
+// NormalizeRewards returns a reward per validator of the withdrawals, the latest first
+func NormalizeRewards(txs []Tx, denom DenomType) blockatlas.RewardsPage {
+	results := make(blockatlas.RewardsPage, 0)
+	for _, tx := range txs {
+		if tx.Code != 0 {
+			continue
+		}
+		date, err := time.Parse("2006-01-02T15:04:05Z", tx.Date)
+		if err != nil {
+			continue
+		}
+		for _, event := range tx.Events {
+			if event.Type != EventWithdrawRewards {
+				continue
+			}
+			for _, w := range event.Attributes.withdrawals(denom) {
+				results = append(results, blockatlas.Reward{
+					ID:        tx.ID,
+					Validator: w.validator,
+					Value:     w.value,
+					Type:      blockatlas.RewardTypeClaim,
+					Date:      date.Unix(),
+				})
+			}
+		}
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Date > results[j].Date
+	})
+	return results
+}
+
+type withdrawal struct {
+	validator string
+	value     string
+}
+
+// withdrawals pairs the amount and the validator attributes of the withdraw_rewards event, one pair per message
+func (a Attributes) withdrawals(denom DenomType) []withdrawal {
+	results := make([]withdrawal, 0)
+	value := ""
+	for _, att := range a {
+		switch att.Key {
+		case AttributeAmount:
+			value = ""
+			for _, coin := range strings.Split(att.Value, ",") {
+				amount := strings.TrimSuffix(coin, string(denom))
+				if _, err := strconv.ParseUint(amount, 10, 64); err == nil && amount != coin {
+					value = amount
+				}
+			}
+		case AttributeValidator:
+			if value != "" {
+				results = append(results, withdrawal{validator: att.Value, value: value})
+			}
+			value = ""
+		}
+	}
+	return results
+}
+
+func integerPart(decimal string) string {
+	if i := strings.IndexByte(decimal, '.'); i >= 0 {
+		decimal = decimal[:i]
+	}
+	decimal = strings.TrimLeft(decimal, "0")
+	if decimal == "" {
+		return "0"
+	}
+	return decimal
+}
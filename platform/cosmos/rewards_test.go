@@ -0,0 +1,83 @@
+package cosmos
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+)
+
+const rewardsSrc = `
+[
+  {
+    "validator_address": "cosmosvaloper1qwl879nx9t6kef4supyazayf7vjhennyh568ys",
+    "reward": [{"denom": "uatom", "amount": "1234.567800000000000000"}]
+  },
+  {
+    "validator_address": "cosmosvaloper1lktjhnzkpkz3ehrg8psvmwhafg56kfss3q3t8m",
+    "reward": [{"denom": "uatom", "amount": "0.200000000000000000"}]
+  }
+]`
+
+const rewardTxsSrc = `
+[
+  {
+    "height": "1000",
+    "txhash": "A1",
+    "code": 0,
+    "timestamp": "2020-03-01T10:00:00Z",
+    "events": [
+      {"type": "message", "Attributes": [{"key": "action", "value": "withdraw_delegator_reward"}]},
+      {"type": "withdraw_rewards", "Attributes": [
+        {"key": "amount", "value": "1500uatom"},
+        {"key": "validator", "value": "cosmosvaloper1qwl879nx9t6kef4supyazayf7vjhennyh568ys"},
+        {"key": "amount", "value": ""},
+        {"key": "validator", "value": "cosmosvaloper1lktjhnzkpkz3ehrg8psvmwhafg56kfss3q3t8m"}
+      ]}
+    ]
+  },
+  {
+    "height": "2000",
+    "txhash": "B2",
+    "code": 0,
+    "timestamp": "2020-03-05T10:00:00Z",
+    "events": [
+      {"type": "withdraw_rewards", "Attributes": [
+        {"key": "amount", "value": "25ibc/27394F,300uatom"},
+        {"key": "validator", "value": "cosmosvaloper1lktjhnzkpkz3ehrg8psvmwhafg56kfss3q3t8m"}
+      ]}
+    ]
+  },
+  {
+    "height": "3000",
+    "txhash": "C3",
+    "code": 12,
+    "timestamp": "2020-03-06T10:00:00Z",
+    "events": []
+  }
+]`
+
+func TestNormalizePendingRewards(t *testing.T) {
+	var rewards []ValidatorReward
+	err := json.Unmarshal([]byte(rewardsSrc), &rewards)
+	assert.NoError(t, err)
+
+	expected := blockatlas.PendingRewards{
+		{Validator: "cosmosvaloper1qwl879nx9t6kef4supyazayf7vjhennyh568ys", Value: "1234"},
+	}
+	assert.Equal(t, expected, NormalizePendingRewards(rewards, DenomAtom))
+	assert.Equal(t, blockatlas.PendingRewards{}, NormalizePendingRewards(rewards, DenomKava))
+}
+
+func TestNormalizeRewards(t *testing.T) {
+	var txs []Tx
+	err := json.Unmarshal([]byte(rewardTxsSrc), &txs)
+	assert.NoError(t, err)
+
+	expected := blockatlas.RewardsPage{
+		{ID: "B2", Validator: "cosmosvaloper1lktjhnzkpkz3ehrg8psvmwhafg56kfss3q3t8m", Value: "300", Type: blockatlas.RewardTypeClaim, Date: 1583402400},
+		{ID: "A1", Validator: "cosmosvaloper1qwl879nx9t6kef4supyazayf7vjhennyh568ys", Value: "1500", Type: blockatlas.RewardTypeClaim, Date: 1583056800},
+	}
+	assert.Equal(t, expected, NormalizeRewards(txs, DenomAtom))
+}
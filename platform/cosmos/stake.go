@@ -6,6 +6,7 @@ import (
 	"github.com/trustwallet/blockatlas/pkg/errors"
 	"github.com/trustwallet/blockatlas/pkg/logger"
 	"github.com/trustwallet/blockatlas/services/assets"
+	"github.com/trustwallet/blockatlas/services/economics"
 	"strconv"
 	"time"
 )
@@ -50,6 +51,7 @@ func (p *Platform) GetValidators() (blockatlas.ValidatorPage, error) {
 	for _, validator := range validators.Result {
 		results = append(results, normalizeValidator(validator, pool.Pool, inflationValue))
 	}
+	results.SetStakeShares()
 
 	return results, nil
 }
@@ -57,7 +59,7 @@ func (p *Platform) GetValidators() (blockatlas.ValidatorPage, error) {
 func (p *Platform) GetDetails() blockatlas.StakingDetails {
 	return blockatlas.StakingDetails{
 		Reward: blockatlas.StakingReward{
-			Annual: p.GetMaxAPR(),
+			Annual: p.annual(),
 		},
 		MinimumAmount: minimumAmount,
 		LockTime:      lockTime,
@@ -65,6 +67,14 @@ func (p *Platform) GetDetails() blockatlas.StakingDetails {
 	}
 }
 
+// annual is the yield computed by the economics job, the best yield of the validators until its first run
+func (p *Platform) annual() float64 {
+	if e, ok := economics.Latest(p.Coin().Handle); ok {
+		return e.Annual
+	}
+	return p.GetMaxAPR()
+}
+
 func (p *Platform) GetMaxAPR() float64 {
 	validators, err := p.GetValidators()
 	if err != nil {
@@ -83,6 +93,53 @@ func (p *Platform) GetMaxAPR() float64 {
 	return max
 }
 
+func (p *Platform) GetEconomics() (blockatlas.StakingEconomics, error) {
+	validators, err := p.client.GetValidators()
+	if err != nil {
+		return blockatlas.StakingEconomics{}, err
+	}
+	pool, err := p.client.GetPool()
+	if err != nil {
+		return blockatlas.StakingEconomics{}, err
+	}
+	inflation, err := p.client.GetInflation()
+	if err != nil {
+		return blockatlas.StakingEconomics{}, err
+	}
+	inflationValue, err := strconv.ParseFloat(inflation.Result, 64)
+	if err != nil {
+		return blockatlas.StakingEconomics{}, errors.E("error to parse inflationValue to float", errors.TypePlatformUnmarshal)
+	}
+	return NormalizeEconomics(validators.Result, pool.Pool, inflationValue)
+}
+
+// NormalizeEconomics spreads the inflation over the bonded tokens, the jailed and unbonded validators earn nothing
+func NormalizeEconomics(validators []Validator, pool Pool, inflation float64) (blockatlas.StakingEconomics, error) {
+	notBondedTokens, err := strconv.ParseFloat(pool.NotBondedTokens, 64)
+	if err != nil {
+		return blockatlas.StakingEconomics{}, errors.E(err, errors.TypePlatformUnmarshal)
+	}
+	bondedTokens, err := strconv.ParseFloat(pool.BondedTokens, 64)
+	if err != nil || bondedTokens == 0 {
+		return blockatlas.StakingEconomics{}, errors.E("invalid bonded tokens", errors.TypePlatformUnmarshal, errors.Params{"bonded_tokens": pool.BondedTokens})
+	}
+	bondedRatio := bondedTokens / (bondedTokens + notBondedTokens)
+	result := blockatlas.StakingEconomics{
+		Annual:      inflation / bondedRatio * 100,
+		Inflation:   inflation * 100,
+		BondedRatio: bondedRatio,
+		Validators:  make(map[string]float64, len(validators)),
+	}
+	for _, v := range validators {
+		if v.Jailed || v.Status != 2 {
+			result.Validators[v.Address] = 0
+			continue
+		}
+		result.Validators[v.Address] = CalculateAnnualReward(pool, inflation, v)
+	}
+	return result, nil
+}
+
 func (p *Platform) GetDelegations(address string) (blockatlas.DelegationsPage, error) {
 	results := make(blockatlas.DelegationsPage, 0)
 	delegations, err := p.client.GetDelegations(address)
@@ -106,6 +163,21 @@ func (p *Platform) GetDelegations(address string) (blockatlas.DelegationsPage, e
 	return results, nil
 }
 
+func (p *Platform) GetRedelegations(address string) (blockatlas.RedelegationsPage, error) {
+	redelegations, err := p.client.GetRedelegations(address)
+	if err != nil {
+		return nil, err
+	}
+	if len(redelegations.List) == 0 {
+		return make(blockatlas.RedelegationsPage, 0), nil
+	}
+	validators, err := assets.GetValidatorsMap(p)
+	if err != nil {
+		return nil, err
+	}
+	return NormalizeRedelegations(redelegations.List, validators), nil
+}
+
 func (p *Platform) UndelegatedBalance(address string) (string, error) {
 	account, err := p.client.GetAccount(address)
 	if err != nil {
@@ -162,6 +234,30 @@ func NormalizeUnbondingDelegations(delegations []UnbondingDelegation, validators
 	return results
 }
 
+func NormalizeRedelegations(redelegations []Redelegation, validators blockatlas.ValidatorMap) blockatlas.RedelegationsPage {
+	results := make(blockatlas.RedelegationsPage, 0)
+	for _, r := range redelegations {
+		source, ok := validators[r.ValidatorSrcAddress]
+		if !ok {
+			source = getUnknownValidator(r.ValidatorSrcAddress)
+		}
+		destination, ok := validators[r.ValidatorDstAddress]
+		if !ok {
+			destination = getUnknownValidator(r.ValidatorDstAddress)
+		}
+		for _, entry := range r.Entries {
+			t, _ := time.Parse(time.RFC3339, entry.CompletionTime)
+			results = append(results, blockatlas.Redelegation{
+				Source:         source,
+				Destination:    destination,
+				Value:          entry.Balance,
+				CompletionDate: uint(t.Unix()),
+			})
+		}
+	}
+	return results
+}
+
 func normalizeValidator(v Validator, p Pool, inflation float64) (validator blockatlas.Validator) {
 	reward := CalculateAnnualReward(p, inflation, v)
 	return blockatlas.Validator{
@@ -173,6 +269,21 @@ func normalizeValidator(v Validator, p Pool, inflation float64) (validator block
 			LockTime:      lockTime,
 			Type:          blockatlas.DelegationTypeDelegate,
 		},
+		Metrics: normalizeMetrics(v),
+	}
+}
+
+// normalizeMetrics reads the voting power and the commission, the uptime is only exposed by the consensus address
+func normalizeMetrics(v Validator) *blockatlas.ValidatorMetrics {
+	commission, err := strconv.ParseFloat(v.Commission.Commision.Rate, 64)
+	if err != nil {
+		commission = 0
+	}
+	return &blockatlas.ValidatorMetrics{
+		Commission:    commission * 100,
+		HasCommission: true,
+		VotingPower:   v.Tokens,
+		Jailed:        v.Jailed,
 	}
 }
 
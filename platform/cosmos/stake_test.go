@@ -79,6 +79,11 @@ func TestNormalizeValidator(t *testing.T) {
 			MinimumAmount: minimumAmount,
 			Type:          blockatlas.DelegationTypeDelegate,
 		},
+		Metrics: &blockatlas.ValidatorMetrics{
+			Commission:    7.04,
+			HasCommission: true,
+			VotingPower:   "1557750969185",
+		},
 	}
 	result := normalizeValidator(v, stakingPool, inflation)
 	assert.Equal(t, expected, result)
@@ -147,3 +152,56 @@ func TestNormalizeUnbondingDelegations(t *testing.T) {
 	result := NormalizeUnbondingDelegations(delegations, validatorMap)
 	assert.Equal(t, expected, result)
 }
+
+const redelegationsSrc = `
+[
+  {
+    "delegator_address": "cosmos135qla4294zxarqhhgxsx0sw56yssa3z0f78pm0",
+    "validator_src_address": "cosmosvaloper1qwl879nx9t6kef4supyazayf7vjhennyh568ys",
+    "validator_dst_address": "cosmosvaloper1lktjhnzkpkz3ehrg8psvmwhafg56kfss3q3t8m",
+    "entries": [
+      {
+        "creation_height": "100",
+        "completion_time": "2020-01-01T06:54:18.441436491Z",
+        "initial_balance": "5000",
+        "shares_dst": "5000.000000000000000000",
+        "balance": "5000"
+      }
+    ]
+  }
+]`
+
+func TestNormalizeRedelegations(t *testing.T) {
+	var redelegations []Redelegation
+	err := json.Unmarshal([]byte(redelegationsSrc), &redelegations)
+	assert.NoError(t, err)
+
+	expected := blockatlas.RedelegationsPage{
+		{
+			Source:         validator1,
+			Destination:    getUnknownValidator("cosmosvaloper1lktjhnzkpkz3ehrg8psvmwhafg56kfss3q3t8m"),
+			Value:          "5000",
+			CompletionDate: 1577861658,
+		},
+	}
+	assert.Equal(t, expected, NormalizeRedelegations(redelegations, validatorMap))
+}
+
+func TestNormalizeEconomics(t *testing.T) {
+	var v Validator
+	_ = json.Unmarshal([]byte(validatorSrc), &v)
+	jailed := v
+	jailed.Address = "cosmosvaloper1jailed"
+	jailed.Jailed = true
+
+	result, err := NormalizeEconomics([]Validator{v, jailed}, Pool{"800", "200"}, 0.07)
+	assert.NoError(t, err)
+	assert.InDelta(t, 35, result.Annual, 0.0001)
+	assert.InDelta(t, 7, result.Inflation, 0.0001)
+	assert.InDelta(t, 0.2, result.BondedRatio, 0.0001)
+	assert.InDelta(t, 32.536, result.Validators[v.Address], 0.0001)
+	assert.Equal(t, float64(0), result.Validators[jailed.Address])
+
+	_, err = NormalizeEconomics([]Validator{v}, Pool{"800", "0"}, 0.07)
+	assert.Error(t, err)
+}
@@ -123,6 +123,10 @@ func (p *Platform) Normalize(srcTx *Tx) (tx blockatlas.Tx, ok bool) {
 		delegate := msg.Value.(MessageValueDelegate)
 		p.fillDelegate(&tx, delegate, srcTx.Events, msg.Type)
 		return tx, true
+	case MessageValueRedelegate:
+		redelegate := msg.Value.(MessageValueRedelegate)
+		p.fillRedelegate(&tx, redelegate)
+		return tx, true
 	}
 	return tx, false
 }
@@ -167,6 +171,7 @@ func (p *Platform) fillDelegate(tx *blockatlas.Tx, delegate MessageValueDelegate
 	case MsgUndelegate:
 		tx.Direction = blockatlas.DirectionIncoming
 		title = blockatlas.AnyActionUndelegation
+		key = blockatlas.KeyStakeUndelegate
 	case MsgWithdrawDelegationReward:
 		tx.Direction = blockatlas.DirectionIncoming
 		title = blockatlas.AnyActionClaimRewards
@@ -183,3 +188,28 @@ func (p *Platform) fillDelegate(tx *blockatlas.Tx, delegate MessageValueDelegate
 		Value:    blockatlas.Amount(value),
 	}
 }
+
+// fillRedelegate moves the delegation to the destination validator, the funds stay staked
+func (p *Platform) fillRedelegate(tx *blockatlas.Tx, redelegate MessageValueRedelegate) {
+	value := ""
+	if len(redelegate.Amount.Quantity) > 0 {
+		var err error
+		value, err = numbers.DecimalToSatoshis(redelegate.Amount.Quantity)
+		if err != nil {
+			return
+		}
+	}
+	tx.From = redelegate.DelegatorAddr
+	tx.To = redelegate.ValidatorDstAddr
+	tx.Type = blockatlas.TxAnyAction
+	tx.Direction = blockatlas.DirectionSelf
+	tx.Meta = blockatlas.AnyAction{
+		Coin:     p.Coin().ID,
+		Title:    blockatlas.AnyActionRedelegation,
+		Key:      blockatlas.KeyStakeRedelegate,
+		Name:     p.Coin().Name,
+		Symbol:   p.Coin().Symbol,
+		Decimals: p.Coin().Decimals,
+		Value:    blockatlas.Amount(value),
+	}
+}
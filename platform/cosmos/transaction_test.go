@@ -267,6 +267,44 @@ const delegateSrc = `
    "timestamp":"2019-08-01T04:10:16Z"
 }`
 
+const redelegateSrc = `
+{
+   "height":"1258390",
+   "txhash":"2B4A2E3FD0B3C5ED0C08A1F6E2D1D3C7F07D4C56B1A8D2F6E3B5C4D7A8E9F012",
+   "gas_wanted":"300000",
+   "gas_used":"212345",
+   "tx":{
+      "type":"auth/StdTx",
+      "value":{
+         "msg":[
+            {
+               "type":"cosmos-sdk/MsgBeginRedelegate",
+               "value":{
+                  "delegator_address":"cosmos1237l0vauhw78qtwq045jd24ay4urpec6r3xfn3",
+                  "validator_src_address":"cosmosvaloper12w6tynmjzq4l8zdla3v4x0jt8lt4rcz5gk7zg2",
+                  "validator_dst_address":"cosmosvaloper1te8nxpc2myjfrhaty0dnzdhs5ahdh5agzuym9v",
+                  "amount":{
+                     "denom":"uatom",
+                     "amount":"1000000"
+                  }
+               }
+            }
+         ],
+         "fee":{
+            "amount":[
+               {
+                  "denom":"uatom",
+                  "amount":"7500"
+               }
+            ],
+            "gas":"300000"
+         },
+         "memo":""
+      }
+   },
+   "timestamp":"2019-08-01T04:45:10Z"
+}`
+
 const unDelegateSrc = `
 {  
    "height":"1257037",
@@ -602,7 +640,7 @@ var unDelegateDst = blockatlas.Tx{
 	Meta: blockatlas.AnyAction{
 		Coin:     coin.ATOM,
 		Title:    blockatlas.AnyActionUndelegation,
-		Key:      blockatlas.KeyStakeDelegate,
+		Key:      blockatlas.KeyStakeUndelegate,
 		Name:     coin.Cosmos().Name,
 		Symbol:   coin.Coins[coin.ATOM].Symbol,
 		Decimals: coin.Coins[coin.ATOM].Decimals,
@@ -610,6 +648,28 @@ var unDelegateDst = blockatlas.Tx{
 	},
 }
 
+var redelegateDst = blockatlas.Tx{
+	ID:        "2B4A2E3FD0B3C5ED0C08A1F6E2D1D3C7F07D4C56B1A8D2F6E3B5C4D7A8E9F012",
+	Coin:      coin.ATOM,
+	From:      "cosmos1237l0vauhw78qtwq045jd24ay4urpec6r3xfn3",
+	To:        "cosmosvaloper1te8nxpc2myjfrhaty0dnzdhs5ahdh5agzuym9v",
+	Fee:       "7500",
+	Date:      1564634710,
+	Block:     1258390,
+	Status:    blockatlas.StatusCompleted,
+	Type:      blockatlas.TxAnyAction,
+	Direction: blockatlas.DirectionSelf,
+	Meta: blockatlas.AnyAction{
+		Coin:     coin.ATOM,
+		Title:    blockatlas.AnyActionRedelegation,
+		Key:      blockatlas.KeyStakeRedelegate,
+		Name:     coin.Cosmos().Name,
+		Symbol:   coin.Coins[coin.ATOM].Symbol,
+		Decimals: coin.Coins[coin.ATOM].Decimals,
+		Value:    "1000000",
+	},
+}
+
 var claimRewardDst2 = blockatlas.Tx{
 	ID:        "082BA88EC055A7C343A353297EAC104CE87C659E0DDD84621C9AC3C284232800",
 	Coin:      coin.ATOM,
@@ -705,6 +765,12 @@ func TestNormalize(t *testing.T) {
 			unDelegateSrc,
 			unDelegateDst,
 		},
+		{
+			"test redelegate tx",
+			cosmos,
+			redelegateSrc,
+			redelegateDst,
+		},
 		{
 			"test claimReward tx 1",
 			cosmos,
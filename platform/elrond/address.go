@@ -0,0 +1,31 @@
+package elrond
+
+import (
+	"encoding/hex"
+
+	"github.com/btcsuite/btcutil/bech32"
+)
+
+const hrp = "erd"
+
+// encodeAddress returns the bech32 address of a public key
+func encodeAddress(publicKey []byte) (string, error) {
+	conv, err := bech32.ConvertBits(publicKey, 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+	return bech32.Encode(hrp, conv)
+}
+
+// decodeAddress returns the hex encoded public key of a bech32 address, as expected by the smart contracts
+func decodeAddress(address string) (string, error) {
+	_, data, err := bech32.Decode(address)
+	if err != nil {
+		return "", err
+	}
+	publicKey, err := bech32.ConvertBits(data, 5, 8, false)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(publicKey), nil
+}
@@ -1,8 +1,10 @@
 package elrond
 
 import (
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"net/url"
 
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
@@ -59,6 +61,90 @@ func (c *Client) GetTxsOfAddress(address string) (blockatlas.TxPage, error) {
 	return txs, nil
 }
 
+func (c *Client) GetAccount(address string) (Account, error) {
+	var accountRes AccountResponse
+	err := c.getResponse(&accountRes, fmt.Sprintf("address/%s", address), nil)
+	return accountRes.Account, err
+}
+
+// QueryVM calls a read-only function of the smart contract and returns the decoded values
+func (c *Client) QueryVM(scAddress, funcName string, args ...string) ([][]byte, error) {
+	if args == nil {
+		args = make([]string, 0)
+	}
+	var queryRes VMQueryResponse
+	request := VMQueryRequest{ScAddress: scAddress, FuncName: funcName, Args: args}
+	if err := c.postResponse(&queryRes, "vm-values/query", request); err != nil {
+		return nil, err
+	}
+	if queryRes.Data.ReturnCode != returnCodeOk {
+		return nil, fmt.Errorf("%s of %s failed: %s", funcName, scAddress, queryRes.Data.ReturnCode)
+	}
+	result := make([][]byte, 0, len(queryRes.Data.ReturnData))
+	for _, data := range queryRes.Data.ReturnData {
+		value, err := base64.StdEncoding.DecodeString(data)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, value)
+	}
+	return result, nil
+}
+
+func (c *Client) GetProviders() ([]string, error) {
+	returnData, err := c.QueryVM(delegationManager, "getAllContractAddresses")
+	if err != nil {
+		return nil, err
+	}
+	providers := make([]string, 0, len(returnData))
+	for _, data := range returnData {
+		address, err := encodeAddress(data)
+		if err != nil {
+			return nil, err
+		}
+		providers = append(providers, address)
+	}
+	return providers, nil
+}
+
+func (c *Client) GetProvider(address string) (Provider, error) {
+	stake, err := c.QueryVM(address, "getTotalActiveStake")
+	if err != nil {
+		return Provider{}, err
+	}
+	config, err := c.QueryVM(address, "getContractConfig")
+	if err != nil {
+		return Provider{}, err
+	}
+	provider := Provider{Address: address, TotalActiveStake: bigInt(stake, 0), ServiceFee: bigInt(config, 1)}
+	return provider, nil
+}
+
+func (c *Client) GetUserStake(provider, delegator string) (*big.Int, []Undelegation, error) {
+	active, err := c.QueryVM(provider, "getUserActiveStake", delegator)
+	if err != nil {
+		return nil, nil, err
+	}
+	undelegated, err := c.QueryVM(provider, "getUserUnDelegatedList", delegator)
+	if err != nil {
+		return nil, nil, err
+	}
+	return bigInt(active, 0), NormalizeUndelegations(undelegated), nil
+}
+
+func (c *Client) postResponse(result interface{}, path string, body interface{}) error {
+	var genericResponse GenericResponse
+	if err := c.Post(&genericResponse, path, body); err != nil {
+		return err
+	}
+
+	if genericResponse.Code != "successful" {
+		return fmt.Errorf("%s", genericResponse.Error)
+	}
+
+	return json.Unmarshal(genericResponse.Data, &result)
+}
+
 func (c *Client) getResponse(result interface{}, path string, query url.Values) error {
 	var genericResponse GenericResponse
 	if err := c.Get(&genericResponse, path, query); err != nil {
@@ -13,6 +13,32 @@ type GenericResponse struct {
 	Error string          `json:"error"`
 }
 
+type AccountResponse struct {
+	Account Account `json:"account"`
+}
+
+type Account struct {
+	Address string `json:"address"`
+	Balance string `json:"balance"`
+}
+
+// VMQueryRequest calls a read-only function of a smart contract, the arguments are hex encoded
+type VMQueryRequest struct {
+	ScAddress string   `json:"scAddress"`
+	FuncName  string   `json:"funcName"`
+	Args      []string `json:"args"`
+}
+
+type VMQueryResponse struct {
+	Data VMOutput `json:"data"`
+}
+
+// VMOutput holds the returned values base64 encoded, the numbers are big endian
+type VMOutput struct {
+	ReturnData []string `json:"returnData"`
+	ReturnCode string   `json:"returnCode"`
+}
+
 type NetworkStatus struct {
 	Status StatusDetails `json:"status"`
 }
@@ -0,0 +1,164 @@
+package elrond
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/logger"
+	"github.com/trustwallet/blockatlas/services/assets"
+	"github.com/trustwallet/blockatlas/services/economics"
+)
+
+const (
+	lockTime      = 864000 // in seconds (10 epochs)
+	epochDuration = 86400  // in seconds
+	minimumAmount = "10000000000000000000"
+
+	// delegationManager deploys the delegation contracts of the staking providers
+	delegationManager = "erd1qqqqqqqqqqqqqqqpqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqylllslmq6y6"
+	returnCodeOk      = "ok"
+	serviceFeeBase    = 100 // the service fee of the providers is in hundredths of a percent
+)
+
+// Provider is a delegation contract, it stakes the funds of the delegators on the nodes of the provider
+type Provider struct {
+	Address          string
+	TotalActiveStake *big.Int
+	ServiceFee       *big.Int
+}
+
+// Undelegation is an amount undelegated from a provider, it can be withdrawn once RemainingEpochs is zero
+type Undelegation struct {
+	Value           *big.Int
+	RemainingEpochs int64
+}
+
+func (p *Platform) GetDetails() blockatlas.StakingDetails {
+	return getDetails(economics.Annual(p.Coin().Handle, blockatlas.DefaultAnnualReward))
+}
+
+func getDetails(annual float64) blockatlas.StakingDetails {
+	return blockatlas.StakingDetails{
+		Reward:        blockatlas.StakingReward{Annual: annual},
+		MinimumAmount: minimumAmount,
+		LockTime:      lockTime,
+		Type:          blockatlas.DelegationTypeDelegate,
+	}
+}
+
+func (p *Platform) GetValidators() (blockatlas.ValidatorPage, error) {
+	addresses, err := p.client.GetProviders()
+	if err != nil {
+		return nil, err
+	}
+	results := make(blockatlas.ValidatorPage, 0, len(addresses))
+	for _, address := range addresses {
+		provider, err := p.client.GetProvider(address)
+		if err != nil {
+			logger.Error(err, "Elrond provider", logger.Params{"address": address})
+			continue
+		}
+		results = append(results, p.normalizeValidator(provider))
+	}
+	results.SetStakeShares()
+	return results, nil
+}
+
+func (p *Platform) normalizeValidator(provider Provider) blockatlas.Validator {
+	return blockatlas.Validator{
+		ID:      provider.Address,
+		Status:  true,
+		Details: p.GetDetails(),
+		Metrics: &blockatlas.ValidatorMetrics{
+			Commission:    float64(provider.ServiceFee.Int64()) / serviceFeeBase,
+			HasCommission: true,
+			VotingPower:   provider.TotalActiveStake.String(),
+		},
+	}
+}
+
+func (p *Platform) GetActiveValidators() (blockatlas.StakeValidators, error) {
+	validators, err := assets.GetValidatorsMap(p)
+	if err != nil {
+		return nil, err
+	}
+	result := make(blockatlas.StakeValidators, 0, len(validators))
+	for _, v := range validators {
+		result = append(result, v)
+	}
+	return result, nil
+}
+
+// GetDelegations queries the stake of the address on every provider of the registry, the delegation
+// contracts keep no index of the providers of a delegator
+func (p *Platform) GetDelegations(address string) (blockatlas.DelegationsPage, error) {
+	delegator, err := decodeAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	validators, err := assets.GetValidatorsMap(p)
+	if err != nil {
+		return nil, err
+	}
+	results := make(blockatlas.DelegationsPage, 0)
+	for _, validator := range validators {
+		active, undelegations, err := p.client.GetUserStake(validator.ID, delegator)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, NormalizeDelegations(validator, active, undelegations, time.Now().Unix())...)
+	}
+	return results, nil
+}
+
+func NormalizeDelegations(validator blockatlas.StakeValidator, active *big.Int, undelegations []Undelegation, now int64) blockatlas.DelegationsPage {
+	results := make(blockatlas.DelegationsPage, 0)
+	if active.Sign() > 0 {
+		results = append(results, blockatlas.Delegation{
+			Delegator: validator,
+			Value:     active.String(),
+			Status:    blockatlas.DelegationStatusActive,
+		})
+	}
+	for _, u := range undelegations {
+		results = append(results, blockatlas.Delegation{
+			Delegator: validator,
+			Value:     u.Value.String(),
+			Status:    blockatlas.DelegationStatusPending,
+			Metadata:  blockatlas.DelegationMetaDataPending{AvailableDate: uint(now + u.RemainingEpochs*epochDuration)},
+		})
+	}
+	return results
+}
+
+// NormalizeUndelegations reads the pairs of amount and remaining epochs returned by getUserUnDelegatedList
+func NormalizeUndelegations(returnData [][]byte) []Undelegation {
+	results := make([]Undelegation, 0, len(returnData)/2)
+	for i := 0; i+1 < len(returnData); i += 2 {
+		results = append(results, Undelegation{
+			Value:           bigInt(returnData, i),
+			RemainingEpochs: bigInt(returnData, i+1).Int64(),
+		})
+	}
+	return results
+}
+
+func (p *Platform) UndelegatedBalance(address string) (string, error) {
+	account, err := p.client.GetAccount(address)
+	if err != nil {
+		return "0", err
+	}
+	if account.Balance == "" {
+		return "0", nil
+	}
+	return account.Balance, nil
+}
+
+// bigInt reads the number at index of the values returned by a smart contract, zero if it is missing
+func bigInt(returnData [][]byte, index int) *big.Int {
+	if index >= len(returnData) {
+		return big.NewInt(0)
+	}
+	return new(big.Int).SetBytes(returnData[index])
+}
@@ -0,0 +1,115 @@
+package elrond
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/coin"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+)
+
+const providerAddress = "erd1qqqqqqqqqqqqqqqpqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqs6ty3a2"
+
+var mockedVMValues = map[string]string{
+	"getAllContractAddresses": `["AAAAAAAAAAAAAQAAAAAAAAAAAAAAAAAAAAAAAAAAAAE="]`,
+	"getTotalActiveStake":     `["oqFdCVGb4AAA"]`,
+	"getContractConfig":       `["eTqEKZvKpTgUQElNM9vn0m2NNG/UWT5J+xKtZUnPA7g=","A+g=","NjXJrcXeoAAA"]`,
+	"getUserActiveStake":      `["G8FtZ07IAAA="]`,
+	"getUserUnDelegatedList":  `["RWORgkT0AAA=","Aw==","G8FtZ07IAAA=",""]`,
+}
+
+func createMockedAPI() http.Handler {
+	r := http.NewServeMux()
+	r.HandleFunc("/vm-values/query", func(w http.ResponseWriter, r *http.Request) {
+		var request VMQueryRequest
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			panic(err)
+		}
+		returnData, ok := mockedVMValues[request.FuncName]
+		if !ok {
+			returnData = "[]"
+		}
+		if _, err := fmt.Fprintf(w, `{"code":"successful","data":{"data":{"returnData":%s,"returnCode":"ok"}}}`, returnData); err != nil {
+			panic(err)
+		}
+	})
+	r.HandleFunc("/address/"+userAddress, func(w http.ResponseWriter, r *http.Request) {
+		if _, err := fmt.Fprintf(w, `{"code":"successful","data":{"account":{"address":"%s","balance":"82516976060558456822"}}}`, userAddress); err != nil {
+			panic(err)
+		}
+	})
+	return r
+}
+
+func TestPlatform_GetValidators(t *testing.T) {
+	server := httptest.NewServer(createMockedAPI())
+	defer server.Close()
+	p := Init(coin.ERD, server.URL)
+
+	validators, err := p.GetValidators()
+	assert.Nil(t, err)
+	assert.Equal(t, blockatlas.ValidatorPage{
+		{
+			ID:      providerAddress,
+			Status:  true,
+			Details: getDetails(blockatlas.DefaultAnnualReward),
+			Metrics: &blockatlas.ValidatorMetrics{
+				Commission:    10,
+				HasCommission: true,
+				VotingPower:   "3000000000000000000000",
+				StakeShare:    100,
+			},
+		},
+	}, validators)
+}
+
+func TestPlatform_GetUserStake(t *testing.T) {
+	server := httptest.NewServer(createMockedAPI())
+	defer server.Close()
+	p := Init(coin.ERD, server.URL)
+
+	delegator, err := decodeAddress(userAddress)
+	assert.Nil(t, err)
+	assert.Equal(t, "793a84299bcaa5381440494d33dbe7d26d8d346fd4593e49fb12ad6549cf03b8", delegator)
+
+	active, undelegations, err := p.client.GetUserStake(providerAddress, delegator)
+	assert.Nil(t, err)
+	assert.Equal(t, "2000000000000000000", active.String())
+	assert.Equal(t, []Undelegation{
+		{Value: big.NewInt(5000000000000000000), RemainingEpochs: 3},
+		{Value: big.NewInt(2000000000000000000), RemainingEpochs: 0},
+	}, undelegations)
+
+	validator := blockatlas.StakeValidator{ID: providerAddress, Status: true}
+	assert.Equal(t, blockatlas.DelegationsPage{
+		{Delegator: validator, Value: "2000000000000000000", Status: blockatlas.DelegationStatusActive},
+		{
+			Delegator: validator,
+			Value:     "5000000000000000000",
+			Status:    blockatlas.DelegationStatusPending,
+			Metadata:  blockatlas.DelegationMetaDataPending{AvailableDate: 1603259200},
+		},
+		{
+			Delegator: validator,
+			Value:     "2000000000000000000",
+			Status:    blockatlas.DelegationStatusPending,
+			Metadata:  blockatlas.DelegationMetaDataPending{AvailableDate: 1603000000},
+		},
+	}, NormalizeDelegations(validator, active, undelegations, 1603000000))
+	assert.Empty(t, NormalizeDelegations(validator, big.NewInt(0), nil, 1603000000))
+}
+
+func TestPlatform_UndelegatedBalance(t *testing.T) {
+	server := httptest.NewServer(createMockedAPI())
+	defer server.Close()
+	p := Init(coin.ERD, server.URL)
+
+	balance, err := p.UndelegatedBalance(userAddress)
+	assert.Nil(t, err)
+	assert.Equal(t, "82516976060558456822", balance)
+}
@@ -0,0 +1,42 @@
+package abi
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strings"
+
+	"github.com/trustwallet/blockatlas/pkg/address"
+)
+
+// DecodeString decodes an ABI encoded string, or a bytes32 for the contracts predating the standard
+func DecodeString(result string) string {
+	b, err := hex.DecodeString(address.Remove0x(result))
+	if err != nil || len(b) == 0 {
+		return ""
+	}
+	if len(b) == 32 {
+		return strings.TrimRight(string(b), "\x00")
+	}
+	if len(b) < 64 {
+		return ""
+	}
+	offset := new(big.Int).SetBytes(b[:32])
+	if !offset.IsInt64() || offset.Int64()+32 > int64(len(b)) {
+		return ""
+	}
+	start := offset.Int64() + 32
+	length := new(big.Int).SetBytes(b[offset.Int64():start])
+	if !length.IsInt64() || start+length.Int64() > int64(len(b)) {
+		return ""
+	}
+	return string(b[start : start+length.Int64()])
+}
+
+// DecodeUint decodes an ABI encoded uint, zero if the result is empty
+func DecodeUint(result string) (*big.Int, bool) {
+	r := address.Remove0x(result)
+	if r == "" {
+		return new(big.Int), true
+	}
+	return new(big.Int).SetString(r, 16)
+}
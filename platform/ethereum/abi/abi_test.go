@@ -0,0 +1,29 @@
+package abi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeString(t *testing.T) {
+	encoded := "0x0000000000000000000000000000000000000000000000000000000000000020" +
+		"000000000000000000000000000000000000000000000000000000000000000b" +
+		"43727970746f4b69747469657300000000000000000000000000000000000000"
+	assert.Equal(t, "CryptoKitti", DecodeString(encoded))
+	assert.Equal(t, "MKR", DecodeString("0x4d4b520000000000000000000000000000000000000000000000000000000000"))
+	assert.Equal(t, "", DecodeString("0x"))
+}
+
+func TestDecodeUint(t *testing.T) {
+	value, ok := DecodeUint("0x0000000000000000000000000000000000000000000000000000000000000012")
+	assert.True(t, ok)
+	assert.Equal(t, int64(18), value.Int64())
+
+	value, ok = DecodeUint("0x")
+	assert.True(t, ok)
+	assert.Equal(t, int64(0), value.Int64())
+
+	_, ok = DecodeUint("0xzz")
+	assert.False(t, ok)
+}
@@ -0,0 +1,183 @@
+package ethereum
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/trustwallet/blockatlas/pkg/address"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/errors"
+	"github.com/trustwallet/blockatlas/pkg/logger"
+	"github.com/trustwallet/blockatlas/platform/ethereum/approval"
+	"github.com/trustwallet/blockatlas/platform/ethereum/logs"
+)
+
+// maxApprovalRequests limits the concurrent eth_call and eth_getLogs requests of a single call
+const maxApprovalRequests = 8
+
+type approvalPair struct{ token, spender string }
+
+// GetApprovals returns the current allowances of every token and spender the address ever approved
+func (p *Platform) GetApprovals(owner string) (blockatlas.ApprovalPage, error) {
+	approvalLogs, err := p.approvals.GetApprovalLogs(owner)
+	if err != nil {
+		return nil, errors.E(err, "get approval logs failed", errors.Params{"owner": owner})
+	}
+	seen := make(map[approvalPair]bool)
+	pairs := make([]approvalPair, 0)
+	for _, log := range approvalLogs {
+		_, spender, _, ok := approval.DecodeEvent(log.Topics, log.Data)
+		if !ok {
+			continue
+		}
+		key := approvalPair{token: strings.ToLower(log.Address), spender: spender}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		pairs = append(pairs, key)
+	}
+
+	values, err := p.allowances(owner, pairs)
+	if err != nil {
+		return nil, err
+	}
+	result := make(blockatlas.ApprovalPage, 0)
+	for i, key := range pairs {
+		if values[i] == "0" {
+			continue
+		}
+		result = append(result, blockatlas.Approval{
+			Coin:    p.CoinIndex,
+			TokenID: address.ToEIP55ByCoinID(key.token, p.CoinIndex),
+			Spender: address.ToEIP55ByCoinID(key.spender, p.CoinIndex),
+			Value:   blockatlas.Amount(values[i]),
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].TokenID != result[j].TokenID {
+			return result[i].TokenID < result[j].TokenID
+		}
+		return result[i].Spender < result[j].Spender
+	})
+	return result, nil
+}
+
+// allowances calls allowance() of the pairs concurrently, the first error fails the whole call
+func (p *Platform) allowances(owner string, pairs []approvalPair) ([]string, error) {
+	values := make([]string, len(pairs))
+	errs := make([]error, len(pairs))
+	sem := make(chan struct{}, maxApprovalRequests)
+	var wg sync.WaitGroup
+	for i, key := range pairs {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, key approvalPair) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			value, err := p.approvals.Allowance(key.token, owner, key.spender)
+			if err != nil {
+				errs[i] = errors.E(err, "get allowance failed", errors.Params{"token": key.token})
+				return
+			}
+			values[i] = value
+		}(i, key)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return values, nil
+}
+
+// fillApprovals replaces the contract calls of the owner which emitted an Approval event of the owner,
+// e.g. an approve() called through a proxy or a permit, by the approve_token action
+func (p *Platform) fillApprovals(txs []blockatlas.Tx, owner string) {
+	if p.RpcURL == "" {
+		return
+	}
+	blocks := make(map[uint64]bool)
+	for _, tx := range txs {
+		if _, ok := tx.Meta.(blockatlas.ContractCall); ok && strings.EqualFold(tx.From, owner) {
+			blocks[tx.Block] = true
+		}
+	}
+	sem := make(chan struct{}, maxApprovalRequests)
+	var (
+		wg    sync.WaitGroup
+		mu    sync.Mutex
+		found []logs.Log
+	)
+	for block := range blocks {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(block uint64) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			blockLogs, err := p.approvals.GetBlockApprovalLogs(int64(block), owner)
+			if err != nil {
+				logger.Error(errors.E(err, "get block approval logs failed", errors.Params{"block": block, "owner": owner}))
+				return
+			}
+			mu.Lock()
+			found = append(found, blockLogs...)
+			mu.Unlock()
+		}(block)
+	}
+	wg.Wait()
+	p.applyApprovalLogs(txs, found)
+}
+
+// fillBlockApprovals fills the approvals of every contract call of the block with a single eth_getLogs,
+// nothing is filled for the platforms without rpc
+func (p *Platform) fillBlockApprovals(block *blockatlas.Block) error {
+	if p.RpcURL == "" {
+		return nil
+	}
+	hasCalls := false
+	for _, tx := range block.Txs {
+		if _, ok := tx.Meta.(blockatlas.ContractCall); ok {
+			hasCalls = true
+			break
+		}
+	}
+	if !hasCalls {
+		return nil
+	}
+	blockLogs, err := p.approvals.GetBlockApprovalLogs(block.Number, "")
+	if err != nil {
+		return errors.E(err, "get block approval logs failed", errors.Params{"block": block.Number})
+	}
+	p.applyApprovalLogs(block.Txs, blockLogs)
+	return nil
+}
+
+// applyApprovalLogs sets the action of the contract calls sent by the owner of an Approval event they emitted
+func (p *Platform) applyApprovalLogs(txs []blockatlas.Tx, approvalLogs []logs.Log) {
+	if len(approvalLogs) == 0 {
+		return
+	}
+	for i := range txs {
+		if _, ok := txs[i].Meta.(blockatlas.ContractCall); !ok {
+			continue
+		}
+		for _, log := range approvalLogs {
+			if !strings.EqualFold(log.TransactionHash, txs[i].ID) {
+				continue
+			}
+			owner, action, ok := approval.EventAction(p.CoinIndex, log)
+			if !ok || !strings.EqualFold(owner, txs[i].From) {
+				continue
+			}
+			txs[i].Meta = action
+			break
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package approval
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/trustwallet/blockatlas/pkg/address"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/platform/ethereum/logs"
+)
+
+const (
+	// ApproveSelector is the selector of approve(address,uint256)
+	ApproveSelector = "095ea7b3"
+	// AllowanceSelector is the selector of allowance(address,address)
+	AllowanceSelector = "dd62ed3e"
+	// ApprovalTopic is the topic of the Approval(address,address,uint256) event
+	ApprovalTopic = "0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925"
+
+	wordLength = 64
+)
+
+// DecodeApprove returns the spender and the amount of an approve call input
+func DecodeApprove(input string) (spender string, amount string, ok bool) {
+	data := strings.ToLower(address.Remove0x(input))
+	if len(data) != len(ApproveSelector)+2*wordLength || !strings.HasPrefix(data, ApproveSelector) {
+		return "", "", false
+	}
+	args := data[len(ApproveSelector):]
+	value, ok := decodeUint(args[wordLength:])
+	if !ok {
+		return "", "", false
+	}
+	return "0x" + args[wordLength-40:wordLength], value, true
+}
+
+// DecodeEvent returns the owner, the spender and the amount of an Approval event. ERC-721
+// Approval events share the topic but index the token id, they are rejected.
+func DecodeEvent(topics []string, data string) (owner string, spender string, amount string, ok bool) {
+	if len(topics) != 3 || !strings.EqualFold(topics[0], ApprovalTopic) {
+		return "", "", "", false
+	}
+	owner, ok = decodeAddress(topics[1])
+	if !ok {
+		return "", "", "", false
+	}
+	spender, ok = decodeAddress(topics[2])
+	if !ok {
+		return "", "", "", false
+	}
+	amount, ok = decodeUint(address.Remove0x(data))
+	if !ok {
+		return "", "", "", false
+	}
+	return owner, spender, amount, true
+}
+
+// Action returns the approve_token action of an approve call to the token contract
+func Action(coinIndex uint, token, input string) (blockatlas.AnyAction, bool) {
+	spender, amount, ok := DecodeApprove(input)
+	if !ok {
+		return blockatlas.AnyAction{}, false
+	}
+	return blockatlas.AnyAction{
+		Coin:    coinIndex,
+		Title:   blockatlas.KeyTitleApproveToken,
+		Key:     blockatlas.KeyApproveToken,
+		TokenID: address.ToEIP55ByCoinID(token, coinIndex),
+		Spender: address.ToEIP55ByCoinID(spender, coinIndex),
+		Value:   blockatlas.Amount(amount),
+	}, true
+}
+
+// EventAction returns the owner and the approve_token action of an Approval event of the token contract,
+// emitted by a call to approve() through another contract or by a permit
+func EventAction(coinIndex uint, log logs.Log) (owner string, action blockatlas.AnyAction, ok bool) {
+	owner, spender, amount, ok := DecodeEvent(log.Topics, log.Data)
+	if !ok {
+		return "", blockatlas.AnyAction{}, false
+	}
+	return owner, blockatlas.AnyAction{
+		Coin:    coinIndex,
+		Title:   blockatlas.KeyTitleApproveToken,
+		Key:     blockatlas.KeyApproveToken,
+		TokenID: address.ToEIP55ByCoinID(log.Address, coinIndex),
+		Spender: address.ToEIP55ByCoinID(spender, coinIndex),
+		Value:   blockatlas.Amount(amount),
+	}, true
+}
+
+// EncodeAllowance returns the call data of allowance(owner, spender)
+func EncodeAllowance(owner, spender string) string {
+	return "0x" + AllowanceSelector + pad(owner) + pad(spender)
+}
+
+// Topic returns the address as an indexed event argument
+func Topic(addr string) string {
+	return "0x" + pad(addr)
+}
+
+func pad(addr string) string {
+	a := strings.ToLower(address.Remove0x(addr))
+	if len(a) >= wordLength {
+		return a
+	}
+	return strings.Repeat("0", wordLength-len(a)) + a
+}
+
+func decodeAddress(word string) (string, bool) {
+	w := address.Remove0x(word)
+	if len(w) != wordLength {
+		return "", false
+	}
+	return "0x" + strings.ToLower(w[wordLength-40:]), true
+}
+
+func decodeUint(word string) (string, bool) {
+	w := address.Remove0x(word)
+	if w == "" {
+		return "0", true
+	}
+	value, ok := new(big.Int).SetString(w, 16)
+	if !ok {
+		return "", false
+	}
+	return value.String(), true
+}
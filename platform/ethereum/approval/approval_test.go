@@ -0,0 +1,96 @@
+package approval
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/coin"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/platform/ethereum/logs"
+)
+
+const (
+	uniswapRouter = "0x7a250d5630b4cf539739df2c5dacb4c659f2488d"
+	approveInput  = "0x095ea7b30000000000000000000000007a250d5630b4cf539739df2c5dacb4c659f2488d" +
+		"ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"
+)
+
+func TestDecodeApprove(t *testing.T) {
+	spender, amount, ok := DecodeApprove(approveInput)
+	assert.True(t, ok)
+	assert.Equal(t, uniswapRouter, spender)
+	assert.Equal(t, "115792089237316195423570985008687907853269984665640564039457584007913129639935", amount)
+
+	_, _, ok = DecodeApprove("0xa9059cbb0000000000000000000000007a250d5630b4cf539739df2c5dacb4c659f2488d" +
+		"0000000000000000000000000000000000000000000000000de0b6b3a7640000")
+	assert.False(t, ok)
+
+	_, _, ok = DecodeApprove("0x095ea7b3")
+	assert.False(t, ok)
+}
+
+func TestDecodeEvent(t *testing.T) {
+	topics := []string{
+		ApprovalTopic,
+		"0x0000000000000000000000007d8bf18c7ce84b3e175b339c4ca93aed1dd166f1",
+		"0x0000000000000000000000007a250d5630b4cf539739df2c5dacb4c659f2488d",
+	}
+	owner, spender, amount, ok := DecodeEvent(topics, "0x0000000000000000000000000000000000000000000000000de0b6b3a7640000")
+	assert.True(t, ok)
+	assert.Equal(t, "0x7d8bf18c7ce84b3e175b339c4ca93aed1dd166f1", owner)
+	assert.Equal(t, uniswapRouter, spender)
+	assert.Equal(t, "1000000000000000000", amount)
+
+	// ERC-721 Approval indexes the token id
+	_, _, _, ok = DecodeEvent(append(topics, "0x0000000000000000000000000000000000000000000000000000000000000001"), "0x")
+	assert.False(t, ok)
+}
+
+func TestAction(t *testing.T) {
+	action, ok := Action(coin.ETH, "0x6b175474e89094c44da98b954eedeac495271d0f", approveInput)
+	assert.True(t, ok)
+	assert.Equal(t, blockatlas.AnyAction{
+		Coin:    coin.ETH,
+		Title:   blockatlas.KeyTitleApproveToken,
+		Key:     blockatlas.KeyApproveToken,
+		TokenID: "0x6B175474E89094C44Da98b954EedeAC495271d0F",
+		Spender: "0x7a250d5630B4cF539739dF2C5dAcb4c659F2488D",
+		Value:   "115792089237316195423570985008687907853269984665640564039457584007913129639935",
+	}, action)
+
+	_, ok = Action(coin.ETH, "0x6b175474e89094c44da98b954eedeac495271d0f", "0x")
+	assert.False(t, ok)
+}
+
+func TestEncodeAllowance(t *testing.T) {
+	assert.Equal(t, "0xdd62ed3e"+
+		"0000000000000000000000007d8bf18c7ce84b3e175b339c4ca93aed1dd166f1"+
+		"0000000000000000000000007a250d5630b4cf539739df2c5dacb4c659f2488d",
+		EncodeAllowance("0x7D8BF18C7cE84b3E175b339c4Ca93aEd1dD166F1", uniswapRouter))
+}
+
+func TestEventAction(t *testing.T) {
+	log := logs.Log{
+		Address: "0x6b175474e89094c44da98b954eedeac495271d0f",
+		Topics: []string{
+			ApprovalTopic,
+			"0x0000000000000000000000007d8bf18c7ce84b3e175b339c4ca93aed1dd166f1",
+			"0x0000000000000000000000007a250d5630b4cf539739df2c5dacb4c659f2488d",
+		},
+		Data: "0x0000000000000000000000000000000000000000000000000de0b6b3a7640000",
+	}
+	owner, action, ok := EventAction(coin.ETH, log)
+	assert.True(t, ok)
+	assert.Equal(t, "0x7d8bf18c7ce84b3e175b339c4ca93aed1dd166f1", owner)
+	assert.Equal(t, blockatlas.AnyAction{
+		Coin:    coin.ETH,
+		Title:   blockatlas.KeyTitleApproveToken,
+		Key:     blockatlas.KeyApproveToken,
+		TokenID: "0x6B175474E89094C44Da98b954EedeAC495271d0F",
+		Spender: "0x7a250d5630B4cF539739dF2C5dAcb4c659F2488D",
+		Value:   "1000000000000000000",
+	}, action)
+
+	_, _, ok = EventAction(coin.ETH, logs.Log{Topics: log.Topics[:1]})
+	assert.False(t, ok)
+}
@@ -0,0 +1,54 @@
+package approval
+
+import (
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/errors"
+	"github.com/trustwallet/blockatlas/platform/ethereum/logs"
+)
+
+type RpcClient struct {
+	blockatlas.Request
+	Logs logs.Client
+}
+
+func InitClient(rpc string) RpcClient {
+	return RpcClient{
+		Request: blockatlas.InitJSONClient(rpc),
+		Logs:    logs.InitClient(rpc),
+	}
+}
+
+// GetApprovalLogs returns the Approval events emitted for the owner, the range is paged and cached
+func (c *RpcClient) GetApprovalLogs(owner string) ([]logs.Log, error) {
+	return c.Logs.GetLogs([]interface{}{ApprovalTopic, Topic(owner)})
+}
+
+// GetBlockApprovalLogs returns the Approval events of the block, of every owner if the owner is empty
+func (c *RpcClient) GetBlockApprovalLogs(block int64, owner string) ([]logs.Log, error) {
+	topics := []interface{}{ApprovalTopic}
+	if owner != "" {
+		topics = append(topics, Topic(owner))
+	}
+	return c.Logs.GetLogsInRange(topics, block, block)
+}
+
+// Allowance returns the current amount the spender is allowed to transfer from the owner
+func (c *RpcClient) Allowance(token, owner, spender string) (string, error) {
+	var res string
+	params := []interface{}{
+		map[string]interface{}{
+			"to":   token,
+			"data": EncodeAllowance(owner, spender),
+		},
+		"latest",
+	}
+	err := c.RpcCall(&res, "eth_call", params)
+	if err != nil {
+		return "", err
+	}
+	value, ok := decodeUint(res)
+	if !ok {
+		return "", errors.E("invalid allowance", errors.Params{"token": token, "result": res})
+	}
+	return value, nil
+}
@@ -0,0 +1,145 @@
+package ethereum
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/coin"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/platform/ethereum/approval"
+)
+
+const approvalLogs = `[
+	{"address":"0x6b175474e89094c44da98b954eedeac495271d0f","topics":["0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925","0x0000000000000000000000007d8bf18c7ce84b3e175b339c4ca93aed1dd166f1","0x0000000000000000000000007a250d5630b4cf539739df2c5dacb4c659f2488d"],"data":"0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"},
+	{"address":"0x6b175474e89094c44da98b954eedeac495271d0f","topics":["0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925","0x0000000000000000000000007d8bf18c7ce84b3e175b339c4ca93aed1dd166f1","0x0000000000000000000000007a250d5630b4cf539739df2c5dacb4c659f2488d"],"data":"0x0000000000000000000000000000000000000000000000000de0b6b3a7640000"},
+	{"address":"0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48","topics":["0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925","0x0000000000000000000000007d8bf18c7ce84b3e175b339c4ca93aed1dd166f1","0x000000000000000000000000def1c0ded9bec7f1a1670819833240f027b25eff"],"data":"0x0000000000000000000000000000000000000000000000000000000000000000"}
+]`
+
+func TestGetApprovals(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		if req.Method == "eth_blockNumber" {
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0xa"}`))
+			return
+		}
+		if req.Method == "eth_getLogs" {
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":` + approvalLogs + `}`))
+			return
+		}
+		var call struct {
+			To   string `json:"to"`
+			Data string `json:"data"`
+		}
+		_ = json.Unmarshal(req.Params[0], &call)
+		result := "0x0000000000000000000000000000000000000000000000000de0b6b3a7640000"
+		if !strings.HasPrefix(call.Data, "0x"+approval.AllowanceSelector) || call.To == "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48" {
+			result = "0x0000000000000000000000000000000000000000000000000000000000000000"
+		}
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"` + result + `"}`))
+	}))
+	defer server.Close()
+	p := Init(coin.ETH, "", server.URL)
+
+	approvals, err := p.GetApprovals("0x7D8BF18C7cE84b3E175b339c4Ca93aEd1dD166F1")
+	assert.Nil(t, err)
+	assert.Equal(t, blockatlas.ApprovalPage{
+		{
+			Coin:    coin.ETH,
+			TokenID: "0x6B175474E89094C44Da98b954EedeAC495271d0F",
+			Spender: "0x7a250d5630B4cF539739dF2C5dAcb4c659F2488D",
+			Value:   "1000000000000000000",
+		},
+	}, approvals)
+}
+
+const proxyApproval = `{"address":"0x6b175474e89094c44da98b954eedeac495271d0f","topics":["0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925","0x0000000000000000000000007d8bf18c7ce84b3e175b339c4ca93aed1dd166f1","0x0000000000000000000000007a250d5630b4cf539739df2c5dacb4c659f2488d"],"data":"0x0000000000000000000000000000000000000000000000000de0b6b3a7640000","blockNumber":"0x9","transactionHash":"0xabc"}`
+
+type blockClient struct {
+	EthereumClient
+	txs blockatlas.TxPage
+}
+
+func (c blockClient) GetTransactions(address string, coinIndex uint) (blockatlas.TxPage, error) {
+	return c.txs, nil
+}
+
+func (c blockClient) GetBlockByNumber(num int64, coinIndex uint) (*blockatlas.Block, error) {
+	return &blockatlas.Block{Number: num, Txs: c.txs}, nil
+}
+
+func TestFillApprovals(t *testing.T) {
+	filters := make([]string, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Params []struct {
+				FromBlock string        `json:"fromBlock"`
+				Topics    []interface{} `json:"topics"`
+			} `json:"params"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		filters = append(filters, req.Params[0].FromBlock+":"+strconv.Itoa(len(req.Params[0].Topics)))
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":[` + proxyApproval + `]}`))
+	}))
+	defer server.Close()
+
+	owner := "0x7D8BF18C7cE84b3E175b339c4Ca93aEd1dD166F1"
+	newTxs := func() blockatlas.TxPage {
+		return blockatlas.TxPage{
+			{ID: "0xABC", From: owner, Block: 9, Meta: blockatlas.ContractCall{Input: "0x1234", Value: "0"}},
+			{ID: "0xdef", From: owner, Block: 9, Meta: blockatlas.ContractCall{Input: "0x1234", Value: "0"}},
+			{ID: "0x123", From: owner, Block: 8, Meta: blockatlas.Transfer{Value: "1"}},
+		}
+	}
+	expected := blockatlas.AnyAction{
+		Coin:    coin.ETH,
+		Title:   blockatlas.KeyTitleApproveToken,
+		Key:     blockatlas.KeyApproveToken,
+		TokenID: "0x6B175474E89094C44Da98b954EedeAC495271d0F",
+		Spender: "0x7a250d5630B4cF539739dF2C5dAcb4c659F2488D",
+		Value:   "1000000000000000000",
+	}
+
+	p := Init(coin.ETH, "", server.URL)
+	p.client = blockClient{txs: newTxs()}
+	txs, err := p.GetTxsByAddress(owner)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, txs[0].Meta)
+	assert.Equal(t, blockatlas.ContractCall{Input: "0x1234", Value: "0"}, txs[1].Meta)
+	assert.Equal(t, []string{"0x9:2"}, filters, "a single block is requested with the owner topic")
+
+	filters = filters[:0]
+	p.client = blockClient{txs: newTxs()}
+	block, err := p.GetBlockByNumber(9)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, block.Txs[0].Meta)
+	assert.Equal(t, []string{"0x9:1"}, filters, "the block is requested once for every owner")
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer failing.Close()
+	p = Init(coin.ETH, "", failing.URL)
+	p.client = blockClient{txs: newTxs()}
+	block, err = p.GetBlockByNumber(9)
+	assert.Nil(t, err, "the approvals don't fail the block")
+	assert.Equal(t, []blockatlas.Tx(newTxs()), block.Txs)
+
+	filters = filters[:0]
+	p = Init(coin.ETH, "", "")
+	p.client = blockClient{txs: newTxs()}
+	block, err = p.GetBlockByNumber(9)
+	assert.Nil(t, err)
+	assert.Equal(t, []blockatlas.Tx(newTxs()), block.Txs)
+	txs, err = p.GetTxsByAddress(owner)
+	assert.Nil(t, err)
+	assert.Equal(t, newTxs(), txs)
+	assert.Empty(t, filters, "the platforms without rpc aren't filled")
+}
@@ -3,9 +3,12 @@ package ethereum
 import (
 	"github.com/trustwallet/blockatlas/coin"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/platform/ethereum/approval"
 	"github.com/trustwallet/blockatlas/platform/ethereum/blockbook"
 	"github.com/trustwallet/blockatlas/platform/ethereum/collection"
 	"github.com/trustwallet/blockatlas/platform/ethereum/ens"
+	"github.com/trustwallet/blockatlas/platform/ethereum/erc20"
+	"github.com/trustwallet/blockatlas/platform/ethereum/nft"
 	"github.com/trustwallet/blockatlas/platform/ethereum/trustray"
 )
 
@@ -15,6 +18,9 @@ type Platform struct {
 	client      EthereumClient
 	collectible collection.Client
 	ens         ens.RpcClient
+	approvals   approval.RpcClient
+	nft         nft.Client
+	erc20       erc20.RpcClient
 }
 
 func Init(coinType uint, api, rpc string) *Platform {
@@ -22,6 +28,9 @@ func Init(coinType uint, api, rpc string) *Platform {
 		CoinIndex: coinType,
 		RpcURL:    rpc,
 		ens:       ens.RpcClient{Request: blockatlas.InitJSONClient(rpc)},
+		approvals: approval.InitClient(rpc),
+		erc20:     erc20.RpcClient{Request: blockatlas.InitJSONClient(rpc)},
+		nft:       nft.InitClient(coinType, rpc, nft.DefaultGateway, nft.EIP55{CoinIndex: coinType}),
 		client:    &trustray.Client{Request: blockatlas.InitClient(api)},
 	}
 }
@@ -31,14 +40,26 @@ func InitWithBlockbook(coinType uint, blockbookApi, rpc string) *Platform {
 		CoinIndex: coinType,
 		RpcURL:    rpc,
 		ens:       ens.RpcClient{Request: blockatlas.InitJSONClient(rpc)},
+		approvals: approval.InitClient(rpc),
+		erc20:     erc20.RpcClient{Request: blockatlas.InitJSONClient(rpc)},
+		nft:       nft.InitClient(coinType, rpc, nft.DefaultGateway, nft.EIP55{CoinIndex: coinType}),
 		client:    &blockbook.Client{Request: blockatlas.InitClient(blockbookApi)},
 	}
 }
 
-func InitWitCollection(coinType uint, api, rpc, blockbookApi, collectionApi, collectionKey string) *Platform {
+// InitWitCollection serves the collectibles from OpenSea when the api key is set, from the chain otherwise
+func InitWitCollection(coinType uint, api, rpc, blockbookApi, collectionApi, collectionKey, gateway string) *Platform {
 	platform := InitWithBlockbook(coinType, blockbookApi, rpc)
 	platform.collectible = collection.Client{Request: blockatlas.InitClient(collectionApi)}
 	platform.collectible.Headers["X-API-KEY"] = collectionKey
+	platform.nft = nft.InitClient(coinType, rpc, gateway, nft.EIP55{CoinIndex: coinType})
+	return platform
+}
+
+// InitWithNativeCollection serves the ERC-721 and ERC-1155 collectibles from the chain through the rpc
+func InitWithNativeCollection(coinType uint, api, rpc, gateway string) *Platform {
+	platform := Init(coinType, api, rpc)
+	platform.nft = nft.InitClient(coinType, rpc, gateway, nft.EIP55{CoinIndex: coinType})
 	return platform
 }
 
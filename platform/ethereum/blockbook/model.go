@@ -46,14 +46,29 @@ type Output struct {
 }
 
 type TokenTransfer struct {
-	Decimals uint   `json:"decimals"`
-	From     string `json:"from"`
-	Name     string `json:"name"`
-	Symbol   string `json:"symbol"`
-	To       string `json:"to"`
-	Token    string `json:"token"`
-	Type     string `json:"type"`
-	Value    string `json:"value"`
+	Decimals         uint              `json:"decimals"`
+	From             string            `json:"from"`
+	Name             string            `json:"name"`
+	Symbol           string            `json:"symbol"`
+	To               string            `json:"to"`
+	Token            string            `json:"token"`
+	Type             string            `json:"type"`
+	Value            string            `json:"value"`
+	MultiTokenValues []MultiTokenValue `json:"multiTokenValues,omitempty"`
+}
+
+// MultiTokenValue contains the id and amount of an ERC-1155 transfer
+type MultiTokenValue struct {
+	ID    string `json:"id"`
+	Value string `json:"value"`
+}
+
+// InternalTransfer contains a value transfer made by a contract call
+type InternalTransfer struct {
+	Type  int    `json:"type"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+	Value string `json:"value"`
 }
 
 // Token contains info about tokens held by an address
@@ -74,4 +89,6 @@ type EthereumSpecific struct {
 	GasUsed  *big.Int `json:"gasUsed"`
 	GasPrice string   `json:"gasPrice"`
 	Data     string   `json:"data,omitempty"`
+
+	InternalTransfers []InternalTransfer `json:"internalTransfers,omitempty"`
 }
@@ -6,6 +6,9 @@ import (
 	"github.com/trustwallet/blockatlas/coin"
 	Address "github.com/trustwallet/blockatlas/pkg/address"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/platform/ethereum/approval"
+	"github.com/trustwallet/blockatlas/platform/ethereum/nft"
+	"github.com/trustwallet/blockatlas/platform/ethereum/swap"
 )
 
 func (c *Client) GetTransactions(address string, coinIndex uint) (blockatlas.TxPage, error) {
@@ -71,20 +74,142 @@ func normalizeBlockHeight(height int64) uint64 {
 }
 
 func fillMeta(final *blockatlas.Tx, tx *Transaction, coinIndex uint) {
+	if ok := fillMultiTransfer(final, tx, coinIndex); ok {
+		return
+	}
 	if ok := fillTokenTransfer(final, tx, coinIndex); !ok {
 		fillTransferOrContract(final, tx, coinIndex)
 	}
 }
 
 func fillMetaWithAddress(final *blockatlas.Tx, tx *Transaction, address, token string, coinIndex uint) {
+	if token == "" {
+		if ok := fillMultiTransfer(final, tx, coinIndex); ok {
+			if multi, ok := final.Meta.(blockatlas.MultiTransfer); ok {
+				final.Direction = multi.GetDirection(address, final.From, final.To)
+			}
+			return
+		}
+	}
 	if ok := fillTokenTransferWithAddress(final, tx, address, token, coinIndex); !ok {
 		fillTransferOrContract(final, tx, coinIndex)
+		if collectible, ok := final.Meta.(blockatlas.CollectibleTransfer); ok {
+			final.Direction = GetDirection(address, collectible.From, collectible.To)
+		}
+	}
+}
+
+// fillMultiTransfer fills all transfers of the transaction when they don't fit
+// into a single Transfer or TokenTransfer, or the swap they make through a known router
+func fillMultiTransfer(final *blockatlas.Tx, tx *Transaction, coinIndex uint) bool {
+	transfers, internal := getTransfers(tx, coinIndex)
+	if !internal && len(transfers) < 2 {
+		return false
+	}
+	if tokenSwap, ok := swap.Detect(final.From, final.To, transfers); ok {
+		final.Meta = tokenSwap
+		return true
+	}
+	final.Meta = blockatlas.MultiTransfer{Transfers: transfers}
+	return true
+}
+
+// getTransfers returns the native value, the internal value transfers and the token transfers of the transaction.
+// It also reports whether the transaction contains any internal value transfer.
+func getTransfers(tx *Transaction, coinIndex uint) (transfers []blockatlas.SubTransfer, internal bool) {
+	transfers = make([]blockatlas.SubTransfer, 0)
+	if hasValue(tx.Value) {
+		transfers = append(transfers, getNativeTransfer(tx.FromAddress(), tx.ToAddress(), tx.Value, coinIndex))
+	}
+	if tx.EthereumSpecific != nil {
+		for _, t := range tx.EthereumSpecific.InternalTransfers {
+			if !hasValue(t.Value) {
+				continue
+			}
+			internal = true
+			transfers = append(transfers, getNativeTransfer(t.From, t.To, t.Value, coinIndex))
+		}
+	}
+	for _, t := range tx.TokenTransfers {
+		transfers = append(transfers, getTokenTransfers(t)...)
 	}
+	return transfers, internal
+}
+
+func getNativeTransfer(from, to, value string, coinIndex uint) blockatlas.SubTransfer {
+	return blockatlas.SubTransfer{
+		Type:     blockatlas.TxTransfer,
+		Name:     coin.Coins[coinIndex].Name,
+		Symbol:   coin.Coins[coinIndex].Symbol,
+		Decimals: coin.Coins[coinIndex].Decimals,
+		Value:    blockatlas.Amount(value),
+		From:     from,
+		To:       to,
+	}
+}
+
+func getTokenTransfers(transfer TokenTransfer) []blockatlas.SubTransfer {
+	base := blockatlas.SubTransfer{
+		Type:     blockatlas.TxTokenTransfer,
+		Name:     transfer.Name,
+		Symbol:   transfer.Symbol,
+		TokenID:  transfer.Token,
+		Decimals: transfer.Decimals,
+		Value:    blockatlas.Amount(transfer.Value),
+		From:     transfer.From,
+		To:       transfer.To,
+	}
+	switch blockatlas.TokenType(transfer.Type) {
+	case blockatlas.TokenTypeERC721:
+		// ERC-721 logs carry the token id as value
+		base.Type = blockatlas.TxCollectibleTransfer
+		base.CollectibleID = transfer.Value
+		base.Decimals = 0
+		base.Value = "1"
+		return []blockatlas.SubTransfer{base}
+	case blockatlas.TokenTypeERC1155:
+		transfers := make([]blockatlas.SubTransfer, 0, len(transfer.MultiTokenValues))
+		for _, v := range transfer.MultiTokenValues {
+			t := base
+			t.Type = blockatlas.TxCollectibleTransfer
+			t.CollectibleID = v.ID
+			t.Decimals = 0
+			t.Value = blockatlas.Amount(v.Value)
+			transfers = append(transfers, t)
+		}
+		return transfers
+	default:
+		return []blockatlas.SubTransfer{base}
+	}
+}
+
+func hasValue(value string) bool {
+	return value != "" && value != "0"
+}
+
+// getCollectibleTransfer returns the transfer of an ERC-721 token or of a single ERC-1155 token id
+func getCollectibleTransfer(transfer TokenTransfer) (blockatlas.CollectibleTransfer, bool) {
+	transfers := getTokenTransfers(transfer)
+	if len(transfers) != 1 || transfers[0].Type != blockatlas.TxCollectibleTransfer {
+		return blockatlas.CollectibleTransfer{}, false
+	}
+	return blockatlas.CollectibleTransfer{
+		Name:     transfers[0].Name,
+		Contract: transfers[0].TokenID,
+		TokenID:  transfers[0].CollectibleID,
+		Value:    transfers[0].Value,
+		From:     transfers[0].From,
+		To:       transfers[0].To,
+	}, true
 }
 
 func fillTokenTransfer(final *blockatlas.Tx, tx *Transaction, coinIndex uint) bool {
 	if len(tx.TokenTransfers) == 1 {
 		transfer := tx.TokenTransfers[0]
+		if collectible, ok := getCollectibleTransfer(transfer); ok {
+			final.Meta = collectible
+			return true
+		}
 		final.Meta = blockatlas.TokenTransfer{
 			Name:     transfer.Name,
 			Symbol:   transfer.Symbol,
@@ -110,6 +235,11 @@ func fillTokenTransferWithAddress(final *blockatlas.Tx, tx *Transaction, address
 				}
 			}
 			direction := GetDirection(address, transfer.From, transfer.To)
+			final.Direction = direction
+			if collectible, ok := getCollectibleTransfer(transfer); ok {
+				final.Meta = collectible
+				return true
+			}
 			metadata := blockatlas.TokenTransfer{
 				Name:     transfer.Name,
 				Symbol:   transfer.Symbol,
@@ -153,7 +283,11 @@ func fillTransferOrContract(final *blockatlas.Tx, tx *Transaction, coinIndex uin
 			Value: tx.Value,
 		}
 	} else {
-		if len(strings.TrimPrefix(data, "0x")) > 0 {
+		if action, ok := approval.Action(coinIndex, tx.ToAddress(), data); ok {
+			final.Meta = action
+		} else if collectible, ok := nft.DecodeTransfer(coinIndex, tx.ToAddress(), data); ok {
+			final.Meta = collectible
+		} else if len(strings.TrimPrefix(data, "0x")) > 0 {
 			final.Meta = blockatlas.ContractCall{
 				Input: data,
 				Value: tx.Value,
@@ -274,12 +274,42 @@ func TestNormalizePage(t *testing.T) {
 					"block": 8958320,
 					"status": "completed",
 					"sequence": 378,
-					"type": "contract_call",
+					"type": "multi_transfer",
 					"direction": "outgoing",
 					"memo": "",
 					"metadata": {
-						"input": "0x",
-						"value": "0"
+						"transfers": [
+						{
+							"type": "token_transfer",
+							"name": "Dai Stablecoin v1.0",
+							"symbol": "DAI",
+							"token_id": "0x89d24A6b4CcB1B6fAA2625fE562bDD9a23260359",
+							"decimals": 18,
+							"value": "2255656573089233195",
+							"from": "0x7d8bf18C7cE84b3E175b339c4Ca93aEd1dD166F1",
+							"to": "0xc73e0383F3Aff3215E6f04B0331D58CeCf0Ab849"
+						},
+						{
+							"type": "token_transfer",
+							"name": "Dai Stablecoin v1.0",
+							"symbol": "DAI",
+							"token_id": "0x89d24A6b4CcB1B6fAA2625fE562bDD9a23260359",
+							"decimals": 18,
+							"value": "2255656573089233195",
+							"from": "0xc73e0383F3Aff3215E6f04B0331D58CeCf0Ab849",
+							"to": "0xad37fd42185Ba63009177058208dd1be4b136e6b"
+						},
+						{
+							"type": "token_transfer",
+							"name": "Dai Stablecoin",
+							"symbol": "DAI",
+							"token_id": "0x6B175474E89094C44Da98b954EedeAC495271d0F",
+							"decimals": 18,
+							"value": "2255656573089233195",
+							"from": "0x0000000000000000000000000000000000000000",
+							"to": "0x7d8bf18C7cE84b3E175b339c4Ca93aEd1dD166F1"
+						}
+						]
 					}
 				  },{
 					"id": "0x17bb2b5e61f34119d4d4fbfae406ad3d854f0a00f13013d77de9aab7179f183f",
@@ -383,3 +413,135 @@ func TestNormalizePage(t *testing.T) {
 		})
 	}
 }
+
+func TestNormalizeTx_MultiTransfer(t *testing.T) {
+	const (
+		sender   = "0x7d8bf18C7cE84b3E175b339c4Ca93aEd1dD166F1"
+		contract = "0xc73e0383F3Aff3215E6f04B0331D58CeCf0Ab849"
+		receiver = "0xad37fd42185Ba63009177058208dd1be4b136e6b"
+		nft      = "0x06012c8cf97BEaD5deAe237070F9587f8E7A266d"
+	)
+	srcTx := Transaction{
+		TxID:        "0xb1a32935f9b015bcfdda1b2e3d281b3780d1a6f7a2d4406e05ec2b826b2349cb",
+		Vin:         []Output{{Addresses: []string{sender}}},
+		Vout:        []Output{{Addresses: []string{contract}}},
+		BlockHeight: 8958320,
+		Value:       "1000",
+		TokenTransfers: []TokenTransfer{
+			{Type: "ERC721", Token: nft, Name: "CryptoKitties", Symbol: "CK", From: contract, To: sender, Value: "1500"},
+			{Type: "ERC1155", Token: nft, Name: "Items", Symbol: "ITM", From: contract, To: sender, MultiTokenValues: []MultiTokenValue{{ID: "7", Value: "2"}}},
+		},
+		EthereumSpecific: &EthereumSpecific{
+			Status: 1,
+			InternalTransfers: []InternalTransfer{
+				{From: contract, To: receiver, Value: "400"},
+				{From: contract, To: sender, Value: "0"},
+			},
+		},
+	}
+	tx := normalizeTx(&srcTx, 60)
+	assert.Equal(t, blockatlas.MultiTransfer{Transfers: []blockatlas.SubTransfer{
+		{Type: blockatlas.TxTransfer, Name: "Ethereum", Symbol: "ETH", Decimals: 18, Value: "1000", From: sender, To: contract},
+		{Type: blockatlas.TxTransfer, Name: "Ethereum", Symbol: "ETH", Decimals: 18, Value: "400", From: contract, To: receiver},
+		{Type: blockatlas.TxCollectibleTransfer, Name: "CryptoKitties", Symbol: "CK", TokenID: nft, CollectibleID: "1500", Value: "1", From: contract, To: sender},
+		{Type: blockatlas.TxCollectibleTransfer, Name: "Items", Symbol: "ITM", TokenID: nft, CollectibleID: "7", Value: "2", From: contract, To: sender},
+	}}, tx.Meta)
+	assert.Subset(t, tx.GetAddresses(), []string{sender, contract, receiver})
+	assert.Equal(t, blockatlas.DirectionIncoming, tx.GetTransactionDirection(receiver))
+	assert.Equal(t, blockatlas.DirectionOutgoing, tx.GetTransactionDirection(sender))
+}
+
+func TestNormalizeTx_TokenSwap(t *testing.T) {
+	const (
+		trader = "0x7d8bf18C7cE84b3E175b339c4Ca93aEd1dD166F1"
+		router = "0x7a250d5630B4cF539739dF2C5dAcb4c659F2488D"
+		pair   = "0xA478c2975Ab1Ea89e8196811F51A7B7Ade33eB11"
+		dai    = "0x6B175474E89094C44Da98b954EedeAC495271d0F"
+		weth   = "0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2"
+	)
+	srcTx := Transaction{
+		TxID:  "0x2a8b1e6e7d3f9a0c4e5b6d7f8091a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3",
+		Vin:   []Output{{Addresses: []string{trader}}},
+		Vout:  []Output{{Addresses: []string{router}}},
+		Value: "1000000000000000000",
+		TokenTransfers: []TokenTransfer{
+			{Type: "ERC20", Token: weth, Name: "Wrapped Ether", Symbol: "WETH", Decimals: 18, From: router, To: pair, Value: "1000000000000000000"},
+			{Type: "ERC20", Token: dai, Name: "Dai Stablecoin", Symbol: "DAI", Decimals: 18, From: pair, To: trader, Value: "350000000000000000000"},
+		},
+		EthereumSpecific: &EthereumSpecific{Status: 1},
+	}
+	tx := normalizeTxWithAddress(&srcTx, trader, "", 60)
+	assert.Equal(t, blockatlas.TokenSwap{
+		Input:  blockatlas.TokenTransfer{Name: "Ethereum", Symbol: "ETH", Decimals: 18, Value: "1000000000000000000", From: trader, To: router},
+		Output: blockatlas.TokenTransfer{Name: "Dai Stablecoin", Symbol: "DAI", TokenID: dai, Decimals: 18, Value: "350000000000000000000", From: router, To: trader},
+	}, tx.Meta)
+	assert.Equal(t, blockatlas.DirectionOutgoing, tx.Direction)
+}
+
+func TestNormalizeTx_ApproveToken(t *testing.T) {
+	const (
+		owner = "0x7d8bf18C7cE84b3E175b339c4Ca93aEd1dD166F1"
+		dai   = "0x6B175474E89094C44Da98b954EedeAC495271d0F"
+	)
+	srcTx := Transaction{
+		TxID:  "0x5c3b1e6e7d3f9a0c4e5b6d7f8091a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3",
+		Vin:   []Output{{Addresses: []string{owner}}},
+		Vout:  []Output{{Addresses: []string{dai}}},
+		Value: "0",
+		EthereumSpecific: &EthereumSpecific{
+			Status: 1,
+			Data: "0x095ea7b30000000000000000000000007a250d5630b4cf539739df2c5dacb4c659f2488d" +
+				"0000000000000000000000000000000000000000000000000de0b6b3a7640000",
+		},
+	}
+	tx := normalizeTxWithAddress(&srcTx, owner, "", 60)
+	assert.Equal(t, blockatlas.AnyAction{
+		Coin:    60,
+		Title:   blockatlas.KeyTitleApproveToken,
+		Key:     blockatlas.KeyApproveToken,
+		TokenID: dai,
+		Spender: "0x7a250d5630B4cF539739dF2C5dAcb4c659F2488D",
+		Value:   "1000000000000000000",
+	}, tx.Meta)
+	assert.Equal(t, blockatlas.DirectionOutgoing, tx.Direction)
+}
+
+func TestNormalizeTx_CollectibleTransfer(t *testing.T) {
+	const (
+		seller  = "0x7d8bf18C7cE84b3E175b339c4Ca93aEd1dD166F1"
+		buyer   = "0x0875BCab22dE3d02402bc38aEe4104e1239374a7"
+		kitties = "0x06012c8cf97BEaD5deAe237070F9587f8E7A266d"
+		items   = "0xd07dc4262BCDbf85190C01c996b4C06a461d2430"
+	)
+	srcTx := Transaction{
+		TxID:  "0x3b2b1e6e7d3f9a0c4e5b6d7f8091a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3",
+		Vin:   []Output{{Addresses: []string{seller}}},
+		Vout:  []Output{{Addresses: []string{kitties}}},
+		Value: "0",
+		TokenTransfers: []TokenTransfer{
+			{Type: "ERC721", Token: kitties, Name: "CryptoKitties", Symbol: "CK", From: seller, To: buyer, Value: "1"},
+		},
+		EthereumSpecific: &EthereumSpecific{Status: 1},
+	}
+	tx := normalizeTxWithAddress(&srcTx, buyer, "", 60)
+	assert.Equal(t, blockatlas.CollectibleTransfer{Name: "CryptoKitties", Contract: kitties, TokenID: "1", Value: "1", From: seller, To: buyer}, tx.Meta)
+	assert.Equal(t, blockatlas.DirectionIncoming, tx.Direction)
+
+	srcTx.Vout = []Output{{Addresses: []string{items}}}
+	srcTx.TokenTransfers = []TokenTransfer{
+		{Type: "ERC1155", Token: items, Name: "Rarible", From: seller, To: buyer, MultiTokenValues: []MultiTokenValue{{ID: "7", Value: "5"}}},
+	}
+	tx = normalizeTx(&srcTx, 60)
+	assert.Equal(t, blockatlas.CollectibleTransfer{Name: "Rarible", Contract: items, TokenID: "7", Value: "5", From: seller, To: buyer}, tx.Meta)
+
+	// older nodes don't index the collectibles, the call is decoded
+	srcTx.Vout = []Output{{Addresses: []string{kitties}}}
+	srcTx.TokenTransfers = nil
+	srcTx.EthereumSpecific.Data = "0x42842e0e" +
+		"0000000000000000000000007d8bf18c7ce84b3e175b339c4ca93aed1dd166f1" +
+		"0000000000000000000000000875bcab22de3d02402bc38aee4104e1239374a7" +
+		"0000000000000000000000000000000000000000000000000000000000000002"
+	tx = normalizeTxWithAddress(&srcTx, buyer, "", 60)
+	assert.Equal(t, blockatlas.CollectibleTransfer{Contract: kitties, TokenID: "2", Value: "1", From: seller, To: buyer}, tx.Meta)
+	assert.Equal(t, blockatlas.DirectionIncoming, tx.Direction)
+}
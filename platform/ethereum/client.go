@@ -2,6 +2,7 @@ package ethereum
 
 import (
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/logger"
 )
 
 type EthereumClient interface {
@@ -20,6 +21,15 @@ func (p *Platform) CurrentBlockNumber() (int64, error) {
 	return p.client.GetCurrentBlockNumber()
 }
 
+// GetBlockByNumber returns the block with its approvals filled when the rpc is available,
+// a failure of the approvals doesn't fail the block
 func (p *Platform) GetBlockByNumber(num int64) (*blockatlas.Block, error) {
-	return p.client.GetBlockByNumber(num, p.CoinIndex)
+	block, err := p.client.GetBlockByNumber(num, p.CoinIndex)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.fillBlockApprovals(block); err != nil {
+		logger.Error(err, logger.Params{"coin": p.CoinIndex})
+	}
+	return block, nil
 }
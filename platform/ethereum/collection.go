@@ -11,7 +11,15 @@ var (
 	supportedTypes = map[string]bool{"ERC721": true, "ERC1155": true}
 )
 
+// hasOpenSea returns true if the OpenSea api key is set, the collectibles are read from the chain otherwise
+func (p *Platform) hasOpenSea() bool {
+	return p.collectible.BaseUrl != "" && p.collectible.Headers["X-API-KEY"] != ""
+}
+
 func (p *Platform) GetCollections(owner string) (blockatlas.CollectionPage, error) {
+	if !p.hasOpenSea() {
+		return p.nft.GetCollections(owner)
+	}
 	collections, err := p.collectible.GetCollections(owner)
 	if err != nil {
 		return nil, err
@@ -20,6 +28,9 @@ func (p *Platform) GetCollections(owner string) (blockatlas.CollectionPage, erro
 }
 
 func (p *Platform) GetCollectibles(owner, collectibleID string) (blockatlas.CollectiblePage, error) {
+	if !p.hasOpenSea() {
+		return p.nft.GetCollectibles(owner, collectibleID)
+	}
 	items, err := p.collectible.GetCollectibles(owner, collectibleID)
 	if err != nil {
 		return nil, err
@@ -7,6 +7,9 @@ import (
 )
 
 func (p *Platform) GetCollectionsV3(owner string) (blockatlas.CollectionPageV3, error) {
+	if !p.hasOpenSea() {
+		return p.nft.GetCollectionsV3(owner)
+	}
 	collections, err := p.collectible.GetCollections(owner)
 	if err != nil {
 		return nil, err
@@ -16,6 +19,9 @@ func (p *Platform) GetCollectionsV3(owner string) (blockatlas.CollectionPageV3,
 }
 
 func (p *Platform) GetCollectiblesV3(owner, collectibleID string) (blockatlas.CollectiblePageV3, error) {
+	if !p.hasOpenSea() {
+		return p.nft.GetCollectiblesV3(owner, collectibleID)
+	}
 	collection, items, err := p.collectible.GetCollectiblesV3(owner, collectibleID)
 	if err != nil {
 		return nil, err
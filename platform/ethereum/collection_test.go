@@ -6,6 +6,7 @@ import (
 	"github.com/trustwallet/blockatlas/coin"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 	"github.com/trustwallet/blockatlas/platform/ethereum/collection"
+	"github.com/trustwallet/blockatlas/platform/ethereum/nft"
 	"testing"
 )
 
@@ -329,3 +330,10 @@ func TestNormalizeCollectibleV4(t *testing.T) {
 	expected := blockatlas.CollectiblePage{collectibleDstV4}
 	assert.Equal(t, page, expected, "collectible don't equal")
 }
+
+func TestInitWithNativeCollection_Gateway(t *testing.T) {
+	p := InitWithNativeCollection(coin.ETH, "", "", "")
+	assert.Equal(t, nft.DefaultGateway, p.nft.Gateway)
+	p = InitWitCollection(coin.ETH, "", "", "", "", "", "https://gateway.test/ipfs")
+	assert.Equal(t, "https://gateway.test/ipfs/", p.nft.Gateway)
+}
@@ -47,6 +47,69 @@ func (p *Platform) Lookup(coins []uint64, name string) ([]blockatlas.Resolved, e
 	return result, nil
 }
 
+// ReverseLookup resolves the name of the ENS reverse record of an ETH address.
+// The record is set by the address owner, so the name has to be verified with a forward Lookup.
+func (p *Platform) ReverseLookup(coinID uint64, address string) (string, error) {
+	if coinID != coin.ETH {
+		return "", nil
+	}
+	node, err := ens.NameHash(ens.ReverseName(address))
+	if err != nil {
+		return "", errors.E(err, "name hash failed")
+	}
+	resolver, err := p.ens.Resolver(node[:])
+	if err == ens.ErrNotRegistered {
+		return "", nil
+	}
+	if err != nil {
+		return "", errors.E(err, "query resolver failed")
+	}
+	name, err := p.ens.Name("0x"+resolver, node[:])
+	if err != nil {
+		return "", errors.E(err, "query name failed", errors.Params{"address": address})
+	}
+	return name, nil
+}
+
+// textRecords maps the profile fields to the ENS text record keys (EIP-634)
+var textRecords = map[blockatlas.ProfileField]string{
+	blockatlas.ProfileAvatar:      "avatar",
+	blockatlas.ProfileURL:         "url",
+	blockatlas.ProfileEmail:       "email",
+	blockatlas.ProfileTwitter:     "com.twitter",
+	blockatlas.ProfileDescription: "description",
+}
+
+func (p *Platform) Record(name string, field blockatlas.ProfileField) (string, error) {
+	node, err := ens.NameHash(name)
+	if err != nil {
+		return "", errors.E(err, "name hash failed")
+	}
+	resolver, err := p.ens.Resolver(node[:])
+	if err == ens.ErrNotRegistered {
+		return "", nil
+	}
+	if err != nil {
+		return "", errors.E(err, "query resolver failed")
+	}
+	if field == blockatlas.ProfileContentHash {
+		hash, err := p.ens.Contenthash("0x"+resolver, node[:])
+		if err != nil {
+			return "", errors.E(err, "query contenthash failed", errors.Params{"name": name})
+		}
+		return ens.DecodeContenthash(hash)
+	}
+	key, ok := textRecords[field]
+	if !ok {
+		return "", nil
+	}
+	value, err := p.ens.Text("0x"+resolver, node[:], key)
+	if err != nil {
+		return "", errors.E(err, "query text record failed", errors.Params{"name": name, "key": key})
+	}
+	return value, nil
+}
+
 func (p *Platform) addressForCoin(resovler string, node []byte, coinID uint64) (string, error) {
 	result, err := p.ens.Addr(resovler, node, coinID)
 	if err != nil {
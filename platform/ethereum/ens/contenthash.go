@@ -0,0 +1,110 @@
+package ens
+
+import (
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/trustwallet/blockatlas/pkg/errors"
+)
+
+// Multicodec codes of the EIP-1577 contenthash namespaces and of the CIDs
+const (
+	codecIpfs      = 0xe3
+	codecSwarm     = 0xe4
+	codecIpns      = 0xe5
+	codecDagPb     = 0x70
+	codecIdentity  = 0x00
+	codecSha256    = 0x12
+	cidVersion1    = 0x01
+	multihashBytes = 32
+)
+
+var base32Lower = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+
+// DecodeContenthash converts an EIP-1577 contenthash to an ipfs://, ipns:// or bzz:// URI
+func DecodeContenthash(b []byte) (string, error) {
+	if len(b) == 0 {
+		return "", nil
+	}
+	namespace, n := binary.Uvarint(b)
+	if n <= 0 {
+		return "", errors.E("invalid contenthash namespace")
+	}
+	cid := b[n:]
+	switch namespace {
+	case codecIpfs:
+		encoded, err := encodeCid(cid)
+		if err != nil {
+			return "", err
+		}
+		return "ipfs://" + encoded, nil
+	case codecIpns:
+		return decodeIpns(cid)
+	case codecSwarm:
+		hash, err := multihashDigest(cid)
+		if err != nil {
+			return "", err
+		}
+		return "bzz://" + hex.EncodeToString(hash), nil
+	default:
+		return "", errors.E("unsupported contenthash namespace", errors.Params{"namespace": namespace})
+	}
+}
+
+// encodeCid returns dag-pb sha2-256 CIDs in the v0 base58 form (Qm...), the others in the v1 base32 form
+func encodeCid(cid []byte) (string, error) {
+	version, codec, multihash, err := splitCid(cid)
+	if err != nil {
+		return "", err
+	}
+	if version == cidVersion1 && codec == codecDagPb && len(multihash) == multihashBytes+2 && multihash[0] == codecSha256 {
+		return base58.Encode(multihash), nil
+	}
+	return "b" + base32Lower.EncodeToString(cid), nil
+}
+
+// decodeIpns handles both DNSLink names stored as identity multihash and libp2p keys
+func decodeIpns(cid []byte) (string, error) {
+	_, _, multihash, err := splitCid(cid)
+	if err != nil {
+		return "", err
+	}
+	code, n := binary.Uvarint(multihash)
+	if n > 0 && code == codecIdentity {
+		length, m := binary.Uvarint(multihash[n:])
+		if m > 0 && uint64(len(multihash[n+m:])) == length {
+			return "ipns://" + string(multihash[n+m:]), nil
+		}
+	}
+	return "ipns://" + base58.Encode(multihash), nil
+}
+
+func splitCid(cid []byte) (version, codec uint64, multihash []byte, err error) {
+	version, n := binary.Uvarint(cid)
+	if n <= 0 {
+		return 0, 0, nil, errors.E("invalid cid version")
+	}
+	codec, m := binary.Uvarint(cid[n:])
+	if m <= 0 {
+		return 0, 0, nil, errors.E("invalid cid codec")
+	}
+	return version, codec, cid[n+m:], nil
+}
+
+func multihashDigest(cid []byte) ([]byte, error) {
+	_, _, multihash, err := splitCid(cid)
+	if err != nil {
+		return nil, err
+	}
+	_, n := binary.Uvarint(multihash)
+	if n <= 0 {
+		return nil, errors.E("invalid multihash code")
+	}
+	length, m := binary.Uvarint(multihash[n:])
+	if m <= 0 || uint64(len(multihash[n+m:])) != length {
+		return nil, errors.E("invalid multihash length")
+	}
+	return multihash[n+m:], nil
+}
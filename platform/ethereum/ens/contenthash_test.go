@@ -0,0 +1,46 @@
+package ens
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeContenthash(t *testing.T) {
+	tests := []struct {
+		name    string
+		hash    string
+		want    string
+		wantErr bool
+	}{
+		{
+			"ipfs",
+			"e3010170122029f2d17be6139079dc48696d1f582a8530eb9805b561eda517e22a892c7e3f1f",
+			"ipfs://QmRAQB6YaCyidP37UdDnjFY5vQuiBrcqdyoW1CuDgwxkD4",
+			false,
+		},
+		{
+			"swarm",
+			"e40101fa011b20d1de9994b4d039f6548d191eb26786769f580809256b4685ef316805265ea162",
+			"bzz://d1de9994b4d039f6548d191eb26786769f580809256b4685ef316805265ea162",
+			false,
+		},
+		{
+			"ipns dnslink",
+			"e5010170000f6170702e756e69737761702e6f7267",
+			"ipns://app.uniswap.org",
+			false,
+		},
+		{"empty", "", "", false},
+		{"unsupported namespace", "0101", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, _ := hex.DecodeString(tt.hash)
+			got, err := DecodeContenthash(b)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
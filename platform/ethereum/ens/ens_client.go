@@ -2,6 +2,7 @@ package ens
 
 import (
 	"encoding/hex"
+	"strings"
 
 	"github.com/trustwallet/blockatlas/pkg/address"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
@@ -9,9 +10,12 @@ import (
 )
 
 const (
-	registry = "0x00000000000C2E074eC69A0dFb2997BA6C7d2e1e"
+	registry    = "0x00000000000C2E074eC69A0dFb2997BA6C7d2e1e"
+	reverseNode = "addr.reverse"
 )
 
+var ErrNotRegistered = errors.E("unregistered name or resolver not set")
+
 type RpcClient struct {
 	blockatlas.Request
 }
@@ -43,7 +47,7 @@ func (c *RpcClient) Resolver(node []byte) (string, error) {
 		return "", err
 	}
 	if allZero(address.Remove0x(result)) {
-		return "", errors.E("unregistered name or resolver not set")
+		return "", ErrNotRegistered
 	}
 	if len(result) < 40 {
 		return "", errors.E("invalid address length")
@@ -74,6 +78,44 @@ func (c *RpcClient) LegacyAddr(resolver string, node []byte) (string, error) {
 	return address.EIP55Checksum(result[len(result)-40:]), nil
 }
 
+// Name returns the name stored in the resolver of a reverse record
+func (c *RpcClient) Name(resolver string, node []byte) (string, error) {
+	data := encodeName(node)
+	params := c.toParams(resolver, data)
+	result, err := c.EthCall(params)
+	if err != nil {
+		return "", err
+	}
+	return string(decodeBytesInHex(result)), nil
+}
+
+// Text returns a text record of the name, e.g. avatar, url, email or com.twitter
+func (c *RpcClient) Text(resolver string, node []byte, key string) (string, error) {
+	data := encodeText(node, key)
+	params := c.toParams(resolver, data)
+	result, err := c.EthCall(params)
+	if err != nil {
+		return "", err
+	}
+	return string(decodeBytesInHex(result)), nil
+}
+
+// Contenthash returns the EIP-1577 contenthash of the name
+func (c *RpcClient) Contenthash(resolver string, node []byte) ([]byte, error) {
+	data := encodeContenthash(node)
+	params := c.toParams(resolver, data)
+	result, err := c.EthCall(params)
+	if err != nil {
+		return nil, err
+	}
+	return decodeBytesInHex(result), nil
+}
+
+// ReverseName returns the name of the reverse record of an address, e.g. <address>.addr.reverse
+func ReverseName(addr string) string {
+	return strings.ToLower(address.Remove0x(addr)) + "." + reverseNode
+}
+
 func allZero(s string) bool {
 	for _, v := range s {
 		if v != '0' {
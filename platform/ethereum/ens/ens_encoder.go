@@ -43,6 +43,35 @@ func encodeLegacyAddr(node []byte) []byte {
 	return data
 }
 
+func encodeName(node []byte) []byte {
+	data := make([]byte, 0, 36)
+	signature := encodeFunc("name(bytes32)")
+	data = append(data, signature...)
+	data = append(data, node...)
+	return data
+}
+
+func encodeText(node []byte, key string) []byte {
+	signature := encodeFunc("text(bytes32,string)")
+	padded := (len(key) + 31) / 32 * 32
+	data := make([]byte, 0, 4+32*3+padded)
+	data = append(data, signature...)
+	data = append(data, node...)
+	data = append(data, encodeCoinType(64)...) // offset of the key
+	data = append(data, encodeCoinType(uint64(len(key)))...)
+	data = append(data, []byte(key)...)
+	data = append(data, make([]byte, padded-len(key))...)
+	return data
+}
+
+func encodeContenthash(node []byte) []byte {
+	data := make([]byte, 0, 36)
+	signature := encodeFunc("contenthash(bytes32)")
+	data = append(data, signature...)
+	data = append(data, node...)
+	return data
+}
+
 func encodeFunc(fn string) []byte {
 	data := make([]byte, 0, 32)
 	sha := sha3.NewLegacyKeccak256()
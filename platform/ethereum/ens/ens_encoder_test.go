@@ -191,3 +191,40 @@ func Test_decodeBytes(t *testing.T) {
 		})
 	}
 }
+
+func Test_encodeName(t *testing.T) {
+	node, _ := hex.DecodeString("5ddd0923ace8fe255c0971f8e60d7cd400ae734142a13c14d29a87deb87cdac6")
+	want := "691f34315ddd0923ace8fe255c0971f8e60d7cd400ae734142a13c14d29a87deb87cdac6"
+	if got := encodeName(node); hex.EncodeToString(got) != want {
+		t.Errorf("encodeName() = %v, want %v", hex.EncodeToString(got), want)
+	}
+}
+
+func Test_decodeName(t *testing.T) {
+	result := "0x0000000000000000000000000000000000000000000000000000000000000020" +
+		"000000000000000000000000000000000000000000000000000000000000000b" +
+		"766974616c696b2e657468000000000000000000000000000000000000000000"
+	if got := string(decodeBytesInHex(result)); got != "vitalik.eth" {
+		t.Errorf("decodeBytesInHex() = %v, want vitalik.eth", got)
+	}
+}
+
+func TestReverseName(t *testing.T) {
+	got := ReverseName("0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045")
+	want := "d8da6bf26964af9d7eed9e03e53415d37aa96045.addr.reverse"
+	if got != want {
+		t.Errorf("ReverseName() = %v, want %v", got, want)
+	}
+}
+
+func Test_encodeText(t *testing.T) {
+	node, _ := hex.DecodeString("5ddd0923ace8fe255c0971f8e60d7cd400ae734142a13c14d29a87deb87cdac6")
+	want := "59d1d43c" +
+		"5ddd0923ace8fe255c0971f8e60d7cd400ae734142a13c14d29a87deb87cdac6" +
+		"0000000000000000000000000000000000000000000000000000000000000040" +
+		"0000000000000000000000000000000000000000000000000000000000000006" +
+		"6176617461720000000000000000000000000000000000000000000000000000"
+	if got := encodeText(node, "avatar"); hex.EncodeToString(got) != want {
+		t.Errorf("encodeText() = %v, want %v", hex.EncodeToString(got), want)
+	}
+}
@@ -0,0 +1,58 @@
+package erc20
+
+import (
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/errors"
+	"github.com/trustwallet/blockatlas/platform/ethereum/abi"
+)
+
+const (
+	nameSelector     = "0x06fdde03"
+	symbolSelector   = "0x95d89b41"
+	decimalsSelector = "0x313ce567"
+)
+
+type RpcClient struct {
+	blockatlas.Request
+}
+
+func (c *RpcClient) EthCall(to, data string) (string, error) {
+	var res string
+	params := []interface{}{
+		map[string]interface{}{
+			"to":   to,
+			"data": data,
+		},
+		"latest",
+	}
+	err := c.RpcCall(&res, "eth_call", params)
+	if err != nil {
+		return "", err
+	}
+	return res, nil
+}
+
+// TokenInfo returns the name, the symbol and the decimals of the token contract
+func (c *RpcClient) TokenInfo(contract string) (name string, symbol string, decimals uint, err error) {
+	result, err := c.EthCall(contract, decimalsSelector)
+	if err != nil {
+		return "", "", 0, err
+	}
+	value, ok := abi.DecodeUint(result)
+	if !ok || isEmpty(result) || !value.IsUint64() || value.Uint64() > 255 {
+		return "", "", 0, errors.E("not an erc20 contract", errors.Params{"contract": contract})
+	}
+	decimals = uint(value.Uint64())
+	if result, err := c.EthCall(contract, nameSelector); err == nil {
+		name = abi.DecodeString(result)
+	}
+	if result, err := c.EthCall(contract, symbolSelector); err == nil {
+		symbol = abi.DecodeString(result)
+	}
+	return name, symbol, decimals, nil
+}
+
+// isEmpty returns true for the result of a call to an address without code
+func isEmpty(result string) bool {
+	return result == "" || result == "0x"
+}
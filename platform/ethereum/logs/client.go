@@ -0,0 +1,135 @@
+package logs
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/errors"
+)
+
+const (
+	// DefaultBlockRange is the number of blocks of a single eth_getLogs call, the nodes reject
+	// the calls whose range or result is too large
+	DefaultBlockRange = 500000
+
+	cacheTTL = time.Hour * 24
+)
+
+type (
+	// Log is an event returned by eth_getLogs
+	Log struct {
+		Address         string   `json:"address"`
+		Topics          []string `json:"topics"`
+		Data            string   `json:"data"`
+		BlockNumber     string   `json:"blockNumber"`
+		LogIndex        string   `json:"logIndex"`
+		TransactionHash string   `json:"transactionHash"`
+	}
+
+	// Client pages eth_getLogs over the block range from StartBlock. The logs of a filter are cached with
+	// the last block scanned, the next calls only scan the blocks produced since.
+	Client struct {
+		blockatlas.Request
+		BlockRange int64
+		StartBlock int64
+		cache      *cache.Cache
+	}
+
+	scan struct {
+		toBlock int64
+		logs    []Log
+	}
+)
+
+func InitClient(rpc string) Client {
+	return Client{
+		Request:    blockatlas.InitJSONClient(rpc),
+		BlockRange: DefaultBlockRange,
+		cache:      cache.New(cacheTTL, time.Hour),
+	}
+}
+
+// GetLogs returns the logs matching the topics from the start block to the latest one
+func (c *Client) GetLogs(topics []interface{}) ([]Log, error) {
+	latest, err := c.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+	return c.GetLogsUntil(topics, latest)
+}
+
+// GetLogsUntil returns the logs matching the topics from the start block to the given one, the queries
+// of a single request share the latest block
+func (c *Client) GetLogsUntil(topics []interface{}, latest int64) ([]Log, error) {
+	key, err := json.Marshal(topics)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		from   = c.StartBlock
+		result []Log
+	)
+	if cached, ok := c.cache.Get(string(key)); ok {
+		s := cached.(scan)
+		if s.toBlock >= latest {
+			return s.logs, nil
+		}
+		from = s.toBlock + 1
+		result = make([]Log, len(s.logs))
+		copy(result, s.logs)
+	}
+	logs, err := c.GetLogsInRange(topics, from, latest)
+	if err != nil {
+		return nil, err
+	}
+	result = append(result, logs...)
+	c.cache.Set(string(key), scan{toBlock: latest, logs: result}, cache.DefaultExpiration)
+	return result, nil
+}
+
+// GetLogsInRange returns the logs matching the topics between the two blocks included
+func (c *Client) GetLogsInRange(topics []interface{}, from, to int64) ([]Log, error) {
+	blockRange := c.BlockRange
+	if blockRange <= 0 {
+		blockRange = DefaultBlockRange
+	}
+	result := make([]Log, 0)
+	for start := from; start <= to; start += blockRange {
+		end := start + blockRange - 1
+		if end > to {
+			end = to
+		}
+		var logs []Log
+		params := []interface{}{
+			map[string]interface{}{
+				"fromBlock": toHex(start),
+				"toBlock":   toHex(end),
+				"topics":    topics,
+			},
+		}
+		if err := c.RpcCall(&logs, "eth_getLogs", params); err != nil {
+			return nil, err
+		}
+		result = append(result, logs...)
+	}
+	return result, nil
+}
+
+func (c *Client) BlockNumber() (int64, error) {
+	var res string
+	if err := c.RpcCall(&res, "eth_blockNumber", []interface{}{}); err != nil {
+		return 0, err
+	}
+	number, err := strconv.ParseInt(res, 0, 64)
+	if err != nil {
+		return 0, errors.E(err, "invalid block number", errors.Params{"result": res})
+	}
+	return number, nil
+}
+
+func toHex(number int64) string {
+	return "0x" + strconv.FormatInt(number, 16)
+}
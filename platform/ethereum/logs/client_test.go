@@ -0,0 +1,65 @@
+package logs
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_GetLogs(t *testing.T) {
+	latest := "0x1d"
+	ranges := make([]string, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Method string `json:"method"`
+			Params []struct {
+				FromBlock string `json:"fromBlock"`
+				ToBlock   string `json:"toBlock"`
+			} `json:"params"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		if req.Method == "eth_blockNumber" {
+			_, _ = fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":%q}`, latest)
+			return
+		}
+		filter := req.Params[0]
+		ranges = append(ranges, filter.FromBlock+"-"+filter.ToBlock)
+		_, _ = fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":[{"blockNumber":%q,"transactionHash":"0x%s"}]}`, filter.ToBlock, filter.FromBlock)
+	}))
+	defer server.Close()
+
+	c := InitClient(server.URL)
+	c.BlockRange = 10
+	topics := []interface{}{"0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925"}
+
+	logs, err := c.GetLogs(topics)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"0x0-0x9", "0xa-0x13", "0x14-0x1d"}, ranges)
+	assert.Len(t, logs, 3)
+
+	ranges = ranges[:0]
+	logs, err = c.GetLogs(topics)
+	assert.Nil(t, err)
+	assert.Empty(t, ranges, "the scanned blocks are cached")
+	assert.Len(t, logs, 3)
+
+	latest = "0x20"
+	logs, err = c.GetLogs(topics)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"0x1e-0x20"}, ranges)
+	assert.Len(t, logs, 4)
+	assert.Equal(t, "0x20", logs[3].BlockNumber)
+
+	ranges = ranges[:0]
+	c = InitClient(server.URL)
+	c.BlockRange = 10
+	c.StartBlock = 0x15
+	logs, err = c.GetLogs(topics)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"0x15-0x1e", "0x1f-0x20"}, ranges, "the scan begins at the start block")
+	assert.Len(t, logs, 2)
+}
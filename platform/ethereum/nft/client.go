@@ -0,0 +1,179 @@
+package nft
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+	"github.com/trustwallet/blockatlas/pkg/address"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/errors"
+	"github.com/trustwallet/blockatlas/platform/ethereum/abi"
+	"github.com/trustwallet/blockatlas/platform/ethereum/logs"
+)
+
+const (
+	ownedCacheTTL        = time.Minute * 5
+	metadataCacheTTL     = time.Hour * 24
+	metadataMissCacheTTL = time.Minute * 10
+
+	tokenURISelector = "c87b56dd"
+	uriSelector      = "0e89341c"
+	nameSelector     = "06fdde03"
+	symbolSelector   = "95d89b41"
+)
+
+// Codec converts the addresses of a chain from and to the 0x hex form used by the RPC
+type Codec interface {
+	ToHex(address string) (string, error)
+	FromHex(hex string) string
+}
+
+// EIP55 is the codec of the ethereum-family chains
+type EIP55 struct {
+	CoinIndex uint
+}
+
+func (e EIP55) ToHex(addr string) (string, error) {
+	a := strings.ToLower(address.Remove0x(addr))
+	if len(a) != 40 {
+		return "", errors.E("invalid address", errors.Params{"address": addr})
+	}
+	return "0x" + a, nil
+}
+
+func (e EIP55) FromHex(hex string) string {
+	return address.ToEIP55ByCoinID(hex, e.CoinIndex)
+}
+
+// Client reads the collectibles from the transfer logs and the metadata from tokenURI/uri
+type Client struct {
+	blockatlas.Request
+	CoinIndex uint
+	Gateway   string
+	Codec     Codec
+	Logs      logs.Client
+	owned     *cache.Cache
+	described *cache.Cache
+}
+
+func InitClient(coinIndex uint, rpc, gateway string, codec Codec) Client {
+	return Client{
+		Request:   blockatlas.InitJSONClient(rpc),
+		CoinIndex: coinIndex,
+		Gateway:   normalizeGateway(gateway),
+		Codec:     codec,
+		Logs:      logs.InitClient(rpc),
+		owned:     cache.New(ownedCacheTTL, ownedCacheTTL),
+		described: cache.New(metadataCacheTTL, time.Hour),
+	}
+}
+
+// Tokens returns the ERC-721 and ERC-1155 tokens currently held by the owner, the set is cached for a few minutes
+func (c *Client) Tokens(owner string) ([]Token, error) {
+	hexOwner, err := c.Codec.ToHex(owner)
+	if err != nil {
+		return nil, err
+	}
+	if cached, ok := c.owned.Get(hexOwner); ok {
+		return cached.([]Token), nil
+	}
+	latest, err := c.Logs.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+	ownerTopic := "0x" + pad(hexOwner)
+	queries := [][]interface{}{
+		{TransferTopic, ownerTopic},
+		{TransferTopic, nil, ownerTopic},
+		{[]string{TransferSingleTopic, TransferBatchTopic}, nil, ownerTopic},
+		{[]string{TransferSingleTopic, TransferBatchTopic}, nil, nil, ownerTopic},
+	}
+	transferLogs := make([]Log, 0)
+	for _, topics := range queries {
+		result, err := c.Logs.GetLogsUntil(topics, latest)
+		if err != nil {
+			return nil, err
+		}
+		transferLogs = append(transferLogs, result...)
+	}
+	tokens := Owned(hexOwner, transferLogs)
+	c.owned.Set(hexOwner, tokens, cache.DefaultExpiration)
+	return tokens, nil
+}
+
+// Metadata returns the metadata JSON the token URI points to, cached per contract and token id
+// with the failures for a shorter time
+func (c *Client) Metadata(token Token) (Metadata, error) {
+	key := strings.Join([]string{token.Type, strings.ToLower(token.Contract), token.TokenID}, ":")
+	if cached, ok := c.described.Get(key); ok {
+		if err, failed := cached.(error); failed {
+			return Metadata{}, err
+		}
+		return cached.(Metadata), nil
+	}
+	m, err := c.readMetadata(token)
+	if err != nil {
+		c.described.Set(key, err, metadataMissCacheTTL)
+		return Metadata{}, err
+	}
+	c.described.Set(key, m, cache.DefaultExpiration)
+	return m, nil
+}
+
+func (c *Client) readMetadata(token Token) (Metadata, error) {
+	selector := tokenURISelector
+	if token.Type == TypeERC1155 {
+		selector = uriSelector
+	}
+	id, ok := new(big.Int).SetString(token.TokenID, 10)
+	if !ok {
+		return Metadata{}, errors.E("invalid token id", errors.Params{"token_id": token.TokenID})
+	}
+	result, err := c.ethCall(token.Contract, "0x"+selector+fmt.Sprintf("%064x", id))
+	if err != nil {
+		return Metadata{}, err
+	}
+	uri := abi.DecodeString(result)
+	if uri == "" {
+		return Metadata{}, errors.E("empty token uri", errors.Params{"contract": token.Contract, "token_id": token.TokenID})
+	}
+	if strings.HasPrefix(uri, "data:") {
+		return decodeDataURI(uri)
+	}
+	return c.fetchMetadata(ResolveURI(uri, c.Gateway, token.TokenID))
+}
+
+// ContractInfo returns the name and the symbol of the contract, empty if not implemented
+func (c *Client) ContractInfo(contract string) (name string, symbol string) {
+	if result, err := c.ethCall(contract, "0x"+nameSelector); err == nil {
+		name = abi.DecodeString(result)
+	}
+	if result, err := c.ethCall(contract, "0x"+symbolSelector); err == nil {
+		symbol = abi.DecodeString(result)
+	}
+	return
+}
+
+func (c *Client) ethCall(to, data string) (string, error) {
+	var res string
+	params := []interface{}{
+		map[string]interface{}{
+			"to":   to,
+			"data": data,
+		},
+		"latest",
+	}
+	err := c.RpcCall(&res, "eth_call", params)
+	if err != nil {
+		return "", err
+	}
+	return res, nil
+}
+
+func pad(hexAddress string) string {
+	a := address.Remove0x(hexAddress)
+	return strings.Repeat("0", wordLength-len(a)) + a
+}
@@ -0,0 +1,160 @@
+package nft
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/coin"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+)
+
+func abiString(s string) string {
+	data := hex.EncodeToString([]byte(s))
+	if rest := len(data) % wordLength; rest != 0 {
+		data += strings.Repeat("0", wordLength-rest)
+	}
+	return "0x" + uint256(32) + uint256(int64(len(s))) + data
+}
+
+func TestClient_GetCollectibles(t *testing.T) {
+	getLogs, tokenURICalls := 0, 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/ipfs/kitty/2" {
+			_, _ = w.Write([]byte(`{"name":"Kitty #2","description":"A kitty","image":"ipfs://QmKitty","external_url":"https://www.cryptokitties.co/kitty/2"}`))
+			return
+		}
+		var req struct {
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		var result interface{} = []Log{}
+		switch req.Method {
+		case "eth_blockNumber":
+			getLogs = 0
+			result = "0x10"
+		case "eth_getLogs":
+			getLogs++
+			var filter struct {
+				Topics []interface{} `json:"topics"`
+			}
+			_ = json.Unmarshal(req.Params[0], &filter)
+			if len(filter.Topics) == 3 && filter.Topics[0] == TransferTopic {
+				result = []Log{{Address: kitties, Topics: []string{TransferTopic, topic(other), topic(owner), "0x" + uint256(2)}, BlockNumber: "0x1", LogIndex: "0x0", TransactionHash: "0xa"}}
+			}
+		case "eth_call":
+			var call struct {
+				Data string `json:"data"`
+			}
+			_ = json.Unmarshal(req.Params[0], &call)
+			switch call.Data[2:10] {
+			case tokenURISelector:
+				tokenURICalls++
+				result = abiString("ipfs://kitty/2")
+			case nameSelector:
+				result = abiString("CryptoKitties")
+			case symbolSelector:
+				result = abiString("CK")
+			}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": 1, "result": result})
+	}))
+	defer server.Close()
+	c := InitClient(coin.ETH, server.URL, server.URL+"/ipfs/", EIP55{CoinIndex: coin.ETH})
+	image := server.URL + "/ipfs/QmKitty"
+
+	collections, err := c.GetCollections(owner)
+	assert.Nil(t, err)
+	assert.Equal(t, blockatlas.CollectionPage{{
+		Id:           "0x06012c8cf97BEaD5deAe237070F9587f8E7A266d",
+		Name:         "CryptoKitties",
+		ImageUrl:     image,
+		Description:  "A kitty",
+		ExternalLink: "https://www.cryptokitties.co/kitty/2",
+		Total:        1,
+		Address:      owner,
+		Coin:         coin.ETH,
+		Type:         TypeERC721,
+	}}, collections)
+
+	assert.Equal(t, 4, getLogs, "the queries of the owner share the latest block")
+
+	getLogs = 0
+	collectibles, err := c.GetCollectibles(owner, "0x06012c8cf97BEaD5deAe237070F9587f8E7A266d")
+	assert.Equal(t, 0, getLogs, "the owned tokens are cached")
+	assert.Nil(t, err)
+	assert.Equal(t, blockatlas.CollectiblePage{{
+		ID:              "0x06012c8cf97BEaD5deAe237070F9587f8E7A266d-2",
+		CollectionID:    "0x06012c8cf97BEaD5deAe237070F9587f8E7A266d",
+		TokenID:         "2",
+		ContractAddress: "0x06012c8cf97BEaD5deAe237070F9587f8E7A266d",
+		Category:        "CryptoKitties",
+		ImageUrl:        image,
+		ExternalLink:    "https://www.cryptokitties.co/kitty/2",
+		Type:            TypeERC721,
+		Description:     "A kitty",
+		Coin:            coin.ETH,
+		Name:            "Kitty #2",
+	}}, collectibles)
+
+	collectibles, err = c.GetCollectibles(owner, other)
+	assert.Nil(t, err)
+	assert.Empty(t, collectibles)
+
+	txs := []blockatlas.Tx{
+		{Meta: blockatlas.CollectibleTransfer{Contract: kitties, TokenID: "2", Value: "1"}},
+		{Meta: blockatlas.Transfer{Value: "1"}},
+	}
+	c.FillTransfers(txs)
+	assert.Equal(t, 1, tokenURICalls, "the metadata is cached per contract and token id")
+	assert.Equal(t, blockatlas.CollectibleTransfer{Name: "Kitty #2", Contract: kitties, ImageURL: image, TokenID: "2", Value: "1"}, txs[0].Meta)
+	assert.Equal(t, blockatlas.Transfer{Value: "1"}, txs[1].Meta)
+}
+
+func TestClient_MetadataURI(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/ipfs/large" {
+			_, _ = w.Write([]byte(`{"name":"` + strings.Repeat("a", maxMetadataSize) + `"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"name":"Kitty"}`))
+	}))
+	defer server.Close()
+	c := InitClient(coin.ETH, server.URL, server.URL+"/ipfs/", EIP55{CoinIndex: coin.ETH})
+
+	m, err := c.fetchMetadata(ResolveURI("ipfs://kitty", c.Gateway, "1"))
+	assert.Nil(t, err)
+	assert.Equal(t, "Kitty", m.Name)
+
+	_, err = c.fetchMetadata(ResolveURI("ipfs://large", c.Gateway, "1"))
+	assert.NotNil(t, err, "the size is capped")
+
+	for _, uri := range []string{
+		server.URL + "/kitty",
+		"http://metadata.test/kitty",
+		"file:///etc/passwd",
+		"https://127.0.0.1/kitty",
+		"https://169.254.169.254/latest/meta-data",
+		"https://10.0.0.1/kitty",
+		"https://[::1]/kitty",
+	} {
+		_, err := c.fetchMetadata(uri)
+		assert.NotNil(t, err, uri)
+	}
+
+	c.Gateway = ""
+	_, err = c.fetchMetadata(server.URL + "/ipfs/puppy")
+	assert.NotNil(t, err, "an empty gateway isn't trusted")
+}
+
+func TestDialPublic(t *testing.T) {
+	assert.NotNil(t, dialPublic("tcp", "127.0.0.1:443", nil))
+	assert.NotNil(t, dialPublic("tcp", "192.168.1.1:443", nil))
+	assert.NotNil(t, dialPublic("tcp", "[fe80::1]:443", nil))
+	assert.Nil(t, dialPublic("tcp", "104.16.0.1:443", nil))
+}
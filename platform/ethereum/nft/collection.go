@@ -0,0 +1,211 @@
+package nft
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+)
+
+const maxMetadataRequests = 8
+
+type (
+	collection struct {
+		contract string
+		kind     string
+		tokens   []Token
+	}
+
+	collectionInfo struct {
+		name         string
+		symbol       string
+		imageUrl     string
+		description  string
+		externalLink string
+	}
+)
+
+func (c *Client) GetCollections(owner string) (blockatlas.CollectionPage, error) {
+	collections, err := c.collections(owner)
+	if err != nil {
+		return nil, err
+	}
+	page := make(blockatlas.CollectionPage, 0, len(collections))
+	for _, col := range collections {
+		info := c.collectionInfo(col)
+		page = append(page, blockatlas.Collection{
+			Id:           c.Codec.FromHex(col.contract),
+			Name:         info.name,
+			ImageUrl:     info.imageUrl,
+			Description:  info.description,
+			ExternalLink: info.externalLink,
+			Total:        len(col.tokens),
+			Address:      owner,
+			Coin:         c.CoinIndex,
+			Type:         col.kind,
+		})
+	}
+	return page, nil
+}
+
+func (c *Client) GetCollectionsV3(owner string) (blockatlas.CollectionPageV3, error) {
+	collections, err := c.collections(owner)
+	if err != nil {
+		return nil, err
+	}
+	page := make(blockatlas.CollectionPageV3, 0, len(collections))
+	for _, col := range collections {
+		info := c.collectionInfo(col)
+		id := c.Codec.FromHex(col.contract)
+		page = append(page, blockatlas.CollectionV3{
+			Id:              id,
+			Name:            info.name,
+			Symbol:          info.symbol,
+			Slug:            id,
+			ImageUrl:        info.imageUrl,
+			Description:     info.description,
+			ExternalLink:    info.externalLink,
+			Total:           len(col.tokens),
+			CategoryAddress: id,
+			Address:         owner,
+			Coin:            c.CoinIndex,
+			Type:            col.kind,
+		})
+	}
+	return page, nil
+}
+
+func (c *Client) GetCollectibles(owner, collectionID string) (blockatlas.CollectiblePage, error) {
+	col, ok, err := c.collection(owner, collectionID)
+	if err != nil || !ok {
+		return blockatlas.CollectiblePage{}, err
+	}
+	name, _ := c.ContractInfo(col.contract)
+	contract := c.Codec.FromHex(col.contract)
+	metadata := c.metadata(col.tokens)
+	page := make(blockatlas.CollectiblePage, 0, len(col.tokens))
+	for i, token := range col.tokens {
+		m := metadata[i]
+		page = append(page, blockatlas.Collectible{
+			ID:              strings.Join([]string{contract, token.TokenID}, "-"),
+			CollectionID:    contract,
+			TokenID:         token.TokenID,
+			ContractAddress: contract,
+			Category:        blockatlas.GetValidParameter(name, contract),
+			ImageUrl:        ResolveURI(m.GetImage(), c.Gateway, token.TokenID),
+			ExternalLink:    m.ExternalURL,
+			Type:            token.Type,
+			Description:     m.Description,
+			Coin:            c.CoinIndex,
+			Name:            blockatlas.GetValidParameter(m.Name, "#"+token.TokenID),
+		})
+	}
+	return page, nil
+}
+
+func (c *Client) GetCollectiblesV3(owner, collectionID string) (blockatlas.CollectiblePageV3, error) {
+	col, ok, err := c.collection(owner, collectionID)
+	if err != nil || !ok {
+		return blockatlas.CollectiblePageV3{}, err
+	}
+	name, _ := c.ContractInfo(col.contract)
+	contract := c.Codec.FromHex(col.contract)
+	metadata := c.metadata(col.tokens)
+	page := make(blockatlas.CollectiblePageV3, 0, len(col.tokens))
+	for i, token := range col.tokens {
+		m := metadata[i]
+		page = append(page, blockatlas.CollectibleV3{
+			ID:               strings.Join([]string{contract, token.TokenID}, "-"),
+			CollectionID:     contract,
+			TokenID:          token.TokenID,
+			CategoryContract: contract,
+			ContractAddress:  contract,
+			Category:         blockatlas.GetValidParameter(name, contract),
+			ImageUrl:         ResolveURI(m.GetImage(), c.Gateway, token.TokenID),
+			ExternalLink:     m.ExternalURL,
+			Type:             token.Type,
+			Description:      m.Description,
+			Coin:             c.CoinIndex,
+			Name:             blockatlas.GetValidParameter(m.Name, "#"+token.TokenID),
+		})
+	}
+	return page, nil
+}
+
+// collections groups the tokens of the owner by contract
+func (c *Client) collections(owner string) ([]collection, error) {
+	tokens, err := c.Tokens(owner)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]collection, 0)
+	for _, token := range tokens {
+		if n := len(result); n > 0 && result[n-1].contract == token.Contract {
+			result[n-1].tokens = append(result[n-1].tokens, token)
+			continue
+		}
+		result = append(result, collection{contract: token.Contract, kind: token.Type, tokens: []Token{token}})
+	}
+	return result, nil
+}
+
+func (c *Client) collection(owner, collectionID string) (collection, bool, error) {
+	contract, err := c.Codec.ToHex(collectionID)
+	if err != nil {
+		return collection{}, false, err
+	}
+	collections, err := c.collections(owner)
+	if err != nil {
+		return collection{}, false, err
+	}
+	for _, col := range collections {
+		if col.contract == contract {
+			return col, true, nil
+		}
+	}
+	return collection{}, false, nil
+}
+
+// collectionInfo describes the collection with the contract name and the metadata of its first token
+func (c *Client) collectionInfo(col collection) collectionInfo {
+	name, symbol := c.ContractInfo(col.contract)
+	info := collectionInfo{
+		name:   blockatlas.GetValidParameter(name, blockatlas.GetValidParameter(symbol, c.Codec.FromHex(col.contract))),
+		symbol: symbol,
+	}
+	if len(col.tokens) == 0 {
+		return info
+	}
+	m, err := c.Metadata(col.tokens[0])
+	if err != nil {
+		return info
+	}
+	info.imageUrl = ResolveURI(m.GetImage(), c.Gateway, col.tokens[0].TokenID)
+	info.description = m.Description
+	info.externalLink = m.ExternalURL
+	return info
+}
+
+// metadata fetches the metadata of the tokens concurrently, a token without metadata gets an empty one
+func (c *Client) metadata(tokens []Token) []Metadata {
+	result := make([]Metadata, len(tokens))
+	sem := make(chan struct{}, maxMetadataRequests)
+	var wg sync.WaitGroup
+	for i, token := range tokens {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, token Token) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			m, err := c.Metadata(token)
+			if err != nil {
+				return
+			}
+			result[i] = m
+		}(i, token)
+	}
+	wg.Wait()
+	return result
+}
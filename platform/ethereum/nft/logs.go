@@ -0,0 +1,235 @@
+package nft
+
+import (
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/trustwallet/blockatlas/pkg/address"
+	"github.com/trustwallet/blockatlas/platform/ethereum/logs"
+)
+
+const (
+	// TransferTopic is the topic of Transfer(address,address,uint256), ERC-721 indexes the token id
+	TransferTopic = "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
+	// TransferSingleTopic is the topic of the ERC-1155 TransferSingle(address,address,address,uint256,uint256)
+	TransferSingleTopic = "0xc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f62"
+	// TransferBatchTopic is the topic of the ERC-1155 TransferBatch(address,address,address,uint256[],uint256[])
+	TransferBatchTopic = "0x4a39dc06d4c0dbc64b70af90fd698a233a518aa5d07e595d983b8c0526c8f7fb"
+
+	TypeERC721  = "ERC721"
+	TypeERC1155 = "ERC1155"
+
+	wordLength = 64
+)
+
+type (
+	Log = logs.Log
+
+	// Token is a collectible held by an owner, contract is the lowercase hex address
+	Token struct {
+		Contract string
+		TokenID  string
+		Type     string
+		Amount   *big.Int
+	}
+
+	transfer struct {
+		contract string
+		tokenID  string
+		kind     string
+		from     string
+		to       string
+		value    *big.Int
+	}
+)
+
+// Owned replays the transfer logs of the owner and returns the collectibles it still holds
+func Owned(owner string, logs []Log) []Token {
+	owner = strings.ToLower(owner)
+	logs = sortLogs(uniqueLogs(logs))
+
+	type key struct{ contract, tokenID string }
+	balances := make(map[key]*Token)
+	for _, log := range logs {
+		for _, t := range decodeTransfers(log) {
+			k := key{contract: t.contract, tokenID: t.tokenID}
+			token, ok := balances[k]
+			if !ok {
+				token = &Token{Contract: t.contract, TokenID: t.tokenID, Type: t.kind, Amount: new(big.Int)}
+				balances[k] = token
+			}
+			if t.to == owner {
+				token.Amount.Add(token.Amount, t.value)
+			}
+			if t.from == owner {
+				token.Amount.Sub(token.Amount, t.value)
+			}
+		}
+	}
+
+	result := make([]Token, 0)
+	for _, token := range balances {
+		if token.Amount.Sign() > 0 {
+			result = append(result, *token)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Contract != result[j].Contract {
+			return result[i].Contract < result[j].Contract
+		}
+		return compareIDs(result[i].TokenID, result[j].TokenID)
+	})
+	return result
+}
+
+func decodeTransfers(log Log) []transfer {
+	contract := strings.ToLower(log.Address)
+	if len(log.Topics) == 0 {
+		return nil
+	}
+	switch strings.ToLower(log.Topics[0]) {
+	case TransferTopic:
+		// ERC-20 Transfer events have the same topic with an unindexed value
+		if len(log.Topics) != 4 {
+			return nil
+		}
+		return []transfer{{
+			contract: contract,
+			tokenID:  decodeUint(log.Topics[3]).String(),
+			kind:     TypeERC721,
+			from:     decodeAddress(log.Topics[1]),
+			to:       decodeAddress(log.Topics[2]),
+			value:    big.NewInt(1),
+		}}
+	case TransferSingleTopic:
+		data := address.Remove0x(log.Data)
+		if len(log.Topics) != 4 || len(data) < 2*wordLength {
+			return nil
+		}
+		return []transfer{{
+			contract: contract,
+			tokenID:  decodeUint(data[:wordLength]).String(),
+			kind:     TypeERC1155,
+			from:     decodeAddress(log.Topics[2]),
+			to:       decodeAddress(log.Topics[3]),
+			value:    decodeUint(data[wordLength : 2*wordLength]),
+		}}
+	case TransferBatchTopic:
+		if len(log.Topics) != 4 {
+			return nil
+		}
+		data := address.Remove0x(log.Data)
+		ids, ok := decodeUintArray(data, 0)
+		if !ok {
+			return nil
+		}
+		values, ok := decodeUintArray(data, 1)
+		if !ok || len(ids) != len(values) {
+			return nil
+		}
+		transfers := make([]transfer, 0, len(ids))
+		for i := range ids {
+			transfers = append(transfers, transfer{
+				contract: contract,
+				tokenID:  ids[i].String(),
+				kind:     TypeERC1155,
+				from:     decodeAddress(log.Topics[2]),
+				to:       decodeAddress(log.Topics[3]),
+				value:    values[i],
+			})
+		}
+		return transfers
+	}
+	return nil
+}
+
+// uniqueLogs drops the duplicates of the incoming and outgoing queries, e.g. self transfers
+func uniqueLogs(logs []Log) []Log {
+	seen := make(map[string]bool)
+	result := make([]Log, 0, len(logs))
+	for _, log := range logs {
+		key := log.TransactionHash + ":" + log.LogIndex
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		result = append(result, log)
+	}
+	return result
+}
+
+func sortLogs(logs []Log) []Log {
+	sort.SliceStable(logs, func(i, j int) bool {
+		bi, bj := parseHex(logs[i].BlockNumber), parseHex(logs[j].BlockNumber)
+		if bi != bj {
+			return bi < bj
+		}
+		return parseHex(logs[i].LogIndex) < parseHex(logs[j].LogIndex)
+	})
+	return logs
+}
+
+func parseHex(s string) uint64 {
+	n, _ := strconv.ParseUint(address.Remove0x(s), 16, 64)
+	return n
+}
+
+func compareIDs(a, b string) bool {
+	x, okX := new(big.Int).SetString(a, 10)
+	y, okY := new(big.Int).SetString(b, 10)
+	if !okX || !okY {
+		return a < b
+	}
+	return x.Cmp(y) < 0
+}
+
+func decodeAddress(word string) string {
+	w := address.Remove0x(word)
+	if len(w) < 40 {
+		return ""
+	}
+	return "0x" + strings.ToLower(w[len(w)-40:])
+}
+
+func decodeUint(word string) *big.Int {
+	value, ok := new(big.Int).SetString(address.Remove0x(word), 16)
+	if !ok {
+		return new(big.Int)
+	}
+	return value
+}
+
+// decodeUintArray decodes the dynamic uint256[] argument at the position of the data
+func decodeUintArray(data string, position int) ([]*big.Int, bool) {
+	head := word(data, position)
+	if head == "" {
+		return nil, false
+	}
+	offset := decodeUint(head)
+	if !offset.IsInt64() || offset.Int64()%32 != 0 {
+		return nil, false
+	}
+	start := int(offset.Int64() / 32)
+	length := decodeUint(word(data, start))
+	if !length.IsInt64() || int(length.Int64()) > len(data)/wordLength {
+		return nil, false
+	}
+	result := make([]*big.Int, 0, length.Int64())
+	for i := 0; i < int(length.Int64()); i++ {
+		w := word(data, start+1+i)
+		if w == "" {
+			return nil, false
+		}
+		result = append(result, decodeUint(w))
+	}
+	return result, true
+}
+
+func word(data string, index int) string {
+	if index < 0 || len(data) < (index+1)*wordLength {
+		return ""
+	}
+	return data[index*wordLength : (index+1)*wordLength]
+}
@@ -0,0 +1,99 @@
+package nft
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	owner    = "0x7d8bf18c7ce84b3e175b339c4ca93aed1dd166f1"
+	other    = "0x0875bcab22de3d02402bc38aee4104e1239374a7"
+	kitties  = "0x06012c8cf97bead5deae237070f9587f8e7a266d"
+	multiple = "0xd07dc4262bcdbf85190c01c996b4c06a461d2430"
+)
+
+func topic(address string) string {
+	return "0x" + strings.Repeat("0", 24) + strings.TrimPrefix(address, "0x")
+}
+
+func uint256(n int64) string {
+	return pad(big.NewInt(n).Text(16))
+}
+
+func TestOwned(t *testing.T) {
+	logs := []Log{
+		// kitty 1 received, then sent back
+		{Address: kitties, Topics: []string{TransferTopic, topic(other), topic(owner), "0x" + uint256(1)}, BlockNumber: "0x1", LogIndex: "0x0", TransactionHash: "0xa"},
+		{Address: kitties, Topics: []string{TransferTopic, topic(owner), topic(other), "0x" + uint256(1)}, BlockNumber: "0x3", LogIndex: "0x0", TransactionHash: "0xc"},
+		// kitty 2 received, returned twice by the incoming and the outgoing queries for a self transfer
+		{Address: kitties, Topics: []string{TransferTopic, topic(other), topic(owner), "0x" + uint256(2)}, BlockNumber: "0x2", LogIndex: "0x0", TransactionHash: "0xb"},
+		{Address: kitties, Topics: []string{TransferTopic, topic(owner), topic(owner), "0x" + uint256(2)}, BlockNumber: "0x4", LogIndex: "0x1", TransactionHash: "0xd"},
+		{Address: kitties, Topics: []string{TransferTopic, topic(owner), topic(owner), "0x" + uint256(2)}, BlockNumber: "0x4", LogIndex: "0x1", TransactionHash: "0xd"},
+		// ERC-20 transfer
+		{Address: kitties, Topics: []string{TransferTopic, topic(other), topic(owner)}, Data: "0x" + uint256(100), BlockNumber: "0x4", LogIndex: "0x2", TransactionHash: "0xd"},
+		// ERC-1155 batch of 5 x id 7 and 3 x id 8, then a single transfer of 2 x id 7
+		{Address: multiple, Topics: []string{TransferBatchTopic, topic(other), topic(other), topic(owner)},
+			Data:        "0x" + uint256(64) + uint256(160) + uint256(2) + uint256(7) + uint256(8) + uint256(2) + uint256(5) + uint256(3),
+			BlockNumber: "0x5", LogIndex: "0x0", TransactionHash: "0xe"},
+		{Address: multiple, Topics: []string{TransferSingleTopic, topic(owner), topic(owner), topic(other)},
+			Data:        "0x" + uint256(7) + uint256(2),
+			BlockNumber: "0x6", LogIndex: "0x0", TransactionHash: "0xf"},
+	}
+	assert.Equal(t, []Token{
+		{Contract: kitties, TokenID: "2", Type: TypeERC721, Amount: big.NewInt(1)},
+		{Contract: multiple, TokenID: "7", Type: TypeERC1155, Amount: big.NewInt(3)},
+		{Contract: multiple, TokenID: "8", Type: TypeERC1155, Amount: big.NewInt(3)},
+	}, Owned(owner, logs))
+}
+
+func TestResolveURI(t *testing.T) {
+	tests := []struct {
+		uri  string
+		want string
+	}{
+		{"ipfs://QmTy8w65yBXgyfG2ZBg5TrfB2hPjrDQH3RCQFJGkARStJb/1", "https://gateway.test/ipfs/QmTy8w65yBXgyfG2ZBg5TrfB2hPjrDQH3RCQFJGkARStJb/1"},
+		{"ipfs://ipfs/QmTy8w65yBXgyfG2ZBg5TrfB2hPjrDQH3RCQFJGkARStJb", "https://gateway.test/ipfs/QmTy8w65yBXgyfG2ZBg5TrfB2hPjrDQH3RCQFJGkARStJb"},
+		{"ar://bWR4o4PvDFY2lsZb4Y0OjKr5oMrT9hUjJbT7tZb1vlY", "https://arweave.net/bWR4o4PvDFY2lsZb4Y0OjKr5oMrT9hUjJbT7tZb1vlY"},
+		{"https://api.example.com/token/{id}.json", "https://api.example.com/token/000000000000000000000000000000000000000000000000000000000000000a.json"},
+		{"https://api.cryptokitties.co/kitties/1", "https://api.cryptokitties.co/kitties/1"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, ResolveURI(tt.uri, "https://gateway.test/ipfs", "10"))
+	}
+}
+
+func TestDecodeDataURI(t *testing.T) {
+	m, err := decodeDataURI("data:application/json;base64,eyJuYW1lIjoiTG9vdCAjMSIsImltYWdlIjoiaXBmczovL1FtYSJ9")
+	assert.Nil(t, err)
+	assert.Equal(t, Metadata{Name: "Loot #1", Image: "ipfs://Qma"}, m)
+
+	m, err = decodeDataURI(`data:application/json;utf8,{"name":"Punk %231"}`)
+	assert.Nil(t, err)
+	assert.Equal(t, "Punk #1", m.Name)
+}
+
+func TestDecodeTransfer(t *testing.T) {
+	input := "0x42842e0e" + pad(owner) + pad(other) + uint256(2)
+	transfer, ok := DecodeTransfer(60, kitties, input)
+	assert.True(t, ok)
+	assert.Equal(t, "0x06012c8cf97BEaD5deAe237070F9587f8E7A266d", transfer.Contract)
+	assert.Equal(t, "2", transfer.TokenID)
+	assert.Equal(t, "1", string(transfer.Value))
+	assert.Equal(t, "0x7d8bf18C7cE84b3E175b339c4Ca93aEd1dD166F1", transfer.From)
+	assert.Equal(t, "0x0875BCab22dE3d02402bc38aEe4104e1239374a7", transfer.To)
+
+	input = "0xf242432a" + pad(owner) + pad(other) + uint256(7) + uint256(5) + uint256(160) + uint256(0)
+	transfer, ok = DecodeTransfer(60, multiple, input)
+	assert.True(t, ok)
+	assert.Equal(t, "7", transfer.TokenID)
+	assert.Equal(t, "5", string(transfer.Value))
+
+	// transferFrom is shared with ERC-20
+	_, ok = DecodeTransfer(60, kitties, "0x23b872dd"+pad(owner)+pad(other)+uint256(2))
+	assert.False(t, ok)
+	_, ok = DecodeTransfer(60, kitties, "0x42842e0e"+pad(owner))
+	assert.False(t, ok)
+}
@@ -0,0 +1,214 @@
+package nft
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/errors"
+)
+
+const (
+	DefaultGateway = "https://ipfs.io/ipfs/"
+	arweaveGateway = "https://arweave.net/"
+
+	// maxMetadataSize caps the metadata documents, their URIs are set by the token contracts
+	maxMetadataSize = 1 << 20
+)
+
+var (
+	// publicClient requests the https URIs of the contracts, it refuses the private and link-local addresses
+	// including after a redirect or a DNS change
+	publicClient = &http.Client{
+		Timeout: time.Second * 15,
+		Transport: &http.Transport{
+			DialContext:         (&net.Dialer{Timeout: time.Second * 10, Control: dialPublic}).DialContext,
+			TLSHandshakeTimeout: time.Second * 10,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= 5 {
+				return errors.E("too many redirects")
+			}
+			if req.URL.Scheme != "https" {
+				return errors.E("redirect to a non-https uri", errors.Params{"uri": req.URL.String()})
+			}
+			return nil
+		},
+	}
+
+	privateNetworks = parseNetworks(
+		"0.0.0.0/8", "10.0.0.0/8", "100.64.0.0/10", "127.0.0.0/8", "169.254.0.0/16", "172.16.0.0/12",
+		"192.0.0.0/24", "192.168.0.0/16", "198.18.0.0/15", "::/128", "::1/128", "fc00::/7", "fe80::/10",
+	)
+)
+
+// Metadata is the ERC-721 and ERC-1155 metadata JSON of a token
+type Metadata struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Image       string `json:"image"`
+	ImageURL    string `json:"image_url"`
+	ExternalURL string `json:"external_url"`
+}
+
+// GetImage returns the image, some collections use image_url instead of the standard field
+func (m Metadata) GetImage() string {
+	if m.Image != "" {
+		return m.Image
+	}
+	return m.ImageURL
+}
+
+// ResolveURI rewrites ipfs:// and ar:// URIs to the gateways and fills the ERC-1155 {id} placeholder
+func ResolveURI(uri, gateway, tokenID string) string {
+	uri = strings.TrimSpace(uri)
+	if strings.Contains(uri, "{id}") {
+		uri = strings.Replace(uri, "{id}", idHex(tokenID), -1)
+	}
+	switch {
+	case strings.HasPrefix(uri, "ipfs://"):
+		path := strings.TrimPrefix(uri, "ipfs://")
+		path = strings.TrimPrefix(path, "ipfs/")
+		return normalizeGateway(gateway) + path
+	case strings.HasPrefix(uri, "ar://"):
+		return arweaveGateway + strings.TrimPrefix(uri, "ar://")
+	}
+	return uri
+}
+
+// fetchMetadata requests the metadata of the configured gateways, or of an https uri of a public host
+func (c *Client) fetchMetadata(uri string) (Metadata, error) {
+	client, err := c.metadataClient(uri)
+	if err != nil {
+		return Metadata{}, err
+	}
+	res, err := client.Get(uri)
+	if err != nil {
+		return Metadata{}, errors.E(err, "get metadata failed", errors.Params{"uri": uri})
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return Metadata{}, errors.E("get metadata failed", errors.Params{"uri": uri, "status": res.StatusCode})
+	}
+	raw, err := ioutil.ReadAll(io.LimitReader(res.Body, maxMetadataSize+1))
+	if err != nil {
+		return Metadata{}, errors.E(err, "read metadata failed", errors.Params{"uri": uri})
+	}
+	if len(raw) > maxMetadataSize {
+		return Metadata{}, errors.E("metadata too large", errors.Params{"uri": uri})
+	}
+	var m Metadata
+	if err := json.Unmarshal(raw, &m); err != nil {
+		return Metadata{}, errors.E(err, "invalid metadata", errors.Params{"uri": uri})
+	}
+	return m, nil
+}
+
+// metadataClient accepts the ipfs:// and ar:// URIs resolved to the gateways and the https URIs,
+// an empty gateway is never trusted
+func (c *Client) metadataClient(uri string) (*http.Client, error) {
+	if (c.Gateway != "" && strings.HasPrefix(uri, c.Gateway)) || strings.HasPrefix(uri, arweaveGateway) {
+		return blockatlas.DefaultClient, nil
+	}
+	u, err := url.Parse(uri)
+	if err != nil || u.Scheme != "https" || u.Hostname() == "" {
+		return nil, errors.E("unsupported token uri", errors.Params{"uri": uri})
+	}
+	if ip := net.ParseIP(u.Hostname()); ip != nil && isPrivate(ip) {
+		return nil, errors.E("private token uri", errors.Params{"uri": uri})
+	}
+	return publicClient, nil
+}
+
+// dialPublic refuses the connections to the private addresses the host names resolve to
+func dialPublic(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if ip := net.ParseIP(host); ip == nil || isPrivate(ip) {
+		return errors.E("private address", errors.Params{"address": address})
+	}
+	return nil
+}
+
+func isPrivate(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() {
+		return true
+	}
+	for _, network := range privateNetworks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func parseNetworks(cidrs ...string) []*net.IPNet {
+	result := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		result = append(result, network)
+	}
+	return result
+}
+
+// decodeDataURI decodes the metadata of fully on-chain collections
+func decodeDataURI(uri string) (Metadata, error) {
+	var m Metadata
+	comma := strings.Index(uri, ",")
+	if comma < 0 {
+		return m, errors.E("invalid data uri")
+	}
+	header, payload := uri[:comma], uri[comma+1:]
+	var raw []byte
+	if strings.HasSuffix(header, ";base64") {
+		b, err := base64.StdEncoding.DecodeString(payload)
+		if err != nil {
+			return m, errors.E(err, "invalid data uri encoding")
+		}
+		raw = b
+	} else {
+		s, err := url.PathUnescape(payload)
+		if err != nil {
+			s = payload
+		}
+		raw = []byte(s)
+	}
+	if err := json.Unmarshal(raw, &m); err != nil {
+		return m, errors.E(err, "invalid metadata")
+	}
+	return m, nil
+}
+
+func normalizeGateway(gateway string) string {
+	if gateway == "" {
+		gateway = DefaultGateway
+	}
+	if !strings.HasSuffix(gateway, "/") {
+		gateway += "/"
+	}
+	return gateway
+}
+
+// idHex returns the token id in the lowercase 64 hex characters form of the ERC-1155 uri
+func idHex(tokenID string) string {
+	id, ok := new(big.Int).SetString(tokenID, 10)
+	if !ok {
+		return tokenID
+	}
+	return fmt.Sprintf("%064x", id)
+}
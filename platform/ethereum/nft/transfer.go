@@ -0,0 +1,85 @@
+package nft
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/trustwallet/blockatlas/pkg/address"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+)
+
+const (
+	// safeTransferFrom(address,address,uint256) and safeTransferFrom(address,address,uint256,bytes) of ERC-721,
+	// transferFrom(address,address,uint256) is left out as ERC-20 shares it
+	safeTransferFromSelector         = "42842e0e"
+	safeTransferFromWithDataSelector = "b88d4fde"
+	// safeTransferFrom(address,address,uint256,uint256,bytes) of ERC-1155
+	safeTransferFrom1155Selector = "f242432a"
+)
+
+// DecodeTransfer returns the collectible transfer of a safeTransferFrom call to the contract
+func DecodeTransfer(coinIndex uint, contract, input string) (blockatlas.CollectibleTransfer, bool) {
+	data := strings.ToLower(address.Remove0x(input))
+	if len(data) < 8 {
+		return blockatlas.CollectibleTransfer{}, false
+	}
+	selector, args := data[:8], data[8:]
+	value := "1"
+	switch selector {
+	case safeTransferFromSelector, safeTransferFromWithDataSelector:
+		if word(args, 2) == "" {
+			return blockatlas.CollectibleTransfer{}, false
+		}
+	case safeTransferFrom1155Selector:
+		if word(args, 3) == "" {
+			return blockatlas.CollectibleTransfer{}, false
+		}
+		value = decodeUint(word(args, 3)).String()
+	default:
+		return blockatlas.CollectibleTransfer{}, false
+	}
+	return blockatlas.CollectibleTransfer{
+		Contract: address.ToEIP55ByCoinID(contract, coinIndex),
+		TokenID:  decodeUint(word(args, 2)).String(),
+		Value:    blockatlas.Amount(value),
+		From:     address.ToEIP55ByCoinID(decodeAddress(word(args, 0)), coinIndex),
+		To:       address.ToEIP55ByCoinID(decodeAddress(word(args, 1)), coinIndex),
+	}, true
+}
+
+// Describe returns the metadata of a token whose standard is unknown, trying tokenURI and then uri
+func (c *Client) Describe(contract, tokenID string) (Metadata, error) {
+	m, err := c.Metadata(Token{Contract: contract, TokenID: tokenID, Type: TypeERC721})
+	if err == nil {
+		return m, nil
+	}
+	return c.Metadata(Token{Contract: contract, TokenID: tokenID, Type: TypeERC1155})
+}
+
+// FillTransfers resolves the name and the image of the collectible transfers, a token
+// without metadata keeps the collection name or is named after its id
+func (c *Client) FillTransfers(txs []blockatlas.Tx) {
+	sem := make(chan struct{}, maxMetadataRequests)
+	var wg sync.WaitGroup
+	for i := range txs {
+		transfer, ok := txs[i].Meta.(blockatlas.CollectibleTransfer)
+		if !ok {
+			continue
+		}
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(tx *blockatlas.Tx, transfer blockatlas.CollectibleTransfer) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			transfer.Name = blockatlas.GetValidParameter(transfer.Name, "#"+transfer.TokenID)
+			if m, err := c.Describe(transfer.Contract, transfer.TokenID); err == nil {
+				transfer.Name = blockatlas.GetValidParameter(m.Name, transfer.Name)
+				transfer.ImageURL = ResolveURI(m.GetImage(), c.Gateway, transfer.TokenID)
+			}
+			tx.Meta = transfer
+		}(&txs[i], transfer)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,90 @@
+package swap
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+)
+
+// routers contains the router contracts of common AMMs and DEX aggregators, keyed by lowercase address
+var routers = map[string]string{
+	"0x7a250d5630b4cf539739df2c5dacb4c659f2488d": "Uniswap V2",
+	"0xe592427a0aece92de3edee1f18e0157c05861564": "Uniswap V3",
+	"0x68b3465833fb72a70ecdf485e0e4c7bd8665fc45": "Uniswap V3",
+	"0x3fc91a3afd70395cd496c647d5a6cc9d4b2b7fad": "Uniswap Universal Router",
+	"0xd9e1ce17f2641f24ae83637ab66a2cca9c378b9f": "SushiSwap",
+	"0x11111112542d85b3ef69ae05771c2dccff4faa26": "1inch",
+	"0x1111111254fb6c44bac0bed2854e76f90643097d": "1inch",
+	"0x1111111254eeb25477b68fb85ed929f73a960582": "1inch",
+	"0xdef1c0ded9bec7f1a1670819833240f027b25eff": "0x",
+	"0xdef171fe48cf0115b1d80b88dc8eab59176fee57": "ParaSwap",
+	"0x9aab3f75489902f3a48495025729a0af77d4b11e": "Kyber",
+	"0x10ed43c718714eb63d5aa57b78b54704e256024e": "PancakeSwap",
+}
+
+// IsRouter returns true if the address is a known swap router
+func IsRouter(address string) bool {
+	_, ok := routers[strings.ToLower(address)]
+	return ok
+}
+
+// Detect returns the swap made by the trader through the router. The transfers need to contain
+// exactly one asset sent by the trader and one different asset received by it, legs of the same
+// asset are summed up.
+func Detect(trader, router string, transfers []blockatlas.SubTransfer) (blockatlas.TokenSwap, bool) {
+	if trader == "" || !IsRouter(router) {
+		return blockatlas.TokenSwap{}, false
+	}
+	input, ok := leg(transfers, func(t blockatlas.SubTransfer) bool {
+		return strings.EqualFold(t.From, trader)
+	})
+	if !ok {
+		return blockatlas.TokenSwap{}, false
+	}
+	output, ok := leg(transfers, func(t blockatlas.SubTransfer) bool {
+		return strings.EqualFold(t.To, trader)
+	})
+	if !ok || strings.EqualFold(input.TokenID, output.TokenID) {
+		return blockatlas.TokenSwap{}, false
+	}
+	input.From, input.To = trader, router
+	output.From, output.To = router, trader
+	return blockatlas.TokenSwap{Input: input, Output: output}, true
+}
+
+func leg(transfers []blockatlas.SubTransfer, match func(t blockatlas.SubTransfer) bool) (blockatlas.TokenTransfer, bool) {
+	var (
+		result blockatlas.TokenTransfer
+		total  = new(big.Int)
+		found  bool
+	)
+	for _, t := range transfers {
+		if !match(t) {
+			continue
+		}
+		// collectibles are traded, not swapped
+		if t.Type == blockatlas.TxCollectibleTransfer {
+			return result, false
+		}
+		if found && !strings.EqualFold(t.TokenID, result.TokenID) {
+			return result, false
+		}
+		value, ok := new(big.Int).SetString(string(t.Value), 10)
+		if !ok {
+			return result, false
+		}
+		total.Add(total, value)
+		if !found {
+			result = blockatlas.TokenTransfer{
+				Name:     t.Name,
+				Symbol:   t.Symbol,
+				TokenID:  t.TokenID,
+				Decimals: t.Decimals,
+			}
+			found = true
+		}
+	}
+	result.Value = blockatlas.Amount(total.String())
+	return result, found
+}
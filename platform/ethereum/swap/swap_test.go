@@ -0,0 +1,89 @@
+package swap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+)
+
+const (
+	trader = "0x7d8bf18C7cE84b3E175b339c4Ca93aEd1dD166F1"
+	router = "0x7a250d5630B4cF539739dF2C5dAcb4c659F2488D"
+	pair   = "0xA478c2975Ab1Ea89e8196811F51A7B7Ade33eB11"
+	dai    = "0x6B175474E89094C44Da98b954EedeAC495271d0F"
+	weth   = "0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2"
+)
+
+var (
+	ethIn      = blockatlas.SubTransfer{Type: blockatlas.TxTransfer, Name: "Ethereum", Symbol: "ETH", Decimals: 18, Value: "1000", From: trader, To: router}
+	wethToPair = blockatlas.SubTransfer{Type: blockatlas.TxTokenTransfer, Name: "Wrapped Ether", Symbol: "WETH", TokenID: weth, Decimals: 18, Value: "1000", From: router, To: pair}
+	daiOut     = blockatlas.SubTransfer{Type: blockatlas.TxTokenTransfer, Name: "Dai Stablecoin", Symbol: "DAI", TokenID: dai, Decimals: 18, Value: "250000", From: pair, To: trader}
+	daiIn      = blockatlas.SubTransfer{Type: blockatlas.TxTokenTransfer, Name: "Dai Stablecoin", Symbol: "DAI", TokenID: dai, Decimals: 18, Value: "250000", From: trader, To: pair}
+	ethOut     = blockatlas.SubTransfer{Type: blockatlas.TxTransfer, Name: "Ethereum", Symbol: "ETH", Decimals: 18, Value: "990", From: router, To: trader}
+)
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		name      string
+		router    string
+		transfers []blockatlas.SubTransfer
+		want      blockatlas.TokenSwap
+		wantOk    bool
+	}{
+		{
+			name:      "ETH to token",
+			router:    router,
+			transfers: []blockatlas.SubTransfer{ethIn, wethToPair, daiOut},
+			want: blockatlas.TokenSwap{
+				Input:  blockatlas.TokenTransfer{Name: "Ethereum", Symbol: "ETH", Decimals: 18, Value: "1000", From: trader, To: router},
+				Output: blockatlas.TokenTransfer{Name: "Dai Stablecoin", Symbol: "DAI", TokenID: dai, Decimals: 18, Value: "250000", From: router, To: trader},
+			},
+			wantOk: true,
+		},
+		{
+			name:      "Token to ETH with split output",
+			router:    router,
+			transfers: []blockatlas.SubTransfer{daiIn, ethOut, ethOut},
+			want: blockatlas.TokenSwap{
+				Input:  blockatlas.TokenTransfer{Name: "Dai Stablecoin", Symbol: "DAI", TokenID: dai, Decimals: 18, Value: "250000", From: trader, To: router},
+				Output: blockatlas.TokenTransfer{Name: "Ethereum", Symbol: "ETH", Decimals: 18, Value: "1980", From: router, To: trader},
+			},
+			wantOk: true,
+		},
+		{
+			name:      "Unknown router",
+			router:    pair,
+			transfers: []blockatlas.SubTransfer{ethIn, wethToPair, daiOut},
+		},
+		{
+			name:      "No output",
+			router:    router,
+			transfers: []blockatlas.SubTransfer{ethIn, wethToPair},
+		},
+		{
+			name:      "Same asset",
+			router:    router,
+			transfers: []blockatlas.SubTransfer{daiIn, daiOut},
+		},
+		{
+			name:      "Several output assets",
+			router:    router,
+			transfers: []blockatlas.SubTransfer{ethIn, daiOut, ethOut},
+		},
+		{
+			name:   "Collectible",
+			router: router,
+			transfers: []blockatlas.SubTransfer{ethIn, {
+				Type: blockatlas.TxCollectibleTransfer, TokenID: pair, CollectibleID: "1", Value: "1", From: pair, To: trader,
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := Detect(trader, tt.router, tt.transfers)
+			assert.Equal(t, tt.wantOk, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
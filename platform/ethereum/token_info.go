@@ -0,0 +1,23 @@
+package ethereum
+
+import (
+	"github.com/trustwallet/blockatlas/pkg/address"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/platform/ethereum/trustray"
+)
+
+// GetTokenInfo reads the metadata of the token from its contract
+func (p *Platform) GetTokenInfo(tokenID string) (blockatlas.Token, error) {
+	name, symbol, decimals, err := p.erc20.TokenInfo(tokenID)
+	if err != nil {
+		return blockatlas.Token{}, err
+	}
+	return blockatlas.Token{
+		Name:     name,
+		Symbol:   symbol,
+		Decimals: decimals,
+		TokenID:  address.ToEIP55ByCoinID(tokenID, p.CoinIndex),
+		Coin:     p.CoinIndex,
+		Type:     trustray.GetTokenTypeByIndex(p.CoinIndex),
+	}, nil
+}
@@ -0,0 +1,54 @@
+package ethereum
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/coin"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+)
+
+func TestGetTokenInfo(t *testing.T) {
+	results := map[string]string{
+		"0x313ce567": "0x0000000000000000000000000000000000000000000000000000000000000012",
+		"0x06fdde03": "0x0000000000000000000000000000000000000000000000000000000000000020" +
+			"000000000000000000000000000000000000000000000000000000000000000e" +
+			"44616920537461626c65636f696e000000000000000000000000000000000000",
+		"0x95d89b41": "0x4441490000000000000000000000000000000000000000000000000000000000",
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Params []json.RawMessage `json:"params"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		var call struct {
+			To   string `json:"to"`
+			Data string `json:"data"`
+		}
+		_ = json.Unmarshal(req.Params[0], &call)
+		result := "0x"
+		if call.To == "0x6b175474e89094c44da98b954eedeac495271d0f" {
+			result = results[call.Data]
+		}
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"` + result + `"}`))
+	}))
+	defer server.Close()
+	p := Init(coin.ETH, "", server.URL)
+
+	token, err := p.GetTokenInfo("0x6b175474e89094c44da98b954eedeac495271d0f")
+	assert.Nil(t, err)
+	assert.Equal(t, blockatlas.Token{
+		Name:     "Dai Stablecoin",
+		Symbol:   "DAI",
+		Decimals: 18,
+		TokenID:  "0x6B175474E89094C44Da98b954EedeAC495271d0F",
+		Coin:     coin.ETH,
+		Type:     blockatlas.TokenTypeERC20,
+	}, token)
+
+	_, err = p.GetTokenInfo("0x7a250d5630b4cf539739df2c5dacb4c659f2488d")
+	assert.NotNil(t, err)
+}
@@ -5,9 +5,25 @@ import (
 )
 
 func (p *Platform) GetTxsByAddress(address string) (blockatlas.TxPage, error) {
-	return p.client.GetTransactions(address, p.CoinIndex)
+	txs, err := p.client.GetTransactions(address, p.CoinIndex)
+	if err != nil {
+		return nil, err
+	}
+	p.nft.FillTransfers(txs)
+	p.fillApprovals(txs, address)
+	return txs, nil
 }
 
 func (p *Platform) GetTokenTxsByAddress(address string, token string) (blockatlas.TxPage, error) {
-	return p.client.GetTokenTxs(address, token, p.CoinIndex)
+	txs, err := p.client.GetTokenTxs(address, token, p.CoinIndex)
+	if err != nil {
+		return nil, err
+	}
+	p.nft.FillTransfers(txs)
+	return txs, nil
+}
+
+// FillCollectibleTransfers resolves the collectible transfers of the transactions taken from the blocks
+func (p *Platform) FillCollectibleTransfers(txs []blockatlas.Tx) {
+	p.nft.FillTransfers(txs)
 }
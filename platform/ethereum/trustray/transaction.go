@@ -6,6 +6,9 @@ import (
 	"github.com/trustwallet/blockatlas/coin"
 	"github.com/trustwallet/blockatlas/pkg/address"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/platform/ethereum/approval"
+	"github.com/trustwallet/blockatlas/platform/ethereum/nft"
+	"github.com/trustwallet/blockatlas/platform/ethereum/swap"
 )
 
 func (c *Client) GetTransactions(address string, coinIndex uint) (blockatlas.TxPage, error) {
@@ -59,6 +62,11 @@ func AppendTxs(in []blockatlas.Tx, srcTx *Doc, coinIndex uint) (out []blockatlas
 			Input: srcTx.Input,
 			Value: srcTx.Value,
 		}
+		if action, ok := approval.Action(coinIndex, srcTx.To, srcTx.Input); ok {
+			contractTx.Meta = action
+		} else if collectible, ok := nft.DecodeTransfer(coinIndex, srcTx.To, srcTx.Input); ok {
+			contractTx.Meta = collectible
+		}
 		out = append(out, contractTx)
 		return
 	}
@@ -66,6 +74,19 @@ func AppendTxs(in []blockatlas.Tx, srcTx *Doc, coinIndex uint) (out []blockatlas
 	if len(srcTx.Ops) == 0 {
 		return
 	}
+
+	// Internal value transfers or several token transfers
+	if transfers, internal := getTransfers(srcTx, coinIndex); internal || len(transfers) > 1 {
+		multiTx := baseTx
+		if tokenSwap, ok := swap.Detect(baseTx.From, baseTx.To, transfers); ok {
+			multiTx.Meta = tokenSwap
+		} else {
+			multiTx.Meta = blockatlas.MultiTransfer{Transfers: transfers}
+		}
+		out = append(out, multiTx)
+		return
+	}
+
 	op := &srcTx.Ops[0]
 	// Token transfer transaction
 	if op.Type == blockatlas.TxTokenTransfer && op.Contract != nil {
@@ -85,6 +106,50 @@ func AppendTxs(in []blockatlas.Tx, srcTx *Doc, coinIndex uint) (out []blockatlas
 	return
 }
 
+// getTransfers returns the native value, the internal value transfers and the token transfers of the transaction.
+// It also reports whether the transaction contains any internal value transfer.
+func getTransfers(srcTx *Doc, coinIndex uint) (transfers []blockatlas.SubTransfer, internal bool) {
+	transfers = make([]blockatlas.SubTransfer, 0, len(srcTx.Ops)+1)
+	if hasValue(srcTx.Value) {
+		transfers = append(transfers, getNativeTransfer(srcTx.From, srcTx.To, srcTx.Value, coinIndex))
+	}
+	for _, op := range srcTx.Ops {
+		switch {
+		case op.Type == blockatlas.TxTransfer && hasValue(op.Value):
+			internal = true
+			transfers = append(transfers, getNativeTransfer(op.From, op.To, op.Value, coinIndex))
+		case op.Type == blockatlas.TxTokenTransfer && op.Contract != nil:
+			transfers = append(transfers, blockatlas.SubTransfer{
+				Type:     blockatlas.TxTokenTransfer,
+				Name:     op.Contract.Name,
+				Symbol:   op.Contract.Symbol,
+				TokenID:  address.ToEIP55ByCoinID(op.Contract.Address, coinIndex),
+				Decimals: op.Contract.Decimals,
+				Value:    blockatlas.Amount(op.Value),
+				From:     address.ToEIP55ByCoinID(op.From, coinIndex),
+				To:       address.ToEIP55ByCoinID(op.To, coinIndex),
+			})
+		}
+	}
+	return transfers, internal
+}
+
+func getNativeTransfer(from, to, value string, coinIndex uint) blockatlas.SubTransfer {
+	return blockatlas.SubTransfer{
+		Type:     blockatlas.TxTransfer,
+		Name:     coin.Coins[coinIndex].Name,
+		Symbol:   coin.Coins[coinIndex].Symbol,
+		Decimals: coin.Coins[coinIndex].Decimals,
+		Value:    blockatlas.Amount(value),
+		From:     address.ToEIP55ByCoinID(from, coinIndex),
+		To:       address.ToEIP55ByCoinID(to, coinIndex),
+	}
+}
+
+func hasValue(value string) bool {
+	return value != "" && value != "0"
+}
+
 func extractBase(srcTx *Doc, coinIndex uint) (base blockatlas.Tx, ok bool) {
 	var (
 		status    blockatlas.Status
@@ -185,6 +185,85 @@ var failedDst = blockatlas.Tx{
 	},
 }
 
+const multiTransferSrc = `
+{
+    "operations": [
+        {
+            "transactionId": "0x5d6a3b0a0d6bd8ae6c6b0c1c4d3a6e45d7c9bf0d7b2cf0f9e5d4c31f1b0a7e11-0",
+            "from": "0xc67f9c909c4d185e4a5d21d642c27d05a145a76c",
+            "to": "0xaa4d790076f1bf7511a0a0ac498c89e13e1efe17",
+            "type": "transfer",
+            "value": "500000000000000000",
+            "coin": 60
+        },
+        {
+            "transactionId": "0x5d6a3b0a0d6bd8ae6c6b0c1c4d3a6e45d7c9bf0d7b2cf0f9e5d4c31f1b0a7e11-1",
+            "contract": {
+                "address": "0xf3586684107ce0859c44aa2b2e0fb8cd8731a15a",
+                "symbol": "KBC",
+                "decimals": 7,
+                "totalSupply": "120000000000000000",
+                "name": "KaratBank Coin"
+            },
+            "from": "0xc67f9c909c4d185e4a5d21d642c27d05a145a76c",
+            "to": "0xd35f30d194684a391c63a6deced7d3dd5207c265",
+            "type": "token_transfer",
+            "value": "4291000000",
+            "coin": 60
+        }
+    ],
+    "contract": null,
+    "_id": "0x5d6a3b0a0d6bd8ae6c6b0c1c4d3a6e45d7c9bf0d7b2cf0f9e5d4c31f1b0a7e11",
+    "blockNumber": 7522627,
+    "time": 1554661737,
+    "nonce": 535,
+    "from": "0xd35f30d194684a391c63a6deced7d3dd5207c265",
+    "to": "0xc67f9c909c4d185e4a5d21d642c27d05a145a76c",
+    "value": "0",
+    "gas": "200000",
+    "gasPrice": "20000000000",
+    "gasUsed": "100000",
+    "input": "0x2e1a7d4d",
+    "error": "",
+    "id": "0x5d6a3b0a0d6bd8ae6c6b0c1c4d3a6e45d7c9bf0d7b2cf0f9e5d4c31f1b0a7e11",
+    "coin": 60
+}`
+
+var multiTransferDst = blockatlas.Tx{
+	ID:       "0x5d6a3b0a0d6bd8ae6c6b0c1c4d3a6e45d7c9bf0d7b2cf0f9e5d4c31f1b0a7e11",
+	Coin:     coin.ETH,
+	From:     "0xd35F30D194684a391C63A6decEd7d3dd5207c265",
+	To:       "0xc67f9C909C4d185E4A5d21D642c27D05A145a76c",
+	Fee:      "2000000000000000",
+	Date:     1554661737,
+	Block:    7522627,
+	Status:   blockatlas.StatusCompleted,
+	Sequence: 535,
+	Meta: blockatlas.MultiTransfer{
+		Transfers: []blockatlas.SubTransfer{
+			{
+				Type:     blockatlas.TxTransfer,
+				Name:     "Ethereum",
+				Symbol:   "ETH",
+				Decimals: 18,
+				Value:    "500000000000000000",
+				From:     "0xc67f9C909C4d185E4A5d21D642c27D05A145a76c",
+				To:       "0xaA4D790076f1Bf7511a0A0AC498C89e13e1eFE17",
+			},
+			{
+				Type:     blockatlas.TxTokenTransfer,
+				Name:     "KaratBank Coin",
+				Symbol:   "KBC",
+				TokenID:  "0xf3586684107CE0859c44aa2b2E0fB8cd8731a15a",
+				Decimals: 7,
+				Value:    "4291000000",
+				From:     "0xc67f9C909C4d185E4A5d21D642c27D05A145a76c",
+				To:       "0xd35F30D194684a391C63A6decEd7d3dd5207c265",
+			},
+		},
+	},
+}
+
 func TestNormalize(t *testing.T) {
 	var (
 		doc   Doc
@@ -196,6 +275,7 @@ func TestNormalize(t *testing.T) {
 			{"token transfer", tokenTransferSrc, &tokenTransferDst},
 			{"contract call", contractCallSrc, &contractCallDst},
 			{"failed transaction", failedSrc, &failedDst},
+			{"multi transfer", multiTransferSrc, &multiTransferDst},
 		}
 	)
 
@@ -35,3 +35,13 @@ func (c *Client) lookupPubAddress(name string, coinSymbol string) (address strin
 	}
 	return res.PublicAddress, nil
 }
+
+// getFioNames returns the FIO addresses owned by the public key, the API responds with 404 if there are none
+func (c *Client) getFioNames(publicKey string) ([]FioAddress, error) {
+	var res GetFioNamesResponse
+	err := c.Post(&res, "v1/chain/get_fio_names", GetFioNamesRequest{FioPublicKey: publicKey})
+	if err != nil {
+		return nil, errors.E(err, "Error looking up FIO names", errors.Params{"public_key": publicKey, "inner_error": err.Error()})
+	}
+	return res.FioAddresses, nil
+}
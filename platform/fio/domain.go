@@ -38,3 +38,19 @@ func (p *Platform) Lookup(coins []uint64, name string) ([]blockatlas.Resolved, e
 
 	return result, nil
 }
+
+// ReverseLookup returns the first FIO address owned by a FIO public key,
+// FIO has no index from the addresses of other chains to names
+func (p *Platform) ReverseLookup(coinID uint64, address string) (string, error) {
+	if coinID != coin.FIO {
+		return "", nil
+	}
+	names, err := p.client.getFioNames(address)
+	if err != nil {
+		return "", err
+	}
+	if len(names) == 0 {
+		return "", nil
+	}
+	return names[0].FioAddress, nil
+}
@@ -1,9 +1,13 @@
 package fio
 
 import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/coin"
 )
 
 func TestCanHandle(t *testing.T) {
@@ -30,3 +34,30 @@ func TestCanHandle(t *testing.T) {
 		assert.Equal(t, tt.want, res)
 	}
 }
+
+func TestReverseLookup(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req GetFioNamesRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		if req.FioPublicKey != "FIO6ydLCnUfsEMpbp35kF8oaUbHvcmLEyswMUF75C4FQAm78DUhAi" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"No FIO names"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"fio_domains":[],"fio_addresses":[{"fio_address":"bob@trust","expiration":"2021-08-10T07:13:52"}]}`))
+	}))
+	defer server.Close()
+	p := Init(server.URL)
+
+	name, err := p.ReverseLookup(coin.FIO, "FIO6ydLCnUfsEMpbp35kF8oaUbHvcmLEyswMUF75C4FQAm78DUhAi")
+	assert.Nil(t, err)
+	assert.Equal(t, "bob@trust", name)
+
+	name, err = p.ReverseLookup(coin.FIO, "FIO5kJKNHwctcfUM5XZyiWSqSTM5HTzznJP9F3ZdbhaQAHEVq575o")
+	assert.Nil(t, err)
+	assert.Equal(t, "", name)
+
+	name, err = p.ReverseLookup(coin.ETH, "0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045")
+	assert.Nil(t, err)
+	assert.Equal(t, "", name)
+}
@@ -64,3 +64,19 @@ type GetPubAddressResponse struct {
 	PublicAddress string `json:"public_address"`
 	Message       string `json:"message"`
 }
+
+// GetFioNamesRequest request struct for get_fio_names
+type GetFioNamesRequest struct {
+	FioPublicKey string `json:"fio_public_key"`
+}
+
+// GetFioNamesResponse response struct for get_fio_names
+type GetFioNamesResponse struct {
+	FioAddresses []FioAddress `json:"fio_addresses"`
+	Message      string       `json:"message"`
+}
+
+type FioAddress struct {
+	FioAddress string `json:"fio_address"`
+	Expiration string `json:"expiration"`
+}
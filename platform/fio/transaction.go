@@ -63,7 +63,8 @@ func (p *Platform) Normalize(action *Action, account string) (blockatlas.Tx, err
 			if err == nil {
 				amount = blockatlas.Amount(strconv.Itoa(int(amountNum * 1000000000)))
 			}
-			// fee unknown
+			// fee unknown, report it as zero
+			fee = "0"
 			memo = actionData.Memo
 			sequence = action.ActionSeq
 		case "trnsfiopubky":
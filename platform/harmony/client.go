@@ -63,6 +63,37 @@ func (c *Client) GetDelegations(address string) (delegations Delegations, err er
 	return
 }
 
+// GetStakingTxsOfAddress returns the page of the staking transactions sent by the address, the latest first
+func (c *Client) GetStakingTxsOfAddress(address string, page int) (txs StakingTxResult, err error) {
+	params := []interface{}{
+		map[string]interface{}{
+			"address":   address,
+			"pageIndex": page - 1,
+			"pageSize":  blockatlas.RewardsPerPage,
+			"fullTx":    true,
+			"txType":    "SENT",
+			"order":     "DESC",
+		},
+	}
+	err = rpcCallStub(c, &txs, "hmy_getStakingTransactionsHistory", params)
+	return
+}
+
+func (c *Client) GetTransactionReceipt(hash string) (receipt Receipt, err error) {
+	err = rpcCallStub(c, &receipt, "hmy_getTransactionReceipt", []interface{}{hash})
+	return
+}
+
+func (c *Client) GetEpoch() (int64, error) {
+	var epoch string
+	err := rpcCallStub(c, &epoch, "hmy_getEpoch", nil)
+	if err != nil {
+		return 0, err
+	}
+	value, err := hexToInt(epoch)
+	return int64(value), err
+}
+
 func (c *Client) GetBalance(address string) (string, error) {
 	var result string
 	err := rpcCallStub(c, &result, "hmy_getBalance", []interface{}{address, "latest"})
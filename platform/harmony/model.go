@@ -1,5 +1,7 @@
 package harmony
 
+import "math/big"
+
 type TxResponse struct {
 	Result TxResult `json:"result"`
 }
@@ -28,17 +30,32 @@ type BlockInfo struct {
 }
 
 type ValidatorInfo struct {
-	Address string  `json:"address"`
+	Address     string                `json:"address"`
+	Rate        string                `json:"rate"`
+	Delegations []ValidatorDelegation `json:"delegations"`
+}
+
+type ValidatorDelegation struct {
+	DelegatorAddress string  `json:"delegator-address"`
+	Amount           float64 `json:"amount"`
 }
 
 type LifetimeInfo struct {
-	Apr     string `json:"apr"`
+	Apr    string     `json:"apr"`
+	Blocks BlocksInfo `json:"blocks"`
+}
+
+type BlocksInfo struct {
+	ToSign int64 `json:"to-sign"`
+	Signed int64 `json:"signed"`
 }
 
 type Validator struct {
-	Info      ValidatorInfo    `json:"validator"`
-	Active    bool             `json:"currently-in-committee"`
-	Lifetime  LifetimeInfo      `json:"lifetime"`
+	Info            ValidatorInfo `json:"validator"`
+	Active          bool          `json:"currently-in-committee"`
+	Lifetime        LifetimeInfo  `json:"lifetime"`
+	TotalDelegation float64       `json:"total-delegation"`
+	BootedStatus    *string       `json:"booted-status"`
 }
 
 type Validators struct {
@@ -46,11 +63,57 @@ type Validators struct {
 }
 
 type Delegation struct {
-	DelegatorAddress string   `json:"delegator_address"`
-	ValidatorAddress string   `json:"validator_address"`
-	Amount           float64  `json:"amount"`
+	DelegatorAddress string         `json:"delegator_address"`
+	ValidatorAddress string         `json:"validator_address"`
+	Amount           float64        `json:"amount"`
+	Reward           float64        `json:"reward"`
+	Undelegations    []Undelegation `json:"Undelegations"`
+}
+
+// Undelegation - Epoch is the epoch the undelegation was sent at
+type Undelegation struct {
+	Amount float64 `json:"Amount"`
+	Epoch  int64   `json:"Epoch"`
 }
 
 type Delegations struct {
 	List []Delegation `json:"result"`
 }
+
+const (
+	StakingTxTypeDelegate       = "Delegate"
+	StakingTxTypeUndelegate     = "Undelegate"
+	StakingTxTypeCollectRewards = "CollectRewards"
+)
+
+type StakingTxResult struct {
+	Transactions []StakingTransaction `json:"staking_transactions"`
+}
+
+type StakingTransaction struct {
+	BlockNumber string     `json:"blockNumber"`
+	From        string     `json:"from"`
+	Gas         string     `json:"gas"`
+	GasPrice    string     `json:"gasPrice"`
+	Hash        string     `json:"hash"`
+	Nonce       string     `json:"nonce"`
+	Timestamp   string     `json:"timestamp"`
+	Type        string     `json:"type"`
+	Msg         StakingMsg `json:"msg"`
+}
+
+// StakingMsg - Amount is missing from the messages collecting the rewards
+type StakingMsg struct {
+	DelegatorAddress string   `json:"delegatorAddress"`
+	ValidatorAddress string   `json:"validatorAddress"`
+	Amount           *big.Int `json:"amount"`
+}
+
+type Receipt struct {
+	Status string       `json:"status"`
+	Logs   []ReceiptLog `json:"logs"`
+}
+
+type ReceiptLog struct {
+	Data string `json:"data"`
+}
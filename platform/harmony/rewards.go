@@ -0,0 +1,74 @@
+package harmony
+
+import (
+	"math/big"
+
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/logger"
+	"github.com/trustwallet/blockatlas/pkg/numbers"
+)
+
+// GetPendingRewards returns the epoch rewards accumulated with every validator and not collected yet
+func (p *Platform) GetPendingRewards(address string) (blockatlas.PendingRewards, error) {
+	delegations, err := p.client.GetDelegations(address)
+	if err != nil {
+		return nil, err
+	}
+	return NormalizePendingRewards(delegations.List), nil
+}
+
+// GetRewardsHistory returns the collected rewards, the amounts are read from the receipts
+func (p *Platform) GetRewardsHistory(address string, page int) (blockatlas.RewardsPage, error) {
+	txs, err := p.client.GetStakingTxsOfAddress(address, page)
+	if err != nil {
+		return nil, err
+	}
+	results := make(blockatlas.RewardsPage, 0)
+	for _, tx := range txs.Transactions {
+		if tx.Type != StakingTxTypeCollectRewards {
+			continue
+		}
+		receipt, err := p.client.GetTransactionReceipt(tx.Hash)
+		if err != nil {
+			logger.Error(err, "Harmony: Failed to get the receipt of the collected rewards", logger.Params{"tx": tx.Hash})
+			continue
+		}
+		if reward, ok := NormalizeReward(tx, receipt); ok {
+			results = append(results, reward)
+		}
+	}
+	return results, nil
+}
+
+func NormalizePendingRewards(delegations []Delegation) blockatlas.PendingRewards {
+	results := make(blockatlas.PendingRewards, 0)
+	for _, d := range delegations {
+		if d.Reward <= 0 {
+			continue
+		}
+		value, _ := new(big.Float).SetFloat64(d.Reward).Int(nil)
+		results = append(results, blockatlas.PendingReward{Validator: d.ValidatorAddress, Value: value.String()})
+	}
+	return results
+}
+
+// NormalizeReward reads the collected amount from the log of the receipt, the validator isn't known
+func NormalizeReward(tx StakingTransaction, receipt Receipt) (blockatlas.Reward, bool) {
+	if len(receipt.Logs) == 0 {
+		return blockatlas.Reward{}, false
+	}
+	value, err := numbers.HexToDecimal(receipt.Logs[0].Data)
+	if err != nil {
+		return blockatlas.Reward{}, false
+	}
+	date, err := hexToInt(tx.Timestamp)
+	if err != nil {
+		return blockatlas.Reward{}, false
+	}
+	return blockatlas.Reward{
+		ID:    tx.Hash,
+		Value: value,
+		Type:  blockatlas.RewardTypeClaim,
+		Date:  int64(date),
+	}, true
+}
@@ -0,0 +1,55 @@
+package harmony
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+)
+
+const stakingTxsSrc = `
+{
+	"staking_transactions": [
+		{"hash": "0xc1", "timestamp": "0x5e5bb2a0", "type": "CollectRewards"},
+		{"hash": "0xd1", "timestamp": "0x5e5bb200", "type": "Delegate"}
+	]
+}`
+
+const receiptSrc = `
+{
+	"status": "0x1",
+	"logs": [{"data": "0x00000000000000000000000000000000000000000000007e37be2022c0914b26"}]
+}`
+
+func TestNormalizePendingRewards(t *testing.T) {
+	delegations := []Delegation{
+		{DelegatorAddress: "one1a", ValidatorAddress: "one1v8pukmelacy3xdap773rpg5pax3tmu40wmwr2j", Amount: 1e22, Reward: 2328233463148225437028},
+		{DelegatorAddress: "one1a", ValidatorAddress: "one1pdv9lrdwl0rg5vglh4xtyrv3wjk3wsqket7zxy", Amount: 1e22, Reward: 0},
+	}
+	expected := blockatlas.PendingRewards{
+		{Validator: "one1v8pukmelacy3xdap773rpg5pax3tmu40wmwr2j", Value: "2328233463148225495040"},
+	}
+	assert.Equal(t, expected, NormalizePendingRewards(delegations))
+}
+
+func TestGetRewardsHistory(t *testing.T) {
+	stub := rpcCallStub
+	defer func() { rpcCallStub = stub }()
+	rpcCallStub = func(c *Client, result interface{}, method string, params interface{}) error {
+		switch method {
+		case "hmy_getStakingTransactionsHistory":
+			return json.Unmarshal([]byte(stakingTxsSrc), result)
+		case "hmy_getTransactionReceipt":
+			return json.Unmarshal([]byte(receiptSrc), result)
+		}
+		return nil
+	}
+	p := Platform{}
+
+	history, err := p.GetRewardsHistory("one1a", 1)
+	assert.Nil(t, err)
+	assert.Equal(t, blockatlas.RewardsPage{
+		{ID: "0xc1", Value: "2328306436538696289062", Type: blockatlas.RewardTypeClaim, Date: 1583067808},
+	}, history)
+}
@@ -5,12 +5,17 @@ import (
 	"github.com/trustwallet/blockatlas/pkg/errors"
 	"github.com/trustwallet/blockatlas/pkg/logger"
 	"github.com/trustwallet/blockatlas/services/assets"
+	"github.com/trustwallet/blockatlas/services/economics"
 	"math/big"
 	"strconv"
+	"time"
 )
 
 const (
-	lockTime  = 604800 // in seconds (7 epochs or 7 days)
+	lockTime = 604800 // in seconds (7 epochs or 7 days)
+
+	undelegationLockEpochs = 7
+	epochDuration          = 86400 // in seconds
 )
 
 func (p *Platform) GetActiveValidators() (blockatlas.StakeValidators, error) {
@@ -39,35 +44,40 @@ func (p *Platform) GetValidators() (blockatlas.ValidatorPage, error) {
 		}
 		results = append(results, normalizeValidator(v, apr))
 	}
+	results.SetStakeShares()
 
 	return results, nil
 }
 
 func (p *Platform) GetDetails() blockatlas.StakingDetails {
-	apr := p.GetMaxAPR()
-	return getDetails(apr)
+	return getDetails(economics.Annual(p.Coin().Handle, Annual))
 }
 
-func (p *Platform) GetMaxAPR() float64 {
+func (p *Platform) GetEconomics() (blockatlas.StakingEconomics, error) {
 	validators, err := p.client.GetValidators()
 	if err != nil {
-		logger.Error("GetMaxAPR", logger.Params{"details": err, "platform": p.Coin().Symbol})
-		return Annual
+		return blockatlas.StakingEconomics{}, err
 	}
+	return NormalizeEconomics(validators.Validators), nil
+}
 
-	var max = 0.0
-	for _, e := range validators.Validators {
-		var apr float64
-		if apr, err = strconv.ParseFloat(e.Lifetime.Apr, 64); err != nil {
-			apr = 0.0
+// NormalizeEconomics reads the lifetime yield the nodes compute for every validator, the validators out of
+// the committee earn nothing and the network yield is the best of the others
+func NormalizeEconomics(validators []Validator) blockatlas.StakingEconomics {
+	result := blockatlas.StakingEconomics{
+		Validators: make(map[string]float64, len(validators)),
+	}
+	for _, v := range validators {
+		apr, err := strconv.ParseFloat(v.Lifetime.Apr, 64)
+		if err != nil || !v.Active {
+			apr = 0
 		}
-
-		if apr > max {
-			max = apr
+		result.Validators[v.Info.Address] = apr
+		if apr > result.Annual {
+			result.Annual = apr
 		}
 	}
-
-	return max
+	return result
 }
 
 func (p *Platform) GetDelegations(address string) (blockatlas.DelegationsPage, error) {
@@ -81,7 +91,15 @@ func (p *Platform) GetDelegations(address string) (blockatlas.DelegationsPage, e
 		return nil, err
 	}
 
-	return NormalizeDelegations(delegations.List, validators), nil
+	results := NormalizeDelegations(delegations.List, validators)
+	if hasUndelegations(delegations.List) {
+		epoch, err := p.client.GetEpoch()
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, NormalizeUndelegations(delegations.List, validators, epoch, time.Now().Unix())...)
+	}
+	return results, nil
 }
 
 func (p *Platform) UndelegatedBalance(address string) (string, error) {
@@ -117,6 +135,42 @@ func NormalizeDelegations(delegations []Delegation, validators blockatlas.Valida
 	return results
 }
 
+// NormalizeUndelegations returns the undelegated amounts, available 7 epochs after the epoch of the undelegation
+func NormalizeUndelegations(delegations []Delegation, validators blockatlas.ValidatorMap, epoch, now int64) []blockatlas.Delegation {
+	results := make([]blockatlas.Delegation, 0)
+	for _, d := range delegations {
+		validator, ok := validators[d.ValidatorAddress]
+		if !ok {
+			continue
+		}
+		for _, u := range d.Undelegations {
+			remaining := u.Epoch + undelegationLockEpochs - epoch
+			if remaining < 0 {
+				remaining = 0
+			}
+			value, _ := new(big.Float).SetFloat64(u.Amount).Int(nil)
+			results = append(results, blockatlas.Delegation{
+				Delegator: validator,
+				Value:     value.String(),
+				Status:    blockatlas.DelegationStatusPending,
+				Metadata: blockatlas.DelegationMetaDataPending{
+					AvailableDate: uint(now + remaining*epochDuration),
+				},
+			})
+		}
+	}
+	return results
+}
+
+func hasUndelegations(delegations []Delegation) bool {
+	for _, d := range delegations {
+		if len(d.Undelegations) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 func getDetails(apr float64) blockatlas.StakingDetails {
 	return blockatlas.StakingDetails{
 		Reward:        blockatlas.StakingReward{Annual: apr},
@@ -131,5 +185,32 @@ func normalizeValidator(v Validator, apr float64) (validator blockatlas.Validato
 		Status:  v.Active,
 		ID:      v.Info.Address,
 		Details: getDetails(apr),
+		Metrics: normalizeMetrics(v),
+	}
+}
+
+// normalizeMetrics reads the lifetime signing of the validator, a booted validator is reported as jailed
+func normalizeMetrics(v Validator) *blockatlas.ValidatorMetrics {
+	rate, err := strconv.ParseFloat(v.Info.Rate, 64)
+	if err != nil {
+		rate = 0
+	}
+	power, _ := new(big.Float).SetFloat64(v.TotalDelegation).Int(nil)
+	metrics := blockatlas.ValidatorMetrics{
+		Commission:    rate * 100,
+		HasCommission: true,
+		VotingPower:   power.String(),
+		Jailed:        v.BootedStatus != nil && *v.BootedStatus != "",
+	}
+	if blocks := v.Lifetime.Blocks; blocks.ToSign > 0 {
+		metrics.Uptime = float64(blocks.Signed) / float64(blocks.ToSign) * 100
+		metrics.MissedBlocks = blocks.ToSign - blocks.Signed
+	}
+	for _, d := range v.Info.Delegations {
+		if d.DelegatorAddress == v.Info.Address {
+			selfBond, _ := new(big.Float).SetFloat64(d.Amount).Int(nil)
+			metrics.SelfBond = selfBond.String()
+		}
 	}
+	return &metrics
 }
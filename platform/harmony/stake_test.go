@@ -98,6 +98,14 @@ func TestNormalizeValidator(t *testing.T) {
 			MinimumAmount: "1000",
 			Type:          blockatlas.DelegationTypeDelegate,
 		},
+		Metrics: &blockatlas.ValidatorMetrics{
+			Commission:    10,
+			HasCommission: true,
+			VotingPower:   "10999000000000000262144",
+			Uptime:        96,
+			MissedBlocks:  21,
+			SelfBond:      "10999000000000000262144",
+		},
 	}
 
 	var apr float64
@@ -255,3 +263,43 @@ func TestGeBalance(t *testing.T) {
 	result, _ := p.UndelegatedBalance("one1pdv9lrdwl0rg5vglh4xtyrv3wjk3wsqket7zxy")
 	assert.Equal(t, "256",result)
 }
+
+func TestNormalizeUndelegations(t *testing.T) {
+	validators := blockatlas.ValidatorMap{
+		"one1v8pukmelacy3xdap773rpg5pax3tmu40wmwr2j": {ID: "one1v8pukmelacy3xdap773rpg5pax3tmu40wmwr2j", Status: true},
+	}
+	delegations := []Delegation{
+		{
+			ValidatorAddress: "one1v8pukmelacy3xdap773rpg5pax3tmu40wmwr2j",
+			Amount:           1e22,
+			Undelegations:    []Undelegation{{Amount: 5e21, Epoch: 100}, {Amount: 1e21, Epoch: 90}},
+		},
+		{ValidatorAddress: "one1unknown", Undelegations: []Undelegation{{Amount: 1e21, Epoch: 100}}},
+	}
+	expected := []blockatlas.Delegation{
+		{
+			Delegator: validators["one1v8pukmelacy3xdap773rpg5pax3tmu40wmwr2j"],
+			Value:     "5000000000000000000000",
+			Status:    blockatlas.DelegationStatusPending,
+			Metadata:  blockatlas.DelegationMetaDataPending{AvailableDate: 1600000000 + 5*86400},
+		},
+		{
+			Delegator: validators["one1v8pukmelacy3xdap773rpg5pax3tmu40wmwr2j"],
+			Value:     "1000000000000000000000",
+			Status:    blockatlas.DelegationStatusPending,
+			Metadata:  blockatlas.DelegationMetaDataPending{AvailableDate: 1600000000},
+		},
+	}
+	assert.Equal(t, expected, NormalizeUndelegations(delegations, validators, 102, 1600000000))
+}
+
+func TestNormalizeEconomics(t *testing.T) {
+	validators := []Validator{
+		{Info: ValidatorInfo{Address: "one1a"}, Active: true, Lifetime: LifetimeInfo{Apr: "12.5"}},
+		{Info: ValidatorInfo{Address: "one1b"}, Active: true, Lifetime: LifetimeInfo{Apr: "9.25"}},
+		{Info: ValidatorInfo{Address: "one1c"}, Active: false, Lifetime: LifetimeInfo{Apr: "15"}},
+	}
+	result := NormalizeEconomics(validators)
+	assert.Equal(t, 12.5, result.Annual)
+	assert.Equal(t, map[string]float64{"one1a": 12.5, "one1b": 9.25, "one1c": 0}, result.Validators)
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/trustwallet/blockatlas/coin"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 	"github.com/trustwallet/blockatlas/pkg/errors"
+	"github.com/trustwallet/blockatlas/pkg/logger"
 	"github.com/trustwallet/blockatlas/pkg/numbers"
 	"strconv"
 )
@@ -15,7 +16,36 @@ func (p *Platform) GetTxsByAddress(address string) (blockatlas.TxPage, error) {
 	if err != nil {
 		return blockatlas.TxPage{}, err
 	}
-	return NormalizeTxs(result.Transactions), err
+	stakingTxs, err := p.client.GetStakingTxsOfAddress(address, 1)
+	if err != nil {
+		return blockatlas.TxPage{}, err
+	}
+	return append(NormalizeTxs(result.Transactions), p.normalizeStakingTxs(stakingTxs.Transactions)...), nil
+}
+
+// normalizeStakingTxs reads the collected rewards from the receipts, the other messages carry their amount
+func (p *Platform) normalizeStakingTxs(txs []StakingTransaction) blockatlas.TxPage {
+	results := make(blockatlas.TxPage, 0)
+	for _, srcTx := range txs {
+		value := "0"
+		if srcTx.Type == StakingTxTypeCollectRewards {
+			receipt, err := p.client.GetTransactionReceipt(srcTx.Hash)
+			if err != nil {
+				logger.Error(err, "Harmony: Failed to get the receipt of the collected rewards", logger.Params{"tx": srcTx.Hash})
+				continue
+			}
+			if reward, ok := NormalizeReward(srcTx, receipt); ok {
+				value = reward.Value
+			}
+		}
+		tx, err := NormalizeStakingTx(srcTx, value)
+		if err != nil {
+			logger.Error(err, logger.Params{"tx": srcTx.Hash})
+			continue
+		}
+		results = append(results, tx)
+	}
+	return results
 }
 
 func NormalizeTxs(txs []Transaction) blockatlas.TxPage {
@@ -84,3 +114,70 @@ func NormalizeTx(trx *Transaction) (tx blockatlas.Tx, b bool, err error) {
 		},
 	}, true, nil
 }
+
+// NormalizeStakingTx converts the delegations, the undelegations and the collected rewards,
+// rewards is the value of the messages without an amount
+func NormalizeStakingTx(trx StakingTransaction, rewards string) (blockatlas.Tx, error) {
+	var key blockatlas.KeyType
+	var title blockatlas.KeyTitle
+	var direction blockatlas.Direction
+	switch trx.Type {
+	case StakingTxTypeDelegate:
+		key, title, direction = blockatlas.KeyStakeDelegate, blockatlas.AnyActionDelegation, blockatlas.DirectionOutgoing
+	case StakingTxTypeUndelegate:
+		key, title, direction = blockatlas.KeyStakeUndelegate, blockatlas.AnyActionUndelegation, blockatlas.DirectionIncoming
+	case StakingTxTypeCollectRewards:
+		key, title, direction = blockatlas.KeyStakeClaimRewards, blockatlas.AnyActionClaimRewards, blockatlas.DirectionIncoming
+	default:
+		return blockatlas.Tx{}, errors.E("Harmony: unsupported staking transaction", errors.TypePlatformNormalize,
+			errors.Params{"type": trx.Type})
+	}
+	value := rewards
+	if trx.Msg.Amount != nil {
+		value = trx.Msg.Amount.String()
+	}
+
+	gasPrice, err := hexToInt(trx.GasPrice)
+	if err != nil {
+		return blockatlas.Tx{}, GetNormalizationError(err)
+	}
+	gas, err := hexToInt(trx.Gas)
+	if err != nil {
+		return blockatlas.Tx{}, GetNormalizationError(err)
+	}
+	block, err := hexToInt(trx.BlockNumber)
+	if err != nil {
+		return blockatlas.Tx{}, GetNormalizationError(err)
+	}
+	nonce, err := hexToInt(trx.Nonce)
+	if err != nil {
+		return blockatlas.Tx{}, GetNormalizationError(err)
+	}
+	timestamp, err := hexToInt(trx.Timestamp)
+	if err != nil {
+		return blockatlas.Tx{}, GetNormalizationError(err)
+	}
+
+	return blockatlas.Tx{
+		ID:        trx.Hash,
+		Coin:      coin.ONE,
+		From:      trx.Msg.DelegatorAddress,
+		To:        trx.Msg.ValidatorAddress,
+		Fee:       blockatlas.Amount(strconv.Itoa(int(gas * gasPrice))),
+		Status:    blockatlas.StatusCompleted,
+		Sequence:  nonce,
+		Date:      int64(timestamp),
+		Type:      blockatlas.TxAnyAction,
+		Block:     block,
+		Direction: direction,
+		Meta: blockatlas.AnyAction{
+			Coin:     coin.ONE,
+			Title:    title,
+			Key:      key,
+			Name:     coin.Coins[coin.ONE].Name,
+			Symbol:   coin.Coins[coin.ONE].Symbol,
+			Decimals: coin.Coins[coin.ONE].Decimals,
+			Value:    blockatlas.Amount(value),
+		},
+	}, nil
+}
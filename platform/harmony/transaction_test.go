@@ -3,6 +3,7 @@ package harmony
 import (
 	"bytes"
 	"encoding/json"
+	"github.com/stretchr/testify/assert"
 	"github.com/trustwallet/blockatlas/coin"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 	"testing"
@@ -76,3 +77,86 @@ func TestNormalize(t *testing.T) {
 		t.Error("tx don't equal")
 	}
 }
+
+const delegateSrc = `
+{
+	"blockNumber": "0x2a9b4c",
+	"from": "one1pdv9lrdwl0rg5vglh4xtyrv3wjk3wsqket7zxy",
+	"gas": "0x5208",
+	"gasPrice": "0x3b9aca00",
+	"hash": "0x1a0ea0d3e9b1a2ef6d1d35c0bfc3a3b8ad37fc4b6a0e2b0c1cd6f6a8b4f1e2d3",
+	"nonce": "0x3",
+	"timestamp": "0x5ED8E2A0",
+	"type": "Delegate",
+	"msg": {
+		"amount": 1000000000000000000000,
+		"delegatorAddress": "one1pdv9lrdwl0rg5vglh4xtyrv3wjk3wsqket7zxy",
+		"validatorAddress": "one1km7xg8e3xjys7azp9f4xp8hkw79vm2h3f2lade"
+	}
+}
+`
+
+const collectRewardsSrc = `
+{
+	"blockNumber": "0x2a9b4d",
+	"from": "one1pdv9lrdwl0rg5vglh4xtyrv3wjk3wsqket7zxy",
+	"gas": "0x5208",
+	"gasPrice": "0x3b9aca00",
+	"hash": "0x2b1fb1e4fac2b3f07e2e46d1c0d4b4c9be48fd5c7b1f3c1d2de7f7b9c5a2f3e4",
+	"nonce": "0x4",
+	"timestamp": "0x5ED8E2BE",
+	"type": "CollectRewards",
+	"msg": {
+		"delegatorAddress": "one1pdv9lrdwl0rg5vglh4xtyrv3wjk3wsqket7zxy"
+	}
+}
+`
+
+func TestNormalizeStakingTx(t *testing.T) {
+	var delegate, collect StakingTransaction
+	assert.NoError(t, json.Unmarshal([]byte(delegateSrc), &delegate))
+	assert.NoError(t, json.Unmarshal([]byte(collectRewardsSrc), &collect))
+
+	tx, err := NormalizeStakingTx(delegate, "0")
+	assert.NoError(t, err)
+	assert.Equal(t, blockatlas.Tx{
+		ID:        "0x1a0ea0d3e9b1a2ef6d1d35c0bfc3a3b8ad37fc4b6a0e2b0c1cd6f6a8b4f1e2d3",
+		Coin:      coin.ONE,
+		From:      "one1pdv9lrdwl0rg5vglh4xtyrv3wjk3wsqket7zxy",
+		To:        "one1km7xg8e3xjys7azp9f4xp8hkw79vm2h3f2lade",
+		Fee:       "21000000000000",
+		Date:      1591272096,
+		Block:     2792268,
+		Sequence:  3,
+		Status:    blockatlas.StatusCompleted,
+		Type:      blockatlas.TxAnyAction,
+		Direction: blockatlas.DirectionOutgoing,
+		Meta: blockatlas.AnyAction{
+			Coin:     coin.ONE,
+			Title:    blockatlas.AnyActionDelegation,
+			Key:      blockatlas.KeyStakeDelegate,
+			Name:     "Harmony",
+			Symbol:   "ONE",
+			Decimals: 18,
+			Value:    "1000000000000000000000",
+		},
+	}, tx)
+
+	tx, err = NormalizeStakingTx(collect, "4531000000000000000")
+	assert.NoError(t, err)
+	assert.Equal(t, "", tx.To)
+	assert.Equal(t, blockatlas.DirectionIncoming, tx.Direction)
+	assert.Equal(t, blockatlas.AnyAction{
+		Coin:     coin.ONE,
+		Title:    blockatlas.AnyActionClaimRewards,
+		Key:      blockatlas.KeyStakeClaimRewards,
+		Name:     "Harmony",
+		Symbol:   "ONE",
+		Decimals: 18,
+		Value:    "4531000000000000000",
+	}, tx.Meta)
+
+	delegate.Type = "CreateValidator"
+	_, err = NormalizeStakingTx(delegate, "0")
+	assert.Error(t, err)
+}
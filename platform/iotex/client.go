@@ -72,13 +72,13 @@ func (c *Client) GetValidators() (blockatlas.ValidatorPage, error) {
 	return validators, nil
 }
 
-func (c *Client) GetDelegations(address string) (blockatlas.DelegationsPage, error) {
-	var delegations blockatlas.DelegationsPage
-	err := c.Get(&delegations, "staking/delegations/"+address, nil)
+func (c *Client) GetBuckets(address string) ([]Bucket, error) {
+	var response BucketsResponse
+	err := c.Get(&response, "staking/buckets/"+address, nil)
 	if err != nil {
 		return nil, err
 	}
-	return delegations, nil
+	return response.Buckets, nil
 }
 
 func (c *Client) GetAccount(address string) (*AccountInfo, error) {
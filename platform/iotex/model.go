@@ -1,6 +1,8 @@
 package iotex
 
 import (
+	"time"
+
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 )
 
@@ -34,8 +36,13 @@ type Action struct {
 }
 
 type ActionCore struct {
-	Nonce    string    `json:"nonce"`
-	Transfer *Transfer `json:"transfer"`
+	Nonce                string                `json:"nonce"`
+	Transfer             *Transfer             `json:"transfer"`
+	StakeCreate          *StakeCreate          `json:"stakeCreate"`
+	StakeUnstake         *StakeBucket          `json:"stakeUnstake"`
+	StakeWithdraw        *StakeBucket          `json:"stakeWithdraw"`
+	StakeAddDeposit      *StakeAddDeposit      `json:"stakeAddDeposit"`
+	StakeChangeCandidate *StakeChangeCandidate `json:"stakeChangeCandidate"`
 }
 
 type Transfer struct {
@@ -43,6 +50,55 @@ type Transfer struct {
 	Recipient string            `json:"recipient"`
 }
 
+type StakeCreate struct {
+	CandidateName  string            `json:"candidateName"`
+	StakedAmount   blockatlas.Amount `json:"stakedAmount"`
+	StakedDuration uint32            `json:"stakedDuration"`
+	AutoStake      bool              `json:"autoStake"`
+}
+
+// StakeBucket is the payload of the actions referring to a bucket only, the amount is the one of the bucket
+type StakeBucket struct {
+	BucketIndex string `json:"bucketIndex"`
+}
+
+type StakeAddDeposit struct {
+	BucketIndex string            `json:"bucketIndex"`
+	Amount      blockatlas.Amount `json:"amount"`
+}
+
+type StakeChangeCandidate struct {
+	CandidateName string `json:"candidateName"`
+	BucketIndex   string `json:"bucketIndex"`
+}
+
 type ChainMeta struct {
 	Height string `json:"height"`
 }
+
+type BucketsResponse struct {
+	Buckets []Bucket `json:"buckets"`
+}
+
+// Bucket is a native staking bucket, StakedAmount votes for the candidate and is locked for StakedDuration days
+// from StakeStartTime. UnstakeStartTime is unset until the owner unstakes the bucket
+type Bucket struct {
+	Index            string    `json:"index"`
+	CandidateAddress string    `json:"candidateAddress"`
+	StakedAmount     string    `json:"stakedAmount"`
+	StakedDuration   uint32    `json:"stakedDuration"`
+	CreateTime       time.Time `json:"createTime"`
+	StakeStartTime   time.Time `json:"stakeStartTime"`
+	UnstakeStartTime time.Time `json:"unstakeStartTime"`
+	AutoStake        bool      `json:"autoStake"`
+	Owner            string    `json:"owner"`
+}
+
+// BucketMetadata is the metadata of the active buckets. An auto staked bucket keeps its duration and stays
+// locked until auto staking is disabled, the others can be unstaked after LockedUntil
+type BucketMetadata struct {
+	Index       string `json:"index"`
+	AutoStake   bool   `json:"auto_stake"`
+	Duration    uint32 `json:"duration"`
+	LockedUntil uint   `json:"locked_until,omitempty"`
+}
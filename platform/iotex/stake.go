@@ -1,10 +1,18 @@
 package iotex
 
 import (
+	"time"
+
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/logger"
 	"github.com/trustwallet/blockatlas/services/assets"
 )
 
+const (
+	lockTime      = 259200 // in seconds (3 days of withdraw waiting period)
+	minimumAmount = "100000000000000000000"
+)
+
 func (p *Platform) GetActiveValidators() (blockatlas.StakeValidators, error) {
 	validators, err := assets.GetValidatorsMap(p)
 	if err != nil {
@@ -22,14 +30,69 @@ func (p *Platform) GetValidators() (blockatlas.ValidatorPage, error) {
 }
 
 func (p *Platform) GetDelegations(address string) (blockatlas.DelegationsPage, error) {
-	return p.client.GetDelegations(address)
+	buckets, err := p.client.GetBuckets(address)
+	if err != nil {
+		return nil, err
+	}
+	if len(buckets) == 0 {
+		return make(blockatlas.DelegationsPage, 0), nil
+	}
+	validators, err := assets.GetValidatorsMap(p)
+	if err != nil {
+		return nil, err
+	}
+	return p.NormalizeDelegations(buckets, validators), nil
+}
+
+// NormalizeDelegations reports the buckets voting for a candidate as active, the unstaked buckets are pending
+// until the end of the withdraw waiting period
+func (p *Platform) NormalizeDelegations(buckets []Bucket, validators blockatlas.ValidatorMap) blockatlas.DelegationsPage {
+	results := make(blockatlas.DelegationsPage, 0, len(buckets))
+	for _, b := range buckets {
+		validator, ok := validators[b.CandidateAddress]
+		if !ok {
+			logger.Warn("Validator not found", logger.Params{"address": b.CandidateAddress, "platform": "iotex", "bucket": b.Index})
+			validator = p.getUnknownValidator(b.CandidateAddress)
+		}
+		delegation := blockatlas.Delegation{
+			Delegator: validator,
+			Value:     b.StakedAmount,
+			Status:    blockatlas.DelegationStatusActive,
+		}
+		if b.UnstakeStartTime.Unix() > 0 {
+			delegation.Status = blockatlas.DelegationStatusPending
+			delegation.Metadata = blockatlas.DelegationMetaDataPending{
+				AvailableDate: uint(b.UnstakeStartTime.Unix() + lockTime),
+			}
+		} else {
+			metadata := BucketMetadata{Index: b.Index, AutoStake: b.AutoStake, Duration: b.StakedDuration}
+			if !b.AutoStake {
+				metadata.LockedUntil = uint(b.StakeStartTime.Add(time.Duration(b.StakedDuration) * 24 * time.Hour).Unix())
+			}
+			delegation.Metadata = metadata
+		}
+		results = append(results, delegation)
+	}
+	return results
+}
+
+func (p *Platform) getUnknownValidator(address string) blockatlas.StakeValidator {
+	return blockatlas.StakeValidator{
+		ID:     address,
+		Status: false,
+		Info: blockatlas.StakeValidatorInfo{
+			Name:        "Decommissioned",
+			Description: "Decommissioned",
+		},
+		Details: p.GetDetails(),
+	}
 }
 
 func (p *Platform) GetDetails() blockatlas.StakingDetails {
 	return blockatlas.StakingDetails{
 		Reward:        blockatlas.StakingReward{Annual: 0},
-		MinimumAmount: blockatlas.Amount("100000000000000000000"),
-		LockTime:      259200,
+		MinimumAmount: minimumAmount,
+		LockTime:      lockTime,
 		Type:          blockatlas.DelegationTypeDelegate,
 	}
 }
@@ -0,0 +1,44 @@
+package iotex
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+)
+
+const bucketsResponse = `{"buckets":[
+	{"index":"1021","candidateAddress":"io1zy9q4myp3jezxdsv82z3f865ruamhqm7a6mggh","stakedAmount":"1200000000000000000000","stakedDuration":91,"createTime":"2020-06-01T10:00:00Z","stakeStartTime":"2020-06-01T10:00:00Z","unstakeStartTime":"1970-01-01T00:00:00Z","autoStake":true,"owner":"io1mflp9m6hcgm2qcghchsdqj3z3eccrnekx9p0ms"},
+	{"index":"1022","candidateAddress":"io1zy9q4myp3jezxdsv82z3f865ruamhqm7a6mggh","stakedAmount":"300000000000000000000","stakedDuration":14,"createTime":"2020-06-20T10:00:00Z","stakeStartTime":"2020-06-20T10:00:00Z","unstakeStartTime":"1970-01-01T00:00:00Z","autoStake":false,"owner":"io1mflp9m6hcgm2qcghchsdqj3z3eccrnekx9p0ms"},
+	{"index":"1023","candidateAddress":"io1jzteq7gc4sr9p9s7qtxs7pcnp2k5msy4ttzfam","stakedAmount":"100000000000000000000","stakedDuration":0,"createTime":"2020-05-01T10:00:00Z","stakeStartTime":"2020-05-01T10:00:00Z","unstakeStartTime":"2020-06-28T19:00:00Z","autoStake":false,"owner":"io1mflp9m6hcgm2qcghchsdqj3z3eccrnekx9p0ms"}
+]}`
+
+func TestPlatform_NormalizeDelegations(t *testing.T) {
+	var response BucketsResponse
+	assert.NoError(t, json.Unmarshal([]byte(bucketsResponse), &response))
+	p := Platform{}
+	validator := blockatlas.StakeValidator{ID: "io1zy9q4myp3jezxdsv82z3f865ruamhqm7a6mggh", Status: true}
+
+	result := p.NormalizeDelegations(response.Buckets, blockatlas.ValidatorMap{validator.ID: validator})
+	assert.Equal(t, blockatlas.DelegationsPage{
+		{
+			Delegator: validator,
+			Value:     "1200000000000000000000",
+			Status:    blockatlas.DelegationStatusActive,
+			Metadata:  BucketMetadata{Index: "1021", AutoStake: true, Duration: 91},
+		},
+		{
+			Delegator: validator,
+			Value:     "300000000000000000000",
+			Status:    blockatlas.DelegationStatusActive,
+			Metadata:  BucketMetadata{Index: "1022", Duration: 14, LockedUntil: 1593856800},
+		},
+		{
+			Delegator: p.getUnknownValidator("io1jzteq7gc4sr9p9s7qtxs7pcnp2k5msy4ttzfam"),
+			Value:     "100000000000000000000",
+			Status:    blockatlas.DelegationStatusPending,
+			Metadata:  blockatlas.DelegationMetaDataPending{AvailableDate: 1593630000},
+		},
+	}, result)
+}
@@ -36,7 +36,7 @@ func (p *Platform) GetTxsByAddress(address string) (blockatlas.TxPage, error) {
 	return txs, nil
 }
 
-// Normalize converts an Iotex transaction into the generic model
+// Normalize converts an Iotex transaction into the generic model, the native staking actions are any actions
 func Normalize(trx *ActionInfo) *blockatlas.Tx {
 	if trx.Action == nil {
 		return nil
@@ -44,9 +44,6 @@ func Normalize(trx *ActionInfo) *blockatlas.Tx {
 	if trx.Action.Core == nil {
 		return nil
 	}
-	if trx.Action.Core.Transfer == nil {
-		return nil
-	}
 
 	date, err := time.Parse(time.RFC3339, trx.Timestamp)
 	if err != nil {
@@ -64,21 +61,61 @@ func Normalize(trx *ActionInfo) *blockatlas.Tx {
 		return nil
 	}
 
-	return &blockatlas.Tx{
+	tx := blockatlas.Tx{
 		ID:       trx.ActHash,
 		Coin:     coin.IOTX,
 		From:     trx.Sender,
-		To:       trx.Action.Core.Transfer.Recipient,
 		Fee:      blockatlas.Amount(trx.GasFee),
 		Date:     date.Unix(),
 		Block:    uint64(height),
 		Status:   blockatlas.StatusCompleted,
 		Sequence: uint64(nonce),
-		Type:     blockatlas.TxTransfer,
-		Meta: blockatlas.Transfer{
-			Value:    trx.Action.Core.Transfer.Amount,
+	}
+	if !fillMeta(&tx, trx.Action.Core) {
+		return nil
+	}
+	return &tx
+}
+
+func fillMeta(tx *blockatlas.Tx, core *ActionCore) bool {
+	switch {
+	case core.Transfer != nil:
+		tx.To = core.Transfer.Recipient
+		tx.Type = blockatlas.TxTransfer
+		tx.Meta = blockatlas.Transfer{
+			Value:    core.Transfer.Amount,
 			Symbol:   coin.Coins[coin.IOTX].Symbol,
 			Decimals: coin.Coins[coin.IOTX].Decimals,
-		},
+		}
+	case core.StakeCreate != nil:
+		tx.To = core.StakeCreate.CandidateName
+		fillStake(tx, blockatlas.KeyStakeDelegate, blockatlas.AnyActionDelegation, blockatlas.DirectionOutgoing, core.StakeCreate.StakedAmount)
+	case core.StakeAddDeposit != nil:
+		fillStake(tx, blockatlas.KeyStakeDelegate, blockatlas.AnyActionDelegation, blockatlas.DirectionOutgoing, core.StakeAddDeposit.Amount)
+	case core.StakeChangeCandidate != nil:
+		tx.To = core.StakeChangeCandidate.CandidateName
+		fillStake(tx, blockatlas.KeyStakeRedelegate, blockatlas.AnyActionRedelegation, blockatlas.DirectionSelf, "0")
+	case core.StakeUnstake != nil:
+		fillStake(tx, blockatlas.KeyStakeUndelegate, blockatlas.AnyActionUndelegation, blockatlas.DirectionIncoming, "0")
+	case core.StakeWithdraw != nil:
+		fillStake(tx, blockatlas.KeyStakeWithdraw, blockatlas.AnyActionWithdraw, blockatlas.DirectionIncoming, "0")
+	default:
+		return false
+	}
+	return true
+}
+
+// fillStake sets a staking action, the actions referring to a bucket don't carry its amount
+func fillStake(tx *blockatlas.Tx, key blockatlas.KeyType, title blockatlas.KeyTitle, direction blockatlas.Direction, value blockatlas.Amount) {
+	tx.Type = blockatlas.TxAnyAction
+	tx.Direction = direction
+	tx.Meta = blockatlas.AnyAction{
+		Coin:     coin.IOTX,
+		Title:    title,
+		Key:      key,
+		Name:     coin.Coins[coin.IOTX].Name,
+		Symbol:   coin.Coins[coin.IOTX].Symbol,
+		Decimals: coin.Coins[coin.IOTX].Decimals,
+		Value:    value,
 	}
 }
@@ -129,3 +129,74 @@ func TestNormalize(t *testing.T) {
 		a.Equal(expected[i], tx)
 	}
 }
+
+const stakeActions = `
+{
+  "actionInfo":
+  [
+    {
+      "action": {"core": {"version": 1, "nonce": "12", "gasLimit": "10000", "gasPrice": "1000000000000",
+        "stakeCreate": {"candidateName": "iotexlab", "stakedAmount": "1200000000000000000000", "stakedDuration": 91, "autoStake": true}}},
+      "actHash": "6bd5e3f8e8fb3c81a8bc3f5cd3e1a1bd1e6dc0d8c2d3c7e8bb5a4dfc7d7e4a11",
+      "blkHeight": "5312096",
+      "sender": "io1mflp9m6hcgm2qcghchsdqj3z3eccrnekx9p0ms",
+      "gasFee": "10000000000000000",
+      "timestamp": "2020-06-01T10:00:00Z"
+    },
+    {
+      "action": {"core": {"version": 1, "nonce": "13", "gasLimit": "10000", "gasPrice": "1000000000000",
+        "stakeUnstake": {"bucketIndex": "1021"}}},
+      "actHash": "7ce6f4f9f9fc4d92b9cd4f6de4f2b2ce2f7ed1e9d3e4d8f9cc6b5efd8e8f5b22",
+      "blkHeight": "5812096",
+      "sender": "io1mflp9m6hcgm2qcghchsdqj3z3eccrnekx9p0ms",
+      "gasFee": "10000000000000000",
+      "timestamp": "2020-07-01T10:00:00Z"
+    },
+    {
+      "action": {"core": {"version": 1, "nonce": "14", "gasLimit": "10000", "gasPrice": "1000000000000",
+        "stakeRestake": {"bucketIndex": "1021", "stakedDuration": 14}}},
+      "actHash": "8df7a5fafafd5ea3cade5a7ef5a3c3df3a8fe2fae4f5e9fadd7c6fafe9f9ac33",
+      "blkHeight": "5812196",
+      "sender": "io1mflp9m6hcgm2qcghchsdqj3z3eccrnekx9p0ms",
+      "gasFee": "10000000000000000",
+      "timestamp": "2020-07-01T11:00:00Z"
+    }
+  ]
+}
+`
+
+func TestNormalize_Stake(t *testing.T) {
+	var act Response
+	assert.NoError(t, json.Unmarshal([]byte(stakeActions), &act))
+
+	assert.Equal(t, &blockatlas.Tx{
+		ID:        "6bd5e3f8e8fb3c81a8bc3f5cd3e1a1bd1e6dc0d8c2d3c7e8bb5a4dfc7d7e4a11",
+		Coin:      coin.IOTX,
+		From:      "io1mflp9m6hcgm2qcghchsdqj3z3eccrnekx9p0ms",
+		To:        "iotexlab",
+		Fee:       "10000000000000000",
+		Date:      1591005600,
+		Block:     5312096,
+		Status:    blockatlas.StatusCompleted,
+		Sequence:  12,
+		Type:      blockatlas.TxAnyAction,
+		Direction: blockatlas.DirectionOutgoing,
+		Meta: blockatlas.AnyAction{
+			Coin:     coin.IOTX,
+			Title:    blockatlas.AnyActionDelegation,
+			Key:      blockatlas.KeyStakeDelegate,
+			Name:     "IoTeX",
+			Symbol:   "IOTX",
+			Decimals: 18,
+			Value:    "1200000000000000000000",
+		},
+	}, Normalize(act.ActionInfo[0]))
+
+	unstake := Normalize(act.ActionInfo[1])
+	assert.NotNil(t, unstake)
+	assert.Equal(t, blockatlas.DirectionIncoming, unstake.Direction)
+	assert.Equal(t, blockatlas.KeyStakeUndelegate, unstake.Meta.(blockatlas.AnyAction).Key)
+	assert.Equal(t, blockatlas.Amount("0"), unstake.Meta.(blockatlas.AnyAction).Value)
+
+	assert.Nil(t, Normalize(act.ActionInfo[2]))
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 	"github.com/trustwallet/blockatlas/pkg/errors"
 	"net/url"
+	"time"
 )
 
 type Client struct {
@@ -20,6 +21,23 @@ func (c *Client) GetBalances(address string) (balances BalancesResult, err error
 	return
 }
 
+func (c *Client) GetNodes() (nodes NodesResult, err error) {
+	err = c.GetWithCache(&nodes, "v2/nodes/current-stakes", nil, time.Minute*10)
+	if err != nil || nodes.Msg != MsgSuccess {
+		return nodes, errors.E(err, "explorer client GetNodes", errors.Params{"platform": "ONT"})
+	}
+	return
+}
+
+func (c *Client) GetAuthorizations(address string) (authorizations AuthorizationsResult, err error) {
+	path := fmt.Sprintf("v2/addresses/%s/authorizations", address)
+	err = c.Get(&authorizations, path, nil)
+	if err != nil || authorizations.Msg != MsgSuccess {
+		return authorizations, errors.E(err, "explorer client GetAuthorizations", errors.Params{"platform": "ONT"})
+	}
+	return
+}
+
 func (c *Client) GetTxsOfAddress(address string) (txPage TxsResult, err error) {
 	query := url.Values{"page_size": {"20"}, "page_number": {"1"}}
 	path := fmt.Sprintf("v2/addresses/%s/transactions", address)
@@ -46,6 +46,16 @@ type BalancesResult struct {
 	Result Balances `json:"result"`
 }
 
+type NodesResult struct {
+	BaseResponse
+	Result []Node `json:"result"`
+}
+
+type AuthorizationsResult struct {
+	BaseResponse
+	Result []Authorization `json:"result"`
+}
+
 type BlockRecords struct {
 	Total   int64   `json:"total"`
 	Records []Block `json:"records"`
@@ -161,3 +171,32 @@ func (bs Balances) getBalance(assetType AssetType) *Balance {
 	}
 	return nil
 }
+
+const (
+	NodeStatusCandidate = 1
+	NodeStatusConsensus = 2
+)
+
+// Node is a node of the governance, the consensus nodes produce the blocks of the round and the candidates stand by
+type Node struct {
+	PublicKey    string `json:"public_key"`
+	Name         string `json:"name"`
+	Address      string `json:"address"`
+	CurrentStake int64  `json:"current_stake"`
+	InitPos      int64  `json:"init_pos"`
+	Status       int    `json:"status"`
+}
+
+// Authorization holds the positions of an address on a node, in ONT. The authorized positions are staked
+// (ConsensusPos and FreezePos) or staked from the next round (NewPos), the withdrawn ones are locked until the
+// end of the round (WithdrawPos and WithdrawFreezePos) then can be redeemed (WithdrawUnfreezePos)
+type Authorization struct {
+	PeerPubkey          string `json:"peer_pubkey"`
+	Address             string `json:"address"`
+	ConsensusPos        int64  `json:"consensus_pos"`
+	FreezePos           int64  `json:"freeze_pos"`
+	NewPos              int64  `json:"new_pos"`
+	WithdrawPos         int64  `json:"withdraw_pos"`
+	WithdrawFreezePos   int64  `json:"withdraw_freeze_pos"`
+	WithdrawUnfreezePos int64  `json:"withdraw_unfreeze_pos"`
+}
@@ -1,14 +1,22 @@
 package ontology
 
 import (
+	"strconv"
+	"time"
+
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 	"github.com/trustwallet/blockatlas/pkg/errors"
+	"github.com/trustwallet/blockatlas/pkg/logger"
 	"github.com/trustwallet/blockatlas/services/assets"
 )
 
 const (
 	// The current value comes from https://cryptoslate.com/coins/ontology
 	Annual = 4.45
+
+	// roundBlocks is the length of a consensus round, the withdrawn positions unlock when it ends
+	roundBlocks   = 120000
+	minimumAmount = "1"
 )
 
 func (p *Platform) GetActiveValidators() (blockatlas.StakeValidators, error) {
@@ -26,12 +34,17 @@ func (p *Platform) GetActiveValidators() (blockatlas.StakeValidators, error) {
 func (p *Platform) GetDetails() blockatlas.StakingDetails {
 	return blockatlas.StakingDetails{
 		Reward:        blockatlas.StakingReward{Annual: Annual},
-		MinimumAmount: "0",
-		LockTime:      0,
-		Type:          blockatlas.DelegationTypeAuto,
+		MinimumAmount: minimumAmount,
+		LockTime:      p.lockTime(),
+		Type:          blockatlas.DelegationTypeDelegate,
 	}
 }
 
+// lockTime is the duration of a round, the longest wait to redeem a withdrawn position
+func (p *Platform) lockTime() int {
+	return int(roundBlocks * p.Coin().BlockTime / 1000)
+}
+
 func (p *Platform) UndelegatedBalance(address string) (string, error) {
 	acc, err := p.client.GetBalances(address)
 	if err != nil {
@@ -45,9 +58,100 @@ func (p *Platform) UndelegatedBalance(address string) (string, error) {
 }
 
 func (p *Platform) GetValidators() (blockatlas.ValidatorPage, error) {
-	return blockatlas.ValidatorPage{}, nil
+	nodes, err := p.client.GetNodes()
+	if err != nil {
+		return nil, err
+	}
+	results := make(blockatlas.ValidatorPage, 0, len(nodes.Result))
+	for _, node := range nodes.Result {
+		results = append(results, p.normalizeValidator(node))
+	}
+	results.SetStakeShares()
+	return results, nil
+}
+
+func (p *Platform) normalizeValidator(node Node) blockatlas.Validator {
+	return blockatlas.Validator{
+		ID:      node.PublicKey,
+		Status:  node.Status == NodeStatusConsensus || node.Status == NodeStatusCandidate,
+		Details: p.GetDetails(),
+		Metrics: &blockatlas.ValidatorMetrics{
+			VotingPower: strconv.FormatInt(node.CurrentStake, 10),
+			SelfBond:    strconv.FormatInt(node.InitPos, 10),
+		},
+	}
 }
 
 func (p *Platform) GetDelegations(address string) (blockatlas.DelegationsPage, error) {
-	return blockatlas.DelegationsPage{}, nil
+	authorizations, err := p.client.GetAuthorizations(address)
+	if err != nil {
+		return nil, err
+	}
+	if len(authorizations.Result) == 0 {
+		return make(blockatlas.DelegationsPage, 0), nil
+	}
+	height, err := p.CurrentBlockNumber()
+	if err != nil {
+		return nil, err
+	}
+	validators, err := assets.GetValidatorsMap(p)
+	if err != nil {
+		return nil, err
+	}
+	return p.NormalizeDelegations(authorizations.Result, validators, p.roundEnd(height, time.Now().Unix()), time.Now().Unix()), nil
+}
+
+// NormalizeDelegations reports the authorized positions of every node as active, the withdrawn ones are pending
+// until roundEnd and the unlocked ones can be redeemed now
+func (p *Platform) NormalizeDelegations(authorizations []Authorization, validators blockatlas.ValidatorMap, roundEnd, now int64) blockatlas.DelegationsPage {
+	results := make(blockatlas.DelegationsPage, 0)
+	for _, a := range authorizations {
+		validator, ok := validators[a.PeerPubkey]
+		if !ok {
+			logger.Warn("Validator not found", logger.Params{"address": a.PeerPubkey, "platform": "ontology", "delegation": a.Address})
+			validator = p.getUnknownValidator(a.PeerPubkey)
+		}
+		if active := a.ConsensusPos + a.FreezePos + a.NewPos; active > 0 {
+			results = append(results, blockatlas.Delegation{
+				Delegator: validator,
+				Value:     strconv.FormatInt(active, 10),
+				Status:    blockatlas.DelegationStatusActive,
+			})
+		}
+		if withdrawn := a.WithdrawPos + a.WithdrawFreezePos; withdrawn > 0 {
+			results = append(results, blockatlas.Delegation{
+				Delegator: validator,
+				Value:     strconv.FormatInt(withdrawn, 10),
+				Status:    blockatlas.DelegationStatusPending,
+				Metadata:  blockatlas.DelegationMetaDataPending{AvailableDate: uint(roundEnd)},
+			})
+		}
+		if a.WithdrawUnfreezePos > 0 {
+			results = append(results, blockatlas.Delegation{
+				Delegator: validator,
+				Value:     strconv.FormatInt(a.WithdrawUnfreezePos, 10),
+				Status:    blockatlas.DelegationStatusPending,
+				Metadata:  blockatlas.DelegationMetaDataPending{AvailableDate: uint(now)},
+			})
+		}
+	}
+	return results
+}
+
+// roundEnd estimates the end of the current round from the block time
+func (p *Platform) roundEnd(height, now int64) int64 {
+	remaining := roundBlocks - height%roundBlocks
+	return now + remaining*int64(p.Coin().BlockTime)/1000
+}
+
+func (p *Platform) getUnknownValidator(address string) blockatlas.StakeValidator {
+	return blockatlas.StakeValidator{
+		ID:     address,
+		Status: false,
+		Info: blockatlas.StakeValidatorInfo{
+			Name:        "Decommissioned",
+			Description: "Decommissioned",
+		},
+		Details: p.GetDetails(),
+	}
 }
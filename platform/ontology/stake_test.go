@@ -0,0 +1,75 @@
+package ontology
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+)
+
+const (
+	nodesSrc = `{"code":0,"msg":"SUCCESS","result":[
+		{"public_key":"02bcdd278a27e4969d48de95d6b7b086b65b8d1d4ff6509e7a9eab364a76115af7","name":"Dubhe","address":"AGEdeZu965DFFFwsAWcThgL6uduf1TqdhG","current_stake":7500000,"init_pos":500000,"status":2},
+		{"public_key":"0251f06bc247b1da94ec7d9fe25f5f913cedaecba8524140353b826cf9b1cbd9f4","name":"Merak","address":"AXJemqdRSUDPRNtrcuuWnAvuuPKdgNLdaj","current_stake":2500000,"init_pos":250000,"status":3}]}`
+	authorizationsSrc = `{"code":0,"msg":"SUCCESS","result":[
+		{"peer_pubkey":"02bcdd278a27e4969d48de95d6b7b086b65b8d1d4ff6509e7a9eab364a76115af7","address":"ARFXGXSmgFT2h9EiS4D5fen127Lzi48Eij","consensus_pos":1000,"freeze_pos":200,"new_pos":50,"withdraw_pos":0,"withdraw_freeze_pos":300,"withdraw_unfreeze_pos":25},
+		{"peer_pubkey":"0251f06bc247b1da94ec7d9fe25f5f913cedaecba8524140353b826cf9b1cbd9f4","address":"ARFXGXSmgFT2h9EiS4D5fen127Lzi48Eij","consensus_pos":0,"freeze_pos":0,"new_pos":0,"withdraw_pos":40,"withdraw_freeze_pos":0,"withdraw_unfreeze_pos":0}]}`
+)
+
+func TestPlatform_normalizeValidator(t *testing.T) {
+	var nodes NodesResult
+	assert.NoError(t, json.Unmarshal([]byte(nodesSrc), &nodes))
+	p := Platform{}
+
+	results := make(blockatlas.ValidatorPage, 0)
+	for _, node := range nodes.Result {
+		results = append(results, p.normalizeValidator(node))
+	}
+	results.SetStakeShares()
+
+	assert.Equal(t, blockatlas.Validator{
+		ID:     "02bcdd278a27e4969d48de95d6b7b086b65b8d1d4ff6509e7a9eab364a76115af7",
+		Status: true,
+		Details: blockatlas.StakingDetails{
+			Reward:        blockatlas.StakingReward{Annual: Annual},
+			MinimumAmount: minimumAmount,
+			LockTime:      1200000,
+			Type:          blockatlas.DelegationTypeDelegate,
+		},
+		Metrics: &blockatlas.ValidatorMetrics{VotingPower: "7500000", StakeShare: 75, SelfBond: "500000"},
+	}, results[0])
+	assert.False(t, results[1].Status)
+}
+
+func TestPlatform_NormalizeDelegations(t *testing.T) {
+	var authorizations AuthorizationsResult
+	assert.NoError(t, json.Unmarshal([]byte(authorizationsSrc), &authorizations))
+	p := Platform{}
+	validator := blockatlas.StakeValidator{ID: "02bcdd278a27e4969d48de95d6b7b086b65b8d1d4ff6509e7a9eab364a76115af7", Status: true}
+
+	roundEnd := p.roundEnd(8460000, 1593000000)
+	assert.Equal(t, int64(1593600000), roundEnd)
+
+	assert.Equal(t, blockatlas.DelegationsPage{
+		{Delegator: validator, Value: "1250", Status: blockatlas.DelegationStatusActive},
+		{
+			Delegator: validator,
+			Value:     "300",
+			Status:    blockatlas.DelegationStatusPending,
+			Metadata:  blockatlas.DelegationMetaDataPending{AvailableDate: 1593600000},
+		},
+		{
+			Delegator: validator,
+			Value:     "25",
+			Status:    blockatlas.DelegationStatusPending,
+			Metadata:  blockatlas.DelegationMetaDataPending{AvailableDate: 1593000000},
+		},
+		{
+			Delegator: p.getUnknownValidator("0251f06bc247b1da94ec7d9fe25f5f913cedaecba8524140353b826cf9b1cbd9f4"),
+			Value:     "40",
+			Status:    blockatlas.DelegationStatusPending,
+			Metadata:  blockatlas.DelegationMetaDataPending{AvailableDate: 1593600000},
+		},
+	}, p.NormalizeDelegations(authorizations.Result, blockatlas.ValidatorMap{validator.ID: validator}, roundEnd, 1593000000))
+}
@@ -77,7 +77,7 @@ func getAllHandlers() blockatlas.Platforms {
 		coin.Aeternity().Handle:    aeternity.Init(GetApiVar(coin.AE)),
 		coin.Solana().Handle:       solana.Init(GetApiVar(coin.SOL)),
 		coin.Tezos().Handle:        tezos.Init(GetApiVar(coin.XTZ), GetRpcVar(coin.XTZ)),
-		coin.Binance().Handle:      binance.Init(GetApiVar(coin.BNB)),
+		coin.Binance().Handle:      binance.Init(GetApiVar(coin.BNB), GetVar("binance.staking")),
 		coin.Zilliqa().Handle:      zilliqa.Init(GetApiVar(coin.ZIL), GetVar("zilliqa.key"), GetRpcVar(coin.ZIL), GetVar("zilliqa.lookup")),
 		coin.Kusama().Handle:       polkadot.Init(coin.KSM, GetApiVar(coin.KSM)),
 		coin.Polkadot().Handle:     polkadot.Init(coin.DOT, GetApiVar(coin.DOT)),
@@ -106,15 +106,24 @@ func getAllHandlers() blockatlas.Platforms {
 		coin.Callisto().Handle:     ethereum.Init(coin.CLO, GetApiVar(coin.CLO), GetRpcVar(coin.CLO)),
 		coin.Wanchain().Handle:     ethereum.Init(coin.WAN, GetApiVar(coin.WAN), GetRpcVar(coin.WAN)),
 		coin.Tomochain().Handle:    ethereum.Init(coin.TOMO, GetApiVar(coin.TOMO), GetRpcVar(coin.TOMO)),
-		coin.Ethereum().Handle:     ethereum.InitWitCollection(coin.ETH, GetApiVar(coin.ETH), GetRpcVar(coin.ETH), GetVar("ethereum.blockbook_api"), GetVar("ethereum.collections_api"), GetVar("ethereum.collections_api_key")),
+		coin.Ethereum().Handle:     ethereum.InitWitCollection(coin.ETH, GetApiVar(coin.ETH), GetRpcVar(coin.ETH), GetVar("ethereum.blockbook_api"), GetVar("ethereum.collections_api"), GetVar("ethereum.collections_api_key"), GetVar("collectibles.ipfs_gateway")),
 		coin.Near().Handle:         near.Init(GetApiVar(coin.NEAR)),
 		coin.Elrond().Handle:       elrond.Init(coin.ERD, GetApiVar(coin.ERD)),
 	}
 }
 
 func getCollectionsHandlers() blockatlas.CollectionsAPIs {
+	gateway := GetVar("collectibles.ipfs_gateway")
 	return blockatlas.CollectionsAPIs{
-		coin.ETH: ethereum.InitWitCollection(coin.ETH, GetApiVar(coin.ETH), GetRpcVar(coin.ETH), GetVar("ethereum.blockbook_api"), GetVar("ethereum.collections_api"), GetVar("ethereum.collections_api_key")),
+		coin.ETH:  ethereum.InitWitCollection(coin.ETH, GetApiVar(coin.ETH), GetRpcVar(coin.ETH), GetVar("ethereum.blockbook_api"), GetVar("ethereum.collections_api"), GetVar("ethereum.collections_api_key"), gateway),
+		coin.ETC:  ethereum.InitWithNativeCollection(coin.ETC, GetApiVar(coin.ETC), GetRpcVar(coin.ETC), gateway),
+		coin.POA:  ethereum.InitWithNativeCollection(coin.POA, GetApiVar(coin.POA), GetRpcVar(coin.POA), gateway),
+		coin.CLO:  ethereum.InitWithNativeCollection(coin.CLO, GetApiVar(coin.CLO), GetRpcVar(coin.CLO), gateway),
+		coin.GO:   ethereum.InitWithNativeCollection(coin.GO, GetApiVar(coin.GO), GetRpcVar(coin.GO), gateway),
+		coin.WAN:  ethereum.InitWithNativeCollection(coin.WAN, GetApiVar(coin.WAN), GetRpcVar(coin.WAN), gateway),
+		coin.TOMO: ethereum.InitWithNativeCollection(coin.TOMO, GetApiVar(coin.TOMO), GetRpcVar(coin.TOMO), gateway),
+		coin.TT:   ethereum.InitWithNativeCollection(coin.TT, GetApiVar(coin.TT), GetRpcVar(coin.TT), gateway),
+		coin.TRX:  tron.InitWithCollection(GetApiVar(coin.TRX), GetVar("tron.explorer"), gateway, viper.GetInt64("tron.collectibles_start_block")),
 	}
 }
 
@@ -122,6 +131,6 @@ func getNamingHandlers() map[uint]blockatlas.NamingServiceAPI {
 	return map[uint]blockatlas.NamingServiceAPI{
 		coin.ETH: ethereum.Init(coin.ETH, GetApiVar(coin.ETH), GetRpcVar(coin.ETH)),
 		coin.FIO: fio.Init(GetApiVar(coin.FIO)),
-		coin.ZIL: zilliqa.Init(GetApiVar(coin.ZIL), GetVar("zilliqa.key"), GetRpcVar(coin.ZIL), GetVar("zilliqa.lookup")),
+		coin.ZIL: zilliqa.InitWithReverseLookup(GetApiVar(coin.ZIL), GetVar("zilliqa.key"), GetRpcVar(coin.ZIL), GetVar("zilliqa.lookup"), GetVar("zilliqa.reverse_lookup"), GetVar("zilliqa.reverse_lookup_key")),
 	}
 }
@@ -49,3 +49,41 @@ func (c *Client) GetBlockByNumber(number int64) ([]Extrinsic, error) {
 	}
 	return res.Data.Extrinsics, nil
 }
+
+func (c *Client) GetAccount(address string) (Account, error) {
+	var res AccountResponse
+	err := c.Post(&res, "scan/search", SearchRequest{Key: address, Row: 1})
+	if err != nil {
+		return Account{}, err
+	}
+	return res.Data.Account, nil
+}
+
+func (c *Client) GetValidators() ([]Validator, error) {
+	var res ValidatorsResponse
+	err := c.Post(&res, "scan/staking/validators", nil)
+	if err != nil {
+		return nil, err
+	}
+	return res.Data.List, nil
+}
+
+// GetNominations returns the validators nominated by the address
+func (c *Client) GetNominations(address string) ([]Validator, error) {
+	var res ValidatorsResponse
+	err := c.Post(&res, "scan/staking/voted", AddressRequest{Address: address})
+	if err != nil {
+		return nil, err
+	}
+	return res.Data.List, nil
+}
+
+// GetRewards returns the rewards and the slashes of the address, page starts at 0
+func (c *Client) GetRewards(address string, page int) ([]RewardEvent, error) {
+	var res RewardsResponse
+	err := c.Post(&res, "scan/account/reward_slash", RewardsRequest{Address: address, Row: blockatlas.RewardsPerPage, Page: page})
+	if err != nil {
+		return nil, err
+	}
+	return res.Data.List, nil
+}
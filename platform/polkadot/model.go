@@ -7,6 +7,9 @@ const (
 	ModuleStaking  string = "staking"
 
 	ModuleFunctionTransfer string = "transfer"
+	ModuleFunctionUnbond   string = "unbond"
+
+	EventReward string = "Reward"
 )
 
 type Transfer struct {
@@ -59,3 +62,76 @@ type SubscanResponse struct {
 	Message string              `json:"message"`
 	Data    SubscanResponseData `json:"data"`
 }
+
+type AddressRequest struct {
+	Address string `json:"address"`
+}
+
+type SearchRequest struct {
+	Key  string `json:"key"`
+	Row  int    `json:"row"`
+	Page int    `json:"page"`
+}
+
+type RewardsRequest struct {
+	Address string `json:"address"`
+	Row     int    `json:"row"`
+	Page    int    `json:"page"`
+}
+
+// Account amounts are in the unit of the coin, not in planck
+type Account struct {
+	Address     string `json:"address"`
+	Balance     string `json:"balance"`
+	BalanceLock string `json:"balance_lock"`
+	Bonded      string `json:"bonded"`
+	Unbonding   string `json:"unbonding"`
+}
+
+type AccountDisplay struct {
+	Address  string `json:"address"`
+	Display  string `json:"display"`
+	Identity bool   `json:"identity"`
+}
+
+// Validator is a validator of the current era, Commission is in parts per billion and the bonds in planck
+type Validator struct {
+	StashAccount    AccountDisplay `json:"stash_account_display"`
+	BondedTotal     string         `json:"bonded_total"`
+	BondedOwner     string         `json:"bonded_owner"`
+	Commission      int64          `json:"validator_prefs_value"`
+	CountNominators int            `json:"count_nominators"`
+}
+
+// RewardEvent is a reward or a slash of an era, Amount is in planck
+type RewardEvent struct {
+	EventIndex    string `json:"event_index"`
+	EventID       string `json:"event_id"`
+	ExtrinsicHash string `json:"extrinsic_hash"`
+	Amount        string `json:"amount"`
+	Timestamp     int64  `json:"block_timestamp"`
+}
+
+type AccountResponse struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    struct {
+		Account Account `json:"account"`
+	} `json:"data"`
+}
+
+type ValidatorsResponse struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    struct {
+		List []Validator `json:"list"`
+	} `json:"data"`
+}
+
+type RewardsResponse struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    struct {
+		List []RewardEvent `json:"list"`
+	} `json:"data"`
+}
@@ -0,0 +1,285 @@
+package polkadot
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/trustwallet/blockatlas/coin"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/logger"
+	"github.com/trustwallet/blockatlas/pkg/numbers"
+	"github.com/trustwallet/blockatlas/services/assets"
+)
+
+const (
+	lockTimePolkadot = 2419200 // in seconds (28 eras of a day)
+	lockTimeKusama   = 604800  // in seconds (28 eras of 6 hours)
+
+	minimumAmountPolkadot = "10000000000"   // 1 DOT
+	minimumAmountKusama   = "1000000000000" // 1 KSM
+
+	commissionBase = 10000000 // validator_prefs_value is in parts per billion
+)
+
+func (p *Platform) GetDetails() blockatlas.StakingDetails {
+	details := blockatlas.StakingDetails{
+		Reward:        blockatlas.StakingReward{Annual: blockatlas.DefaultAnnualReward},
+		MinimumAmount: minimumAmountPolkadot,
+		LockTime:      lockTimePolkadot,
+		Type:          blockatlas.DelegationTypeDelegate,
+	}
+	if p.CoinIndex == coin.KSM {
+		details.MinimumAmount = minimumAmountKusama
+		details.LockTime = lockTimeKusama
+	}
+	return details
+}
+
+func (p *Platform) GetValidators() (blockatlas.ValidatorPage, error) {
+	validators, err := p.client.GetValidators()
+	if err != nil {
+		return nil, err
+	}
+	results := make(blockatlas.ValidatorPage, 0, len(validators))
+	for _, v := range validators {
+		results = append(results, p.normalizeValidator(v))
+	}
+	results.SetStakeShares()
+	return results, nil
+}
+
+func (p *Platform) normalizeValidator(v Validator) blockatlas.Validator {
+	return blockatlas.Validator{
+		Status:  true,
+		ID:      v.StashAccount.Address,
+		Details: p.GetDetails(),
+		Metrics: &blockatlas.ValidatorMetrics{
+			Commission:    float64(v.Commission) / commissionBase,
+			HasCommission: true,
+			VotingPower:   v.BondedTotal,
+			SelfBond:      v.BondedOwner,
+		},
+	}
+}
+
+// GetActiveValidators lists the validators of the registry, then the others of the era with an on-chain identity
+func (p *Platform) GetActiveValidators() (blockatlas.StakeValidators, error) {
+	validators, err := assets.GetValidatorsMap(p)
+	if err != nil {
+		return nil, err
+	}
+	result := make(blockatlas.StakeValidators, 0, len(validators))
+	for _, v := range validators {
+		result = append(result, v)
+	}
+	eraValidators, err := p.client.GetValidators()
+	if err != nil {
+		logger.Error(err, "Polkadot validators identity", logger.Params{"coin": p.Coin().Handle})
+		return result, nil
+	}
+	return append(result, p.identityValidators(eraValidators, validators)...), nil
+}
+
+func (p *Platform) identityValidators(eraValidators []Validator, known blockatlas.ValidatorMap) blockatlas.StakeValidators {
+	result := make(blockatlas.StakeValidators, 0)
+	for _, v := range eraValidators {
+		if _, ok := known[v.StashAccount.Address]; ok || !v.StashAccount.Identity || v.StashAccount.Display == "" {
+			continue
+		}
+		validator := p.normalizeValidator(v)
+		result = append(result, blockatlas.StakeValidator{
+			ID:      validator.ID,
+			Status:  validator.Status,
+			Info:    blockatlas.StakeValidatorInfo{Name: v.StashAccount.Display},
+			Details: validator.Details,
+			Metrics: validator.Metrics,
+		})
+	}
+	return result
+}
+
+func (p *Platform) GetDelegations(address string) (blockatlas.DelegationsPage, error) {
+	account, err := p.client.GetAccount(address)
+	if err != nil {
+		return nil, err
+	}
+	nominations, err := p.client.GetNominations(address)
+	if err != nil {
+		return nil, err
+	}
+	if len(nominations) == 0 && p.toPlanck(account.Unbonding) == "0" {
+		return make(blockatlas.DelegationsPage, 0), nil
+	}
+	extrinsics, err := p.client.GetExtrinsicsOfAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	validators, err := assets.GetValidatorsMap(p)
+	if err != nil {
+		return nil, err
+	}
+	unbondings := p.NormalizeUnbondings(extrinsics, time.Now().Unix())
+	return p.NormalizeDelegations(account, nominations, unbondings, validators, time.Now().Unix()), nil
+}
+
+// Unbonding is an unbond call of the account, the amount is locked until AvailableDate
+type Unbonding struct {
+	Value         *big.Int
+	AvailableDate int64
+}
+
+// NormalizeUnbondings reads the unbond calls of the last extrinsics still in their bonding duration
+func (p *Platform) NormalizeUnbondings(extrinsics []Extrinsic, now int64) []Unbonding {
+	lockTime := int64(p.GetDetails().LockTime)
+	result := make([]Unbonding, 0)
+	for _, e := range extrinsics {
+		if !e.Success || e.CallModule != ModuleStaking || e.CallModuleFunction != ModuleFunctionUnbond {
+			continue
+		}
+		available := int64(e.Timestamp) + lockTime
+		if available <= now {
+			continue
+		}
+		var datas []CallData
+		if err := json.Unmarshal([]byte(e.Params), &datas); err != nil {
+			continue
+		}
+		for _, data := range datas {
+			if vf, ok := data.Value.(float64); ok {
+				value, _ := new(big.Int).SetString(fmt.Sprintf("%.0f", vf), 10)
+				result = append(result, Unbonding{Value: value, AvailableDate: available})
+			}
+		}
+	}
+	return result
+}
+
+// NormalizeDelegations reports the bonded amount split evenly between the nominees, the chain assigns it to the
+// elected ones every era. The unbonding chunks are pending until their date, the rest of the unbonding is redeemable
+func (p *Platform) NormalizeDelegations(account Account, nominations []Validator, unbondings []Unbonding, validators blockatlas.ValidatorMap, now int64) blockatlas.DelegationsPage {
+	results := make(blockatlas.DelegationsPage, 0)
+	bonded, _ := new(big.Int).SetString(p.toPlanck(account.Bonded), 10)
+	if bonded != nil && bonded.Sign() > 0 && len(nominations) > 0 {
+		share, remainder := new(big.Int).QuoRem(bonded, big.NewInt(int64(len(nominations))), new(big.Int))
+		for i, n := range nominations {
+			value := new(big.Int).Set(share)
+			if i == 0 {
+				value.Add(value, remainder)
+			}
+			results = append(results, blockatlas.Delegation{
+				Delegator: p.validator(n.StashAccount.Address, validators),
+				Value:     value.String(),
+				Status:    blockatlas.DelegationStatusActive,
+			})
+		}
+	}
+
+	unbonding, _ := new(big.Int).SetString(p.toPlanck(account.Unbonding), 10)
+	if unbonding == nil || unbonding.Sign() == 0 {
+		return results
+	}
+	unbondingValidator := p.validator(account.Address, validators)
+	if len(nominations) > 0 {
+		unbondingValidator = p.validator(nominations[0].StashAccount.Address, validators)
+	}
+	redeemable := new(big.Int).Set(unbonding)
+	for _, u := range unbondings {
+		if u.Value.Cmp(redeemable) > 0 {
+			break
+		}
+		redeemable.Sub(redeemable, u.Value)
+		results = append(results, blockatlas.Delegation{
+			Delegator: unbondingValidator,
+			Value:     u.Value.String(),
+			Status:    blockatlas.DelegationStatusPending,
+			Metadata:  blockatlas.DelegationMetaDataPending{AvailableDate: uint(u.AvailableDate)},
+		})
+	}
+	if redeemable.Sign() > 0 {
+		results = append(results, blockatlas.Delegation{
+			Delegator: unbondingValidator,
+			Value:     redeemable.String(),
+			Status:    blockatlas.DelegationStatusPending,
+			Metadata:  blockatlas.DelegationMetaDataPending{AvailableDate: uint(now)},
+		})
+	}
+	return results
+}
+
+func (p *Platform) validator(address string, validators blockatlas.ValidatorMap) blockatlas.StakeValidator {
+	validator, ok := validators[address]
+	if !ok {
+		return p.getUnknownValidator(address)
+	}
+	return validator
+}
+
+func (p *Platform) getUnknownValidator(address string) blockatlas.StakeValidator {
+	return blockatlas.StakeValidator{
+		ID:     address,
+		Status: false,
+		Info: blockatlas.StakeValidatorInfo{
+			Name:        "Decommissioned",
+			Description: "Decommissioned",
+		},
+		Details: p.GetDetails(),
+	}
+}
+
+func (p *Platform) UndelegatedBalance(address string) (string, error) {
+	account, err := p.client.GetAccount(address)
+	if err != nil {
+		return "0", err
+	}
+	balance, ok := new(big.Int).SetString(p.toPlanck(account.Balance), 10)
+	if !ok {
+		return "0", nil
+	}
+	if locked, ok := new(big.Int).SetString(p.toPlanck(account.BalanceLock), 10); ok {
+		balance.Sub(balance, locked)
+	}
+	if balance.Sign() < 0 {
+		return "0", nil
+	}
+	return balance.String(), nil
+}
+
+// GetPendingRewards is empty, the rewards of an era are paid to the nominators with the payout of the validator
+func (p *Platform) GetPendingRewards(address string) (blockatlas.PendingRewards, error) {
+	return make(blockatlas.PendingRewards, 0), nil
+}
+
+func (p *Platform) GetRewardsHistory(address string, page int) (blockatlas.RewardsPage, error) {
+	events, err := p.client.GetRewards(address, page-1)
+	if err != nil {
+		return nil, err
+	}
+	return NormalizeRewards(events), nil
+}
+
+func NormalizeRewards(events []RewardEvent) blockatlas.RewardsPage {
+	results := make(blockatlas.RewardsPage, 0, len(events))
+	for _, e := range events {
+		if e.EventID != EventReward {
+			continue
+		}
+		results = append(results, blockatlas.Reward{
+			ID:    blockatlas.GetValidParameter(e.ExtrinsicHash, e.EventIndex),
+			Value: e.Amount,
+			Type:  blockatlas.RewardTypePayout,
+			Date:  e.Timestamp,
+		})
+	}
+	return results
+}
+
+// toPlanck converts the amounts of the accounts, reported in the unit of the coin
+func (p *Platform) toPlanck(amount string) string {
+	if amount == "" {
+		return "0"
+	}
+	return strings.Split(numbers.DecimalExp(amount, int(p.Coin().Decimals)), ".")[0]
+}
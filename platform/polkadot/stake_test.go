@@ -0,0 +1,135 @@
+package polkadot
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/coin"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+)
+
+const validatorsSrc = `
+{
+	"code": 0,
+	"message": "Success",
+	"data": {
+		"list": [
+			{
+				"stash_account_display": {"address": "GLVeryFRbg5hEKvQZcAnLvXZEXhiYaBjzSDwrXBXrfPF7wj", "display": "Validator A", "identity": true},
+				"bonded_total": "30000000000000",
+				"bonded_owner": "1000000000000",
+				"validator_prefs_value": 30000000,
+				"count_nominators": 12
+			},
+			{
+				"stash_account_display": {"address": "HngUT2inDFPBwiey6ZdqhhnmPKHkXayRpWw9rFj55reAqvi", "display": "", "identity": false},
+				"bonded_total": "10000000000000",
+				"bonded_owner": "10000000000000",
+				"validator_prefs_value": 1000000000,
+				"count_nominators": 0
+			}
+		]
+	}
+}`
+
+const extrinsicsSrc = `
+[
+	{"block_timestamp": 1593000000, "call_module_function": "unbond", "call_module": "staking", "params": "[{\"name\":\"value\",\"type\":\"Compact<BalanceOf>\",\"value\":2000000000000,\"valueRaw\":\"\"}]", "success": true},
+	{"block_timestamp": 1593100000, "call_module_function": "unbond", "call_module": "staking", "params": "[{\"name\":\"value\",\"type\":\"Compact<BalanceOf>\",\"value\":500000000000,\"valueRaw\":\"\"}]", "success": false},
+	{"block_timestamp": 1580000000, "call_module_function": "unbond", "call_module": "staking", "params": "[{\"name\":\"value\",\"type\":\"Compact<BalanceOf>\",\"value\":700000000000,\"valueRaw\":\"\"}]", "success": true},
+	{"block_timestamp": 1593200000, "call_module_function": "transfer", "call_module": "balances", "params": "[]", "success": true}
+]`
+
+const rewardsSrc = `
+[
+	{"event_index": "2514623-11", "event_id": "Reward", "extrinsic_hash": "0xa7ab", "amount": "13280571612", "block_timestamp": 1593312000},
+	{"event_index": "2513201-3", "event_id": "Slash", "extrinsic_hash": "", "amount": "1000000", "block_timestamp": 1593225600},
+	{"event_index": "2512000-7", "event_id": "Reward", "extrinsic_hash": "", "amount": "13044807001", "block_timestamp": 1593139200}
+]`
+
+func TestPlatform_normalizeValidator(t *testing.T) {
+	var res ValidatorsResponse
+	assert.NoError(t, json.Unmarshal([]byte(validatorsSrc), &res))
+	p := Platform{CoinIndex: coin.KSM}
+
+	results := make(blockatlas.ValidatorPage, 0)
+	for _, v := range res.Data.List {
+		results = append(results, p.normalizeValidator(v))
+	}
+	results.SetStakeShares()
+
+	assert.Equal(t, blockatlas.Validator{
+		ID:     "GLVeryFRbg5hEKvQZcAnLvXZEXhiYaBjzSDwrXBXrfPF7wj",
+		Status: true,
+		Details: blockatlas.StakingDetails{
+			Reward:        blockatlas.StakingReward{Annual: 0},
+			MinimumAmount: minimumAmountKusama,
+			LockTime:      lockTimeKusama,
+			Type:          blockatlas.DelegationTypeDelegate,
+		},
+		Metrics: &blockatlas.ValidatorMetrics{
+			Commission:    3,
+			HasCommission: true,
+			VotingPower:   "30000000000000",
+			StakeShare:    75,
+			SelfBond:      "1000000000000",
+		},
+	}, results[0])
+	assert.Equal(t, float64(100), results[1].Metrics.Commission)
+	assert.Equal(t, float64(25), results[1].Metrics.StakeShare)
+
+	identity := p.identityValidators(res.Data.List, blockatlas.ValidatorMap{})
+	assert.Len(t, identity, 1)
+	assert.Equal(t, "Validator A", identity[0].Info.Name)
+	assert.Empty(t, p.identityValidators(res.Data.List, blockatlas.ValidatorMap{identity[0].ID: identity[0]}))
+}
+
+func TestPlatform_NormalizeDelegations(t *testing.T) {
+	var extrinsics []Extrinsic
+	assert.NoError(t, json.Unmarshal([]byte(extrinsicsSrc), &extrinsics))
+	p := Platform{CoinIndex: coin.KSM}
+	now := int64(1593300000)
+
+	unbondings := p.NormalizeUnbondings(extrinsics, now)
+	assert.Equal(t, []Unbonding{{Value: big.NewInt(2000000000000), AvailableDate: 1593604800}}, unbondings)
+
+	account := Account{Address: "FT7KmqtU6gbJE4yzY3tu1YkX4iGQsr4Rq1swDNZcKrFZXPH", Balance: "15.5", BalanceLock: "12.5", Bonded: "10", Unbonding: "2.5"}
+	nominations := []Validator{
+		{StashAccount: AccountDisplay{Address: "GLVeryFRbg5hEKvQZcAnLvXZEXhiYaBjzSDwrXBXrfPF7wj"}},
+		{StashAccount: AccountDisplay{Address: "HngUT2inDFPBwiey6ZdqhhnmPKHkXayRpWw9rFj55reAqvi"}},
+		{StashAccount: AccountDisplay{Address: "DSpbbk6HKKyS78c4KDLSxCetqbwnsemv2iocVXwNe2FAvWC"}},
+	}
+	known := blockatlas.StakeValidator{ID: "GLVeryFRbg5hEKvQZcAnLvXZEXhiYaBjzSDwrXBXrfPF7wj", Status: true, Info: blockatlas.StakeValidatorInfo{Name: "Validator A"}}
+	validators := blockatlas.ValidatorMap{known.ID: known}
+
+	result := p.NormalizeDelegations(account, nominations, unbondings, validators, now)
+	assert.Len(t, result, 5)
+	assert.Equal(t, blockatlas.Delegation{Delegator: known, Value: "3333333333334", Status: blockatlas.DelegationStatusActive}, result[0])
+	assert.Equal(t, "3333333333333", result[1].Value)
+	assert.Equal(t, "Decommissioned", result[2].Delegator.Info.Name)
+	assert.Equal(t, blockatlas.Delegation{
+		Delegator: known,
+		Value:     "2000000000000",
+		Status:    blockatlas.DelegationStatusPending,
+		Metadata:  blockatlas.DelegationMetaDataPending{AvailableDate: 1593604800},
+	}, result[3])
+	assert.Equal(t, blockatlas.Delegation{
+		Delegator: known,
+		Value:     "500000000000",
+		Status:    blockatlas.DelegationStatusPending,
+		Metadata:  blockatlas.DelegationMetaDataPending{AvailableDate: uint(now)},
+	}, result[4])
+
+	assert.Empty(t, p.NormalizeDelegations(Account{Bonded: "0", Unbonding: "0"}, nil, nil, validators, now))
+}
+
+func TestNormalizeRewards(t *testing.T) {
+	var events []RewardEvent
+	assert.NoError(t, json.Unmarshal([]byte(rewardsSrc), &events))
+	assert.Equal(t, blockatlas.RewardsPage{
+		{ID: "0xa7ab", Value: "13280571612", Type: blockatlas.RewardTypePayout, Date: 1593312000},
+		{ID: "2512000-7", Value: "13044807001", Type: blockatlas.RewardTypePayout, Date: 1593139200},
+	}, NormalizeRewards(events))
+}
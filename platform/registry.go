@@ -4,7 +4,10 @@ import (
 	"fmt"
 	"github.com/spf13/viper"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/errors"
 	"github.com/trustwallet/blockatlas/pkg/logger"
+	"sort"
+	"sync"
 )
 
 var (
@@ -25,6 +28,31 @@ var (
 
 	// NamingAPIs contain platforms which support naming services
 	NamingAPIs map[uint]blockatlas.NamingServiceAPI
+
+	// mu guards the maps above, they are replaced as a whole and never modified after Init or Reload
+	mu sync.RWMutex
+
+	// configs contains a snapshot of the config section of every enabled platform, used to detect updates on Reload
+	configs map[string]string
+)
+
+type (
+	// Changes lists the platform handles affected by a Reload
+	Changes struct {
+		Added   []string `json:"added"`
+		Removed []string `json:"removed"`
+		Updated []string `json:"updated"`
+	}
+
+	registry struct {
+		platforms   map[string]blockatlas.Platform
+		blockAPIs   map[string]blockatlas.BlockAPI
+		tokensAPIs  map[uint]blockatlas.TokensAPI
+		stakeAPIs   map[string]blockatlas.StakeAPI
+		collections blockatlas.CollectionsAPIs
+		namingAPIs  map[uint]blockatlas.NamingServiceAPI
+		configs     map[string]string
+	}
 )
 
 func getActivePlatforms(handles []string) []blockatlas.Platform {
@@ -52,11 +80,43 @@ func getActivePlatforms(handles []string) []blockatlas.Platform {
 
 func Init(platformHandles []string) {
 	platformList := getActivePlatforms(platformHandles)
+	r := newRegistry(platformList)
+
+	mu.Lock()
+	defer mu.Unlock()
+	r.publish()
+}
 
-	Platforms = make(map[string]blockatlas.Platform)
-	BlockAPIs = make(map[string]blockatlas.BlockAPI)
-	TokensAPIs = make(map[uint]blockatlas.TokensAPI)
-	StakeAPIs = make(map[string]blockatlas.StakeAPI)
+// Reload rebuilds the registry from the current viper config and swaps it atomically.
+// Platform instances already handed out keep serving the requests in flight.
+func Reload(platformHandles []string) (Changes, error) {
+	if len(platformHandles) == 0 {
+		return Changes{}, errors.E("platform handles are empty")
+	}
+	r := newRegistry(getActivePlatforms(platformHandles))
+
+	mu.Lock()
+	defer mu.Unlock()
+	changes := r.diff(configs)
+	r.publish()
+	logger.Info("Platform registry reloaded", logger.Params{
+		"added":   changes.Added,
+		"removed": changes.Removed,
+		"updated": changes.Updated,
+	})
+	return changes, nil
+}
+
+func newRegistry(platformList []blockatlas.Platform) registry {
+	r := registry{
+		platforms:   make(map[string]blockatlas.Platform),
+		blockAPIs:   make(map[string]blockatlas.BlockAPI),
+		tokensAPIs:  make(map[uint]blockatlas.TokensAPI),
+		stakeAPIs:   make(map[string]blockatlas.StakeAPI),
+		collections: make(blockatlas.CollectionsAPIs),
+		namingAPIs:  getNamingHandlers(),
+		configs:     make(map[string]string),
+	}
 
 	for _, platform := range platformList {
 		handle := platform.Coin().Handle
@@ -74,21 +134,219 @@ func Init(platformHandles []string) {
 			"coin":     platform.Coin(),
 		}
 
-		if _, exists := Platforms[handle]; exists {
+		if _, exists := r.platforms[handle]; exists {
 			logger.Fatal("Duplicate handle", p)
 		}
-		Platforms[handle] = platform
+		r.platforms[handle] = platform
+		r.configs[handle] = fmt.Sprintf("%v", viper.Get(handle))
 		if blockAPI, ok := platform.(blockatlas.BlockAPI); ok {
-			BlockAPIs[handle] = blockAPI
+			r.blockAPIs[handle] = blockAPI
 		}
 		if tokenAPI, ok := platform.(blockatlas.TokensAPI); ok {
-			TokensAPIs[platform.Coin().ID] = tokenAPI
+			r.tokensAPIs[platform.Coin().ID] = tokenAPI
 		}
 		if stakeAPI, ok := platform.(blockatlas.StakeAPI); ok {
-			StakeAPIs[handle] = stakeAPI
+			r.stakeAPIs[handle] = stakeAPI
+		}
+	}
+	for coinID, collectionsAPI := range getCollectionsHandlers() {
+		if _, ok := r.platforms[collectionsAPI.Coin().Handle]; ok {
+			r.collections[coinID] = collectionsAPI
+		}
+	}
+	return r
+}
+
+// publish must be called with mu held
+func (r registry) publish() {
+	Platforms = r.platforms
+	BlockAPIs = r.blockAPIs
+	TokensAPIs = r.tokensAPIs
+	StakeAPIs = r.stakeAPIs
+	CollectionsAPIs = r.collections
+	NamingAPIs = r.namingAPIs
+	configs = r.configs
+}
+
+func (r registry) diff(previous map[string]string) Changes {
+	changes := Changes{Added: make([]string, 0), Removed: make([]string, 0), Updated: make([]string, 0)}
+	for handle, config := range r.configs {
+		old, ok := previous[handle]
+		if !ok {
+			changes.Added = append(changes.Added, handle)
+		} else if old != config {
+			changes.Updated = append(changes.Updated, handle)
 		}
 	}
+	for handle := range previous {
+		if _, ok := r.configs[handle]; !ok {
+			changes.Removed = append(changes.Removed, handle)
+		}
+	}
+	sort.Strings(changes.Added)
+	sort.Strings(changes.Removed)
+	sort.Strings(changes.Updated)
+	return changes
+}
+
+// GetPlatforms returns the enabled platforms, safe to call during a reload
+func GetPlatforms() map[string]blockatlas.Platform {
+	mu.RLock()
+	defer mu.RUnlock()
+	return Platforms
+}
 
-	CollectionsAPIs = getCollectionsHandlers()
-	NamingAPIs = getNamingHandlers()
+// GetPlatform returns the enabled platform with the handle, or nil
+func GetPlatform(handle string) blockatlas.Platform {
+	mu.RLock()
+	defer mu.RUnlock()
+	return Platforms[handle]
+}
+
+func GetBlockAPIs() map[string]blockatlas.BlockAPI {
+	mu.RLock()
+	defer mu.RUnlock()
+	return BlockAPIs
+}
+
+func GetTokensAPIs() map[uint]blockatlas.TokensAPI {
+	mu.RLock()
+	defer mu.RUnlock()
+	return TokensAPIs
+}
+
+func GetStakeAPIs() map[string]blockatlas.StakeAPI {
+	mu.RLock()
+	defer mu.RUnlock()
+	return StakeAPIs
+}
+
+func GetCollectionsAPIs() blockatlas.CollectionsAPIs {
+	mu.RLock()
+	defer mu.RUnlock()
+	return CollectionsAPIs
+}
+
+func GetNamingAPIs() map[uint]blockatlas.NamingServiceAPI {
+	mu.RLock()
+	defer mu.RUnlock()
+	return NamingAPIs
+}
+
+// GetTokenInfoAPI returns the enabled platform of the coin if it reads the token metadata from the chain
+func GetTokenInfoAPI(coinID uint) (blockatlas.TokenInfoAPI, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	for _, p := range Platforms {
+		if p.Coin().ID != coinID {
+			continue
+		}
+		api, ok := p.(blockatlas.TokenInfoAPI)
+		return api, ok
+	}
+	return nil, false
+}
+
+// GetCollectibleTransferAPI returns the enabled platform of the coin if it resolves the collectible transfers
+func GetCollectibleTransferAPI(coinID uint) (blockatlas.CollectibleTransferAPI, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	for _, p := range Platforms {
+		if p.Coin().ID != coinID {
+			continue
+		}
+		api, ok := p.(blockatlas.CollectibleTransferAPI)
+		return api, ok
+	}
+	return nil, false
+}
+
+// GetAllPlatforms returns every supported platform, whether it is enabled or not
+func GetAllPlatforms() blockatlas.Platforms {
+	return getAllHandlers()
+}
+
+// GetAllCollectionsAPIs returns every supported collections api, whether its platform is enabled or not
+func GetAllCollectionsAPIs() blockatlas.CollectionsAPIs {
+	return getCollectionsHandlers()
+}
+
+// GetCapabilities returns which services are enabled for the platform
+func GetCapabilities(p blockatlas.Platform) blockatlas.PlatformCapabilities {
+	coinID := p.Coin().ID
+	_, txAPI := p.(blockatlas.TxAPI)
+	_, tokenTxAPI := p.(blockatlas.TokenTxAPI)
+	_, txUtxoAPI := p.(blockatlas.TxUtxoAPI)
+	_, tokensAPI := GetTokensAPIs()[coinID]
+	_, stakeAPI := GetStakeAPIs()[p.Coin().Handle]
+	_, collectionsAPI := GetCollectionsAPIs()[coinID]
+	_, namingAPI := GetNamingAPIs()[coinID]
+	return blockatlas.PlatformCapabilities{
+		Transactions: txAPI || tokenTxAPI,
+		Xpub:         txUtxoAPI,
+		Tokens:       tokensAPI,
+		Staking:      stakeAPI,
+		Collections:  collectionsAPI,
+		Naming:       namingAPI,
+	}
+}
+
+// GetInterfaces returns the names of the blockatlas interfaces served for the platform
+func GetInterfaces(p blockatlas.Platform) []string {
+	coinID := p.Coin().ID
+	interfaces := make([]string, 0)
+	if _, ok := p.(blockatlas.BlockAPI); ok {
+		interfaces = append(interfaces, "BlockAPI")
+	}
+	if _, ok := p.(blockatlas.TxAPI); ok {
+		interfaces = append(interfaces, "TxAPI")
+	}
+	if _, ok := p.(blockatlas.TokenTxAPI); ok {
+		interfaces = append(interfaces, "TokenTxAPI")
+	}
+	if _, ok := p.(blockatlas.TxUtxoAPI); ok {
+		interfaces = append(interfaces, "TxUtxoAPI")
+	}
+	if _, ok := GetTokensAPIs()[coinID]; ok {
+		interfaces = append(interfaces, "TokensAPI")
+	}
+	if _, ok := p.(blockatlas.ApprovalsAPI); ok {
+		interfaces = append(interfaces, "ApprovalsAPI")
+	}
+	if _, ok := GetStakeAPIs()[p.Coin().Handle]; ok {
+		interfaces = append(interfaces, "StakeAPI")
+	}
+	if _, ok := p.(blockatlas.RedelegationsAPI); ok {
+		interfaces = append(interfaces, "RedelegationsAPI")
+	}
+	if _, ok := p.(blockatlas.StakingRewardsAPI); ok {
+		interfaces = append(interfaces, "StakingRewardsAPI")
+	}
+	if _, ok := p.(blockatlas.StakingEconomicsAPI); ok {
+		interfaces = append(interfaces, "StakingEconomicsAPI")
+	}
+	if _, ok := GetCollectionsAPIs()[coinID]; ok {
+		interfaces = append(interfaces, "CollectionsAPI")
+	}
+	if _, ok := GetNamingAPIs()[coinID]; ok {
+		interfaces = append(interfaces, "NamingServiceAPI")
+	}
+	return interfaces
+}
+
+// GetPlatformInfo returns the coin metadata and the services of the platform
+func GetPlatformInfo(p blockatlas.Platform) blockatlas.PlatformInfo {
+	c := p.Coin()
+	return blockatlas.PlatformInfo{
+		Coin:          c.ID,
+		Handle:        c.Handle,
+		Symbol:        c.Symbol,
+		Name:          c.Name,
+		Decimals:      c.Decimals,
+		BlockTime:     c.BlockTime,
+		SampleAddress: c.SampleAddr,
+		Capabilities:  GetCapabilities(p),
+		Interfaces:    GetInterfaces(p),
+		Endpoints:     make([]string, 0),
+	}
 }
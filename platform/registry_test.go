@@ -0,0 +1,40 @@
+package platform
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReload(t *testing.T) {
+	viper.Set("cosmos.api", "https://cosmos.example.com")
+	viper.Set("tezos.api", "https://tezos.example.com")
+	Init([]string{"cosmos"})
+	assert.NotNil(t, GetPlatform("cosmos"))
+	assert.Nil(t, GetPlatform("tezos"))
+	cosmos := GetPlatform("cosmos")
+
+	changes, err := Reload([]string{"cosmos", "tezos"})
+	assert.Nil(t, err)
+	assert.Equal(t, Changes{Added: []string{"tezos"}, Removed: []string{}, Updated: []string{}}, changes)
+	assert.NotNil(t, GetPlatform("tezos"))
+	assert.Contains(t, GetBlockAPIs(), "tezos")
+	assert.Contains(t, GetStakeAPIs(), "tezos")
+
+	viper.Set("cosmos.api", "https://cosmos.example.org")
+	changes, err = Reload([]string{"tezos", "cosmos"})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"cosmos"}, changes.Updated)
+	assert.NotEqual(t, cosmos, GetPlatform("cosmos"))
+
+	changes, err = Reload([]string{"tezos"})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"cosmos"}, changes.Removed)
+	assert.Nil(t, GetPlatform("cosmos"))
+	assert.NotContains(t, GetStakeAPIs(), "cosmos")
+
+	_, err = Reload([]string{})
+	assert.NotNil(t, err)
+	assert.NotNil(t, GetPlatform("tezos"))
+}
@@ -2,6 +2,8 @@ package solana
 
 import (
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/errors"
+	"github.com/trustwallet/blockatlas/pkg/logger"
 )
 
 const stakeProgramId = "Stake11111111111111111111111111111111111111"
@@ -36,3 +38,57 @@ func (c *Client) GetMinimumBalanceForRentExemption() (minimumBalance uint64, err
 	err = c.RpcCall(&minimumBalance, "getMinimumBalanceForRentExemption", []uint64{4008})
 	return
 }
+
+// GetInflationRewards returns the rewards of the accounts for the epoch, nil for the accounts without rewards
+func (c *Client) GetInflationRewards(pubkeys []string, epoch uint64) (rewards []*InflationReward, err error) {
+	err = c.RpcCall(&rewards, "getInflationReward", []interface{}{pubkeys, map[string]uint64{"epoch": epoch}})
+	return
+}
+
+func (c *Client) GetBlockTime(slot uint64) (timestamp int64, err error) {
+	err = c.RpcCall(&timestamp, "getBlockTime", []uint64{slot})
+	return
+}
+
+func (c *Client) GetSignaturesForAddress(address string, limit int) (signatures []Signature, err error) {
+	err = c.RpcCall(&signatures, "getConfirmedSignaturesForAddress2", []interface{}{address, map[string]int{"limit": limit}})
+	return
+}
+
+// GetConfirmedTransactions fetches the transactions of the signatures in a single batch call, in the order of the
+// signatures. The transactions failing to decode are skipped.
+func (c *Client) GetConfirmedTransactions(signatures []string) ([]ConfirmedTransaction, error) {
+	if len(signatures) == 0 {
+		return nil, nil
+	}
+	requests := make(blockatlas.RpcRequests, 0, len(signatures))
+	for _, signature := range signatures {
+		requests = append(requests, &blockatlas.RpcRequest{
+			Method: "getConfirmedTransaction",
+			Params: []string{signature, "jsonParsed"},
+		})
+	}
+	responses, err := c.RpcBatchCall(requests)
+	if err != nil {
+		return nil, errors.E(err, "get confirmed transactions failed", errors.Params{"count": len(signatures)})
+	}
+	byID := make(map[int64]blockatlas.RpcResponse, len(responses))
+	for _, response := range responses {
+		byID[response.Id] = response
+	}
+	txs := make([]ConfirmedTransaction, 0, len(signatures))
+	for i, request := range requests {
+		response, ok := byID[request.Id]
+		if !ok || response.Error != nil || response.Result == nil {
+			logger.Error("Solana: Failed to get the transaction", logger.Params{"signature": signatures[i], "error": response.Error})
+			continue
+		}
+		var tx ConfirmedTransaction
+		if err := response.GetObject(&tx); err != nil {
+			logger.Error(err, "Solana: Failed to decode the transaction", logger.Params{"signature": signatures[i]})
+			continue
+		}
+		txs = append(txs, tx)
+	}
+	return txs, nil
+}
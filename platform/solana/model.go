@@ -1,5 +1,7 @@
 package solana
 
+import "encoding/json"
+
 const (
 	StakeStateUninitialized StakeState = 0
 	StakeStateInitialized   StakeState = 1
@@ -69,3 +71,71 @@ type EpochInfo struct {
 	SlotIndex    uint64 `json:"slotIndex"`
 	SlotsInEpoch uint64 `json:"slotsInEpoch"`
 }
+
+type InflationReward struct {
+	Epoch         uint64 `json:"epoch"`
+	EffectiveSlot uint64 `json:"effectiveSlot"`
+	Amount        uint64 `json:"amount"`
+	PostBalance   uint64 `json:"postBalance"`
+}
+
+const (
+	StakeInstructionDelegate   = "delegate"
+	StakeInstructionDeactivate = "deactivate"
+	StakeInstructionWithdraw   = "withdraw"
+)
+
+type Signature struct {
+	Signature string      `json:"signature"`
+	Slot      uint64      `json:"slot"`
+	Err       interface{} `json:"err"`
+}
+
+type ConfirmedTransaction struct {
+	Slot        uint64          `json:"slot"`
+	BlockTime   int64           `json:"blockTime"`
+	Meta        TransactionMeta `json:"meta"`
+	Transaction Transaction     `json:"transaction"`
+}
+
+type TransactionMeta struct {
+	Err          interface{} `json:"err"`
+	Fee          uint64      `json:"fee"`
+	PreBalances  []uint64    `json:"preBalances"`
+	PostBalances []uint64    `json:"postBalances"`
+}
+
+type Transaction struct {
+	Signatures []string `json:"signatures"`
+	Message    Message  `json:"message"`
+}
+
+type Message struct {
+	AccountKeys  []AccountKey  `json:"accountKeys"`
+	Instructions []Instruction `json:"instructions"`
+}
+
+type AccountKey struct {
+	Pubkey   string `json:"pubkey"`
+	Signer   bool   `json:"signer"`
+	Writable bool   `json:"writable"`
+}
+
+// Instruction - Parsed is a string or an object depending on the program, it is absent when the program is unknown
+type Instruction struct {
+	Program   string          `json:"program"`
+	ProgramId string          `json:"programId"`
+	Parsed    json.RawMessage `json:"parsed"`
+}
+
+type StakeInstruction struct {
+	Type string               `json:"type"`
+	Info StakeInstructionInfo `json:"info"`
+}
+
+type StakeInstructionInfo struct {
+	StakeAccount string `json:"stakeAccount"`
+	VoteAccount  string `json:"voteAccount"`
+	Destination  string `json:"destination"`
+	Lamports     uint64 `json:"lamports"`
+}
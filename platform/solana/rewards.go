@@ -0,0 +1,76 @@
+package solana
+
+import (
+	"strconv"
+
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/logger"
+)
+
+// rewardEpochsPerPage is the number of epochs of a page of the rewards history
+const rewardEpochsPerPage = 5
+
+// GetPendingRewards returns no rewards, the epoch rewards are credited to the stake accounts
+func (p *Platform) GetPendingRewards(address string) (blockatlas.PendingRewards, error) {
+	return make(blockatlas.PendingRewards, 0), nil
+}
+
+// GetRewardsHistory returns the epoch rewards of the stake accounts of the address, a page covers 5 epochs
+func (p *Platform) GetRewardsHistory(address string, page int) (blockatlas.RewardsPage, error) {
+	accounts, err := p.client.GetStakeAccounts()
+	if err != nil {
+		return nil, err
+	}
+	pubkeys := make([]string, 0)
+	voters := make(map[string]string)
+	for _, keyedAccount := range accounts {
+		account, err := parseStakeData(keyedAccount.Account)
+		if err != nil {
+			return nil, err
+		}
+		if account.State == StakeStateDelegated && isAuthorized(account, address) {
+			pubkeys = append(pubkeys, keyedAccount.Pubkey)
+			voters[keyedAccount.Pubkey] = base58.Encode(account.VoterPubkey[:])
+		}
+	}
+	results := make(blockatlas.RewardsPage, 0)
+	if len(pubkeys) == 0 {
+		return results, nil
+	}
+	epochInfo, err := p.client.GetEpochInfo()
+	if err != nil {
+		return nil, err
+	}
+	// rewards of an epoch are paid at the start of the next one
+	last := int64(epochInfo.Epoch) - 1 - int64((page-1)*rewardEpochsPerPage)
+	for epoch := last; epoch >= 0 && epoch > last-rewardEpochsPerPage; epoch-- {
+		rewards, err := p.client.GetInflationRewards(pubkeys, uint64(epoch))
+		if err != nil {
+			return nil, err
+		}
+		date := int64(0)
+		for i, reward := range rewards {
+			if reward == nil || reward.Amount == 0 || i >= len(pubkeys) {
+				continue
+			}
+			if date == 0 {
+				if date, err = p.client.GetBlockTime(reward.EffectiveSlot); err != nil {
+					logger.Error(err, "Solana: Failed to get the block time", logger.Params{"slot": reward.EffectiveSlot})
+				}
+			}
+			results = append(results, NormalizeReward(*reward, voters[pubkeys[i]], date))
+		}
+	}
+	return results, nil
+}
+
+func NormalizeReward(reward InflationReward, validator string, date int64) blockatlas.Reward {
+	return blockatlas.Reward{
+		ID:        strconv.FormatUint(reward.Epoch, 10),
+		Validator: validator,
+		Value:     strconv.FormatUint(reward.Amount, 10),
+		Type:      blockatlas.RewardTypePayout,
+		Date:      date,
+	}
+}
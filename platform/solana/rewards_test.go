@@ -0,0 +1,27 @@
+package solana
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+)
+
+const inflationRewardsSrc = `[{"epoch": 152, "effectiveSlot": 65664000, "amount": 2461, "postBalance": 1002461}, null]`
+
+func TestNormalizeReward(t *testing.T) {
+	var rewards []*InflationReward
+	err := json.Unmarshal([]byte(inflationRewardsSrc), &rewards)
+	assert.NoError(t, err)
+	assert.Nil(t, rewards[1])
+
+	expected := blockatlas.Reward{
+		ID:        "152",
+		Validator: "2Afu38M1KaSfDBpjZjnJb9BSWP6YkBkoPiBfnFedD7JW",
+		Value:     "2461",
+		Type:      blockatlas.RewardTypePayout,
+		Date:      1611000000,
+	}
+	assert.Equal(t, expected, NormalizeReward(*rewards[0], "2Afu38M1KaSfDBpjZjnJb9BSWP6YkBkoPiBfnFedD7JW", 1611000000))
+}
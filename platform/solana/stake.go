@@ -8,9 +8,13 @@ import (
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 	"github.com/trustwallet/blockatlas/pkg/logger"
 	"github.com/trustwallet/blockatlas/services/assets"
+	"math"
 	"strconv"
+	"time"
 )
 
+const slotDuration = 400 // in milliseconds
+
 func arrayOfPubkey(pubkey string) [32]byte {
 	var array [32]byte
 	copy(array[:], base58.Decode(pubkey))
@@ -45,6 +49,7 @@ func (p *Platform) GetValidators() (blockatlas.ValidatorPage, error) {
 	for _, v := range validators {
 		results = append(results, normalizeValidator(v, minimumBalance))
 	}
+	results.SetStakeShares()
 	return results, nil
 }
 
@@ -72,6 +77,11 @@ func normalizeValidator(v VoteAccount, minimumBalance uint64) (validator blockat
 			LockTime:      0,
 			Type:          blockatlas.DelegationTypeDelegate,
 		},
+		Metrics: &blockatlas.ValidatorMetrics{
+			Commission:    float64(v.Commission),
+			HasCommission: true,
+			VotingPower:   strconv.FormatUint(v.ActivatedStake, 10),
+		},
 	}
 }
 
@@ -148,7 +158,19 @@ func NormalizeDelegations(stakeAccounts []StakeData, validators blockatlas.Valid
 			Value:     strconv.FormatUint(stakeState.Stake, 10),
 			Status:    status,
 		}
+		if stakeState.DeactivationEpoch != math.MaxUint64 {
+			delegation.Status = blockatlas.DelegationStatusPending
+			delegation.Metadata = blockatlas.DelegationMetaDataPending{
+				AvailableDate: cooldownEnd(stakeState.DeactivationEpoch, epochInfo, time.Now().Unix()),
+			}
+		}
 		results = append(results, delegation)
 	}
 	return results, nil
 }
+
+// cooldownEnd estimates the date a deactivating stake becomes withdrawable, at the end of its deactivation epoch
+func cooldownEnd(deactivationEpoch uint64, epochInfo EpochInfo, now int64) uint {
+	remainingSlots := (deactivationEpoch-epochInfo.Epoch)*epochInfo.SlotsInEpoch + epochInfo.SlotsInEpoch - epochInfo.SlotIndex
+	return uint(now + int64(remainingSlots)*slotDuration/1000)
+}
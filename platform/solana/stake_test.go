@@ -46,6 +46,7 @@ var expectedValidators = []blockatlas.Validator{
 			LockTime:      0,
 			Type:          blockatlas.DelegationTypeDelegate,
 		},
+		Metrics: &blockatlas.ValidatorMetrics{Commission: 100, HasCommission: true, VotingPower: "3733867423940"},
 	},
 	blockatlas.Validator{
 		Status: true,
@@ -56,6 +57,7 @@ var expectedValidators = []blockatlas.Validator{
 			LockTime:      0,
 			Type:          blockatlas.DelegationTypeDelegate,
 		},
+		Metrics: &blockatlas.ValidatorMetrics{Commission: 100, HasCommission: true, VotingPower: "10540011934"},
 	},
 }
 
@@ -182,3 +184,18 @@ func TestNormalizeDelegations(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, delegation, result)
 }
+
+func TestNormalizeDelegations_Deactivating(t *testing.T) {
+	deactivating := stakeState
+	deactivating.DeactivationEpoch = 80
+	result, err := NormalizeDelegations([]StakeData{deactivating}, validatorMap, epochInfo)
+	assert.NoError(t, err)
+	assert.Len(t, result, 1)
+	assert.Equal(t, blockatlas.DelegationStatusPending, result[0].Status)
+	assert.IsType(t, blockatlas.DelegationMetaDataPending{}, result[0].Metadata)
+}
+
+func TestCooldownEnd(t *testing.T) {
+	assert.Equal(t, uint(1600000000+125*400/1000), cooldownEnd(80, epochInfo, 1600000000))
+	assert.Equal(t, uint(1600000000+(2048+125)*400/1000), cooldownEnd(81, epochInfo, 1600000000))
+}
@@ -1,10 +1,107 @@
 package solana
 
 import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/trustwallet/blockatlas/coin"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 )
 
+// GetTxsByAddress returns the stake program instructions signed by the address, the transfers aren't supported yet.
+// The transactions of the signatures are fetched in a single batch call.
 func (p *Platform) GetTxsByAddress(address string) (blockatlas.TxPage, error) {
+	signatures, err := p.client.GetSignaturesForAddress(address, blockatlas.TxPerPage)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(signatures))
+	for _, s := range signatures {
+		ids = append(ids, s.Signature)
+	}
+	srcTxs, err := p.client.GetConfirmedTransactions(ids)
+	if err != nil {
+		return nil, err
+	}
 	results := make(blockatlas.TxPage, 0)
+	for _, srcTx := range srcTxs {
+		if tx, ok := NormalizeStakeTx(srcTx); ok {
+			results = append(results, tx)
+		}
+	}
 	return results, nil
 }
+
+// NormalizeStakeTx converts the first stake instruction of the transaction. The delegated and the deactivated
+// values are the balance of the stake account since the whole account is staked.
+func NormalizeStakeTx(srcTx ConfirmedTransaction) (blockatlas.Tx, bool) {
+	message := srcTx.Transaction.Message
+	if len(srcTx.Transaction.Signatures) == 0 || len(message.AccountKeys) == 0 {
+		return blockatlas.Tx{}, false
+	}
+	for _, instruction := range message.Instructions {
+		if instruction.ProgramId != stakeProgramId {
+			continue
+		}
+		var stake StakeInstruction
+		if err := json.Unmarshal(instruction.Parsed, &stake); err != nil {
+			continue
+		}
+		tx := blockatlas.Tx{
+			ID:     srcTx.Transaction.Signatures[0],
+			Coin:   coin.SOL,
+			From:   message.AccountKeys[0].Pubkey,
+			Fee:    blockatlas.Amount(strconv.FormatUint(srcTx.Meta.Fee, 10)),
+			Date:   srcTx.BlockTime,
+			Block:  srcTx.Slot,
+			Status: blockatlas.StatusCompleted,
+			Type:   blockatlas.TxAnyAction,
+		}
+		if srcTx.Meta.Err != nil {
+			tx.Status = blockatlas.StatusError
+		}
+		var key blockatlas.KeyType
+		var title blockatlas.KeyTitle
+		var value uint64
+		switch stake.Type {
+		case StakeInstructionDelegate:
+			key, title = blockatlas.KeyStakeDelegate, blockatlas.AnyActionDelegation
+			tx.To = stake.Info.VoteAccount
+			tx.Direction = blockatlas.DirectionOutgoing
+			value = postBalance(srcTx, stake.Info.StakeAccount)
+		case StakeInstructionDeactivate:
+			key, title = blockatlas.KeyStakeUndelegate, blockatlas.AnyActionUndelegation
+			tx.To = stake.Info.StakeAccount
+			tx.Direction = blockatlas.DirectionIncoming
+			value = postBalance(srcTx, stake.Info.StakeAccount)
+		case StakeInstructionWithdraw:
+			key, title = blockatlas.KeyStakeWithdraw, blockatlas.AnyActionWithdraw
+			tx.From = stake.Info.StakeAccount
+			tx.To = stake.Info.Destination
+			tx.Direction = blockatlas.DirectionIncoming
+			value = stake.Info.Lamports
+		default:
+			continue
+		}
+		tx.Meta = blockatlas.AnyAction{
+			Coin:     coin.SOL,
+			Title:    title,
+			Key:      key,
+			Name:     coin.Coins[coin.SOL].Name,
+			Symbol:   coin.Coins[coin.SOL].Symbol,
+			Decimals: coin.Coins[coin.SOL].Decimals,
+			Value:    blockatlas.Amount(strconv.FormatUint(value, 10)),
+		}
+		return tx, true
+	}
+	return blockatlas.Tx{}, false
+}
+
+func postBalance(srcTx ConfirmedTransaction, pubkey string) uint64 {
+	for i, key := range srcTx.Transaction.Message.AccountKeys {
+		if key.Pubkey == pubkey && i < len(srcTx.Meta.PostBalances) {
+			return srcTx.Meta.PostBalances[i]
+		}
+	}
+	return 0
+}
@@ -0,0 +1,153 @@
+package solana
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/coin"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+)
+
+const delegateTxSrc = `
+{
+	"slot": 45243516,
+	"blockTime": 1603123456,
+	"meta": {"err": null, "fee": 5000, "preBalances": [3000000000, 2000000000, 1], "postBalances": [2999995000, 2000000000, 1]},
+	"transaction": {
+		"signatures": ["4Fy1vHSdRyNEjsW1R9C5TqqW9U6MY2Kg7WFQTdmS5T3Pd2ZrBWAgNEUG3b3CgXz9h4pWLtYQpKafvnnn9bjdSqXv"],
+		"message": {
+			"accountKeys": [
+				{"pubkey": "CwkQ4k1RBsZdjrMNgWx2Vd4M6LjEpQfE9ZVtq8fhhvBU", "signer": true, "writable": true},
+				{"pubkey": "7LuTdUmqzFYfYxTNwuWzK7WGDnAzH1gTgD4hAdPDRe7n", "signer": false, "writable": true},
+				{"pubkey": "Stake11111111111111111111111111111111111111", "signer": false, "writable": false}
+			],
+			"instructions": [
+				{"program": "spl-memo", "programId": "Memo1UhkJRfHyvLMcVucJwxXeuD728EqVDDwQDxFMNo", "parsed": "stake"},
+				{
+					"program": "stake",
+					"programId": "Stake11111111111111111111111111111111111111",
+					"parsed": {
+						"type": "delegate",
+						"info": {
+							"stakeAccount": "7LuTdUmqzFYfYxTNwuWzK7WGDnAzH1gTgD4hAdPDRe7n",
+							"voteAccount": "9QU2QSxhb24FUX3Tu2FpczXjpK3VYrvRudywSZaM29mF",
+							"stakeAuthority": "CwkQ4k1RBsZdjrMNgWx2Vd4M6LjEpQfE9ZVtq8fhhvBU"
+						}
+					}
+				}
+			]
+		}
+	}
+}`
+
+const withdrawTxSrc = `
+{
+	"slot": 45243999,
+	"blockTime": 1603223456,
+	"meta": {"err": {"InstructionError": [0, "InsufficientFunds"]}, "fee": 5000, "preBalances": [], "postBalances": []},
+	"transaction": {
+		"signatures": ["2EXqv6aVHZnKUnAh1cuzK2HKA8zb5QF1hWmLTdhLWUTsHvYbDvbV1uSAh6b4nfNnU6xFXHUh3bGd3QqBHQUDbvxh"],
+		"message": {
+			"accountKeys": [{"pubkey": "CwkQ4k1RBsZdjrMNgWx2Vd4M6LjEpQfE9ZVtq8fhhvBU", "signer": true, "writable": true}],
+			"instructions": [
+				{
+					"program": "stake",
+					"programId": "Stake11111111111111111111111111111111111111",
+					"parsed": {
+						"type": "withdraw",
+						"info": {
+							"stakeAccount": "7LuTdUmqzFYfYxTNwuWzK7WGDnAzH1gTgD4hAdPDRe7n",
+							"destination": "CwkQ4k1RBsZdjrMNgWx2Vd4M6LjEpQfE9ZVtq8fhhvBU",
+							"lamports": 1500000000
+						}
+					}
+				}
+			]
+		}
+	}
+}`
+
+func TestNormalizeStakeTx(t *testing.T) {
+	var delegate, withdraw ConfirmedTransaction
+	assert.NoError(t, json.Unmarshal([]byte(delegateTxSrc), &delegate))
+	assert.NoError(t, json.Unmarshal([]byte(withdrawTxSrc), &withdraw))
+
+	tx, ok := NormalizeStakeTx(delegate)
+	assert.True(t, ok)
+	assert.Equal(t, blockatlas.Tx{
+		ID:        "4Fy1vHSdRyNEjsW1R9C5TqqW9U6MY2Kg7WFQTdmS5T3Pd2ZrBWAgNEUG3b3CgXz9h4pWLtYQpKafvnnn9bjdSqXv",
+		Coin:      coin.SOL,
+		From:      "CwkQ4k1RBsZdjrMNgWx2Vd4M6LjEpQfE9ZVtq8fhhvBU",
+		To:        "9QU2QSxhb24FUX3Tu2FpczXjpK3VYrvRudywSZaM29mF",
+		Fee:       "5000",
+		Date:      1603123456,
+		Block:     45243516,
+		Status:    blockatlas.StatusCompleted,
+		Type:      blockatlas.TxAnyAction,
+		Direction: blockatlas.DirectionOutgoing,
+		Meta: blockatlas.AnyAction{
+			Coin:     coin.SOL,
+			Title:    blockatlas.AnyActionDelegation,
+			Key:      blockatlas.KeyStakeDelegate,
+			Name:     "Solana",
+			Symbol:   "SOL",
+			Decimals: 9,
+			Value:    "2000000000",
+		},
+	}, tx)
+
+	tx, ok = NormalizeStakeTx(withdraw)
+	assert.True(t, ok)
+	assert.Equal(t, blockatlas.StatusError, tx.Status)
+	assert.Equal(t, "7LuTdUmqzFYfYxTNwuWzK7WGDnAzH1gTgD4hAdPDRe7n", tx.From)
+	assert.Equal(t, "CwkQ4k1RBsZdjrMNgWx2Vd4M6LjEpQfE9ZVtq8fhhvBU", tx.To)
+	assert.Equal(t, blockatlas.KeyStakeWithdraw, tx.Meta.(blockatlas.AnyAction).Key)
+	assert.Equal(t, blockatlas.Amount("1500000000"), tx.Meta.(blockatlas.AnyAction).Value)
+
+	delegate.Transaction.Message.Instructions = delegate.Transaction.Message.Instructions[:1]
+	_, ok = NormalizeStakeTx(delegate)
+	assert.False(t, ok)
+}
+
+func TestPlatform_GetTxsByAddress(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		var body json.RawMessage
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		if body[0] != '[' {
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":[{"signature":"s1"},{"signature":"s2"},{"signature":"s3"}]}`))
+			return
+		}
+		var requests []blockatlas.RpcRequest
+		assert.NoError(t, json.Unmarshal(body, &requests))
+		assert.Len(t, requests, 3)
+		txs := map[string]string{"s1": withdrawTxSrc, "s3": delegateTxSrc}
+		responses := make([]json.RawMessage, 0)
+		// the responses of a batch aren't ordered
+		for i := len(requests) - 1; i >= 0; i-- {
+			params := requests[i].Params.([]interface{})
+			assert.Equal(t, "getConfirmedTransaction", requests[i].Method)
+			assert.Equal(t, "jsonParsed", params[1])
+			id, _ := json.Marshal(requests[i].Id)
+			if src, ok := txs[params[0].(string)]; ok {
+				responses = append(responses, json.RawMessage(`{"jsonrpc":"2.0","id":`+string(id)+`,"result":`+src+`}`))
+			} else {
+				responses = append(responses, json.RawMessage(`{"jsonrpc":"2.0","id":`+string(id)+`,"error":{"code":-32004,"message":"not found"}}`))
+			}
+		}
+		_ = json.NewEncoder(w).Encode(responses)
+	}))
+	defer server.Close()
+	p := Init(server.URL)
+
+	txs, err := p.GetTxsByAddress("CwkQ4k1RBsZdjrMNgWx2Vd4M6LjEpQfE9ZVtq8fhhvBU")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, calls)
+	assert.Len(t, txs, 2)
+	assert.Equal(t, blockatlas.KeyStakeWithdraw, txs[0].Meta.(blockatlas.AnyAction).Key)
+	assert.Equal(t, blockatlas.KeyStakeDelegate, txs[1].Meta.(blockatlas.AnyAction).Key)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 	"net/url"
+	"strconv"
 	"strings"
 )
 
@@ -21,6 +22,18 @@ func (c *Client) GetTxsOfAddress(address string, txType []string) (txs ExplorerA
 	return
 }
 
+// GetTxsOfAddressPage returns the page of the operations of the address, the latest first
+func (c *Client) GetTxsOfAddressPage(address string, txType []string, page int) (txs ExplorerAccount, err error) {
+	path := fmt.Sprintf("account/%s/op", address)
+	err = c.Get(&txs, path, url.Values{
+		"order":  {"desc"},
+		"type":   {strings.Join(txType, ",")},
+		"limit":  {strconv.Itoa(blockatlas.RewardsPerPage)},
+		"offset": {strconv.Itoa((page - 1) * blockatlas.RewardsPerPage)},
+	})
+	return
+}
+
 // Get last indexed block by explorer
 func (c *Client) GetCurrentBlock() (int64, error) {
 	var status Status
@@ -49,6 +49,16 @@ type (
 
 	Validator struct {
 		Address string `json:"pkh"`
+		Rolls   int64  `json:"rolls"`
+	}
+
+	// Constants are the protocol parameters, the rewards are listed by the priority of the block or endorsement
+	Constants struct {
+		TimeBetweenBlocks          []string `json:"time_between_blocks"`
+		EndorsersPerBlock          int64    `json:"endorsers_per_block"`
+		TokensPerRoll              string   `json:"tokens_per_roll"`
+		BakingRewardPerEndorsement []string `json:"baking_reward_per_endorsement"`
+		EndorsementReward          []string `json:"endorsement_reward"`
 	}
 
 	ActivityValidatorInfo struct {
@@ -0,0 +1,50 @@
+package tezos
+
+import (
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/numbers"
+	"github.com/trustwallet/blockatlas/services/assets"
+)
+
+// GetPendingRewards returns no rewards, bakers pay the rewards of their delegators themselves
+func (p *Platform) GetPendingRewards(address string) (blockatlas.PendingRewards, error) {
+	return make(blockatlas.PendingRewards, 0), nil
+}
+
+// GetRewardsHistory returns the payouts of the bakers found in the page of the transactions of the address.
+// Payouts sent from an address other than the baker one are not recognized
+func (p *Platform) GetRewardsHistory(address string, page int) (blockatlas.RewardsPage, error) {
+	txs, err := p.client.GetTxsOfAddressPage(address, []string{TxTypeTransaction}, page)
+	if err != nil {
+		return nil, err
+	}
+	validators, err := assets.GetValidatorsMap(p)
+	if err != nil {
+		return nil, err
+	}
+	bakers := make(map[string]bool, len(validators)+1)
+	for id := range validators {
+		bakers[id] = true
+	}
+	if account, err := p.rpcClient.GetAccount(address); err == nil && account.Delegate != "" {
+		bakers[account.Delegate] = true
+	}
+	return NormalizeRewards(address, txs.Transactions, bakers), nil
+}
+
+func NormalizeRewards(address string, txs []Transaction, bakers map[string]bool) blockatlas.RewardsPage {
+	results := make(blockatlas.RewardsPage, 0)
+	for _, tx := range txs {
+		if tx.Type != TxTypeTransaction || tx.Receiver != address || !bakers[tx.Sender] || tx.Status() != blockatlas.StatusCompleted {
+			continue
+		}
+		results = append(results, blockatlas.Reward{
+			ID:        tx.Hash,
+			Validator: tx.Sender,
+			Value:     numbers.DecimalExp(numbers.Float64toString(tx.Volume), 6),
+			Type:      blockatlas.RewardTypePayout,
+			Date:      tx.BlockTimestamp(),
+		})
+	}
+	return results
+}
@@ -0,0 +1,37 @@
+package tezos
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+)
+
+const rewardTxsSrc = `
+{
+  "ops": [
+    {"hash": "oo1", "type": "transaction", "status": "applied", "is_success": true, "time": "2020-03-01T10:00:00Z", "sender": "tz2FCNBrERXtaTtNX6iimR1UJ5JSDxvdHM93", "receiver": "tz1WCd2jm4uSt4vntk4vSuUWoZQGhLcDuR9q", "volume": 1.234567},
+    {"hash": "oo2", "type": "transaction", "status": "applied", "is_success": true, "time": "2020-02-27T10:00:00Z", "sender": "tz1S5WxdZR5f9NzsPXhr7L9L1vrEb5spZFur", "receiver": "tz1WCd2jm4uSt4vntk4vSuUWoZQGhLcDuR9q", "volume": 10},
+    {"hash": "oo3", "type": "transaction", "status": "failed", "is_success": false, "time": "2020-02-26T10:00:00Z", "sender": "tz2FCNBrERXtaTtNX6iimR1UJ5JSDxvdHM93", "receiver": "tz1WCd2jm4uSt4vntk4vSuUWoZQGhLcDuR9q", "volume": 1},
+    {"hash": "oo4", "type": "transaction", "status": "applied", "is_success": true, "time": "2020-02-25T10:00:00Z", "sender": "tz1WCd2jm4uSt4vntk4vSuUWoZQGhLcDuR9q", "receiver": "tz2FCNBrERXtaTtNX6iimR1UJ5JSDxvdHM93", "volume": 1}
+  ]
+}`
+
+func TestNormalizeRewards(t *testing.T) {
+	var account ExplorerAccount
+	err := json.Unmarshal([]byte(rewardTxsSrc), &account)
+	assert.NoError(t, err)
+
+	bakers := map[string]bool{"tz2FCNBrERXtaTtNX6iimR1UJ5JSDxvdHM93": true}
+	expected := blockatlas.RewardsPage{
+		{
+			ID:        "oo1",
+			Validator: "tz2FCNBrERXtaTtNX6iimR1UJ5JSDxvdHM93",
+			Value:     "1234567",
+			Type:      blockatlas.RewardTypePayout,
+			Date:      1583056800,
+		},
+	}
+	assert.Equal(t, expected, NormalizeRewards("tz1WCd2jm4uSt4vntk4vSuUWoZQGhLcDuR9q", account.Transactions, bakers))
+}
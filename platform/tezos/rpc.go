@@ -26,6 +26,11 @@ func (c *RpcClient) GetPeriodType() (periodType PeriodType, err error) {
 	return
 }
 
+func (c *RpcClient) GetConstants() (constants Constants, err error) {
+	err = c.GetWithCache(&constants, "chains/main/blocks/head/context/constants", nil, time.Hour)
+	return
+}
+
 func (c *RpcClient) GetAccount(address string) (account Account, err error) {
 	err = c.Get(&account, "chains/main/blocks/head/context/contracts/"+address, nil)
 	return
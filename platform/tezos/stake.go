@@ -1,12 +1,20 @@
 package tezos
 
 import (
+	"github.com/trustwallet/blockatlas/coin"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/errors"
 	"github.com/trustwallet/blockatlas/pkg/logger"
 	"github.com/trustwallet/blockatlas/services/assets"
+	"github.com/trustwallet/blockatlas/services/economics"
+	"math/big"
+	"strconv"
 )
 
+const secondsPerYear = 365 * 24 * 3600
+
 const (
+	// Annual is the yield served until the economics job computes it from the chain
 	Annual             = 6.09
 	LockTime           = 0
 	MinimumStakeAmount = "0"
@@ -35,6 +43,7 @@ func (p *Platform) isValidatorActive(id string) bool {
 	return !res.Deactivated
 }
 
+// GetDelegations reports the baker of the account, the balance is never locked and so never pending
 func (p *Platform) GetDelegations(address string) (blockatlas.DelegationsPage, error) {
 	account, err := p.rpcClient.GetAccount(address)
 	if err != nil {
@@ -74,9 +83,15 @@ func (p *Platform) GetValidators() (blockatlas.ValidatorPage, error) {
 		return results, err
 	}
 
+	// the voting power is only reported in rolls without the constants
+	constants, err := p.rpcClient.GetConstants()
+	if err != nil {
+		logger.Error(err, "Tezos constants")
+	}
 	for _, v := range validators {
-		results = append(results, normalizeValidator(v))
+		results = append(results, normalizeValidator(v, constants.TokensPerRoll))
 	}
+	results.SetStakeShares()
 	return results, nil
 }
 
@@ -106,23 +121,84 @@ func (p *Platform) UndelegatedBalance(address string) (string, error) {
 	return account.Balance, nil
 }
 
+func (p *Platform) GetEconomics() (blockatlas.StakingEconomics, error) {
+	constants, err := p.rpcClient.GetConstants()
+	if err != nil {
+		return blockatlas.StakingEconomics{}, err
+	}
+	validators, err := p.getCurrentValidators()
+	if err != nil {
+		return blockatlas.StakingEconomics{}, err
+	}
+	return NormalizeEconomics(constants, validators)
+}
+
+// NormalizeEconomics spreads the rewards of a year of blocks baked and endorsed at the best priority over the rolls.
+// The bakers pay the delegators themselves, their fee is applied from the registry like the commissions
+func NormalizeEconomics(constants Constants, validators []Validator) (blockatlas.StakingEconomics, error) {
+	if len(constants.TimeBetweenBlocks) == 0 || len(constants.BakingRewardPerEndorsement) == 0 || len(constants.EndorsementReward) == 0 {
+		return blockatlas.StakingEconomics{}, errors.E("missing reward constants", errors.TypePlatformUnmarshal)
+	}
+	blockTime, err := strconv.ParseFloat(constants.TimeBetweenBlocks[0], 64)
+	if err != nil || blockTime == 0 {
+		return blockatlas.StakingEconomics{}, errors.E("invalid time between blocks", errors.TypePlatformUnmarshal)
+	}
+	bakingReward, err := strconv.ParseFloat(constants.BakingRewardPerEndorsement[0], 64)
+	if err != nil {
+		return blockatlas.StakingEconomics{}, errors.E(err, errors.TypePlatformUnmarshal)
+	}
+	endorsementReward, err := strconv.ParseFloat(constants.EndorsementReward[0], 64)
+	if err != nil {
+		return blockatlas.StakingEconomics{}, errors.E(err, errors.TypePlatformUnmarshal)
+	}
+	tokensPerRoll, err := strconv.ParseFloat(constants.TokensPerRoll, 64)
+	if err != nil {
+		return blockatlas.StakingEconomics{}, errors.E(err, errors.TypePlatformUnmarshal)
+	}
+	var rolls int64
+	for _, v := range validators {
+		rolls += v.Rolls
+	}
+	if rolls == 0 {
+		return blockatlas.StakingEconomics{}, errors.E("no rolls staked", errors.TypePlatformUnmarshal)
+	}
+
+	blocksPerYear := secondsPerYear / blockTime
+	rewardPerBlock := float64(constants.EndorsersPerBlock) * (bakingReward + endorsementReward)
+	annual := blocksPerYear * rewardPerBlock / (float64(rolls) * tokensPerRoll) * 100
+
+	result := blockatlas.StakingEconomics{
+		Annual:     annual,
+		Validators: make(map[string]float64, len(validators)),
+	}
+	for _, v := range validators {
+		result.Validators[v.Address] = annual
+	}
+	return result, nil
+}
+
 func getDetails() blockatlas.StakingDetails {
 	return blockatlas.StakingDetails{
-		Reward:        blockatlas.StakingReward{Annual: Annual},
+		Reward:        blockatlas.StakingReward{Annual: economics.Annual(coin.Coins[coin.XTZ].Handle, Annual)},
 		MinimumAmount: MinimumStakeAmount,
 		LockTime:      LockTime,
 		Type:          blockatlas.DelegationTypeDelegate,
 	}
 }
 
-func normalizeValidator(v Validator) (validator blockatlas.Validator) {
-	// How to calculate Tezos APR? I have no idea. Tezos team does not know either. let's assume it's around 7% - no way to calculate in decentralized manner
-	// Delegation rewards distributed by the validators manually, it's up to them to do it.
-	return blockatlas.Validator{
+func normalizeValidator(v Validator, tokensPerRoll string) (validator blockatlas.Validator) {
+	// Delegation rewards are distributed by the validators manually, the yield is the one of the network
+	validator = blockatlas.Validator{
 		Status:  true,
 		ID:      v.Address,
 		Details: getDetails(),
 	}
+	if perRoll, ok := new(big.Int).SetString(tokensPerRoll, 10); ok {
+		validator.Metrics = &blockatlas.ValidatorMetrics{
+			VotingPower: new(big.Int).Mul(perRoll, big.NewInt(v.Rolls)).String(),
+		}
+	}
+	return validator
 }
 
 func getUnknownValidator(address string) blockatlas.StakeValidator {
@@ -135,7 +211,7 @@ func getUnknownValidator(address string) blockatlas.StakeValidator {
 		},
 		Details: blockatlas.StakingDetails{
 			Reward: blockatlas.StakingReward{
-				Annual: Annual,
+				Annual: economics.Annual(coin.Coins[coin.XTZ].Handle, Annual),
 			},
 			LockTime:      LockTime,
 			MinimumAmount: MinimumStakeAmount,
@@ -62,8 +62,11 @@ func TestNormalizeValidator(t *testing.T) {
 	var v []Validator
 	err := json.Unmarshal([]byte(validatorSrc), &v)
 	assert.Nil(t, err)
-	result := normalizeValidator(v[0])
+	result := normalizeValidator(v[0], "")
 	assert.Equal(t, validator, result)
+
+	result = normalizeValidator(v[0], "8000000000")
+	assert.Equal(t, &blockatlas.ValidatorMetrics{VotingPower: "29808000000000"}, result.Metrics)
 }
 
 func TestNormalizeDelegations(t *testing.T) {
@@ -98,3 +101,28 @@ func createMockedAPI() http.Handler {
 
 	return r
 }
+
+const constantsSrc = `
+{
+	"time_between_blocks": ["60", "40"],
+	"endorsers_per_block": 32,
+	"tokens_per_roll": "8000000000",
+	"baking_reward_per_endorsement": ["1250000", "187500"],
+	"endorsement_reward": ["1250000", "833333"]
+}`
+
+func TestNormalizeEconomics(t *testing.T) {
+	var constants Constants
+	assert.NoError(t, json.Unmarshal([]byte(constantsSrc), &constants))
+	validators := []Validator{{Address: "tz1a", Rolls: 50000}, {Address: "tz1b", Rolls: 30000}}
+
+	result, err := NormalizeEconomics(constants, validators)
+	assert.NoError(t, err)
+	assert.InDelta(t, 6.57, result.Annual, 0.0001)
+	assert.Equal(t, map[string]float64{"tz1a": result.Annual, "tz1b": result.Annual}, result.Validators)
+
+	_, err = NormalizeEconomics(constants, nil)
+	assert.Error(t, err)
+	_, err = NormalizeEconomics(Constants{}, validators)
+	assert.Error(t, err)
+}
@@ -60,10 +60,14 @@ func NormalizeTx(srcTx Transaction, address string) (blockatlas.Tx, bool) {
 		if !ok {
 			return tx, false
 		}
+		key := blockatlas.KeyStakeDelegate
+		if title == blockatlas.AnyActionUndelegation {
+			key = blockatlas.KeyStakeUndelegate
+		}
 		tx.Meta = blockatlas.AnyAction{
 			Coin:     coin.Tezos().ID,
 			Title:    title,
-			Key:      blockatlas.KeyStakeDelegate,
+			Key:      key,
 			Name:     coin.Tezos().Name,
 			Symbol:   coin.Tezos().Symbol,
 			Decimals: coin.Tezos().Decimals,
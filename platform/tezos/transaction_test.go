@@ -21,6 +21,40 @@ var (
 		//Errors: {{ID: "proto.005-PsBabyM1.delegate.unchanged"}, Kind: "temporary"}
 	}
 
+	tezosUndelegation = Transaction{
+		Hash:      "ooYNZgmrAvA1bk8wcuR6ptUzHdDi1ijpGUq8UT1bHMxK38jP4hp",
+		Type:      TxTypeDelegation,
+		Time:      "2020-03-02T10:11:24Z",
+		Height:    848012,
+		Stat:      "applied",
+		IsSuccess: true,
+		Fee:       0.00135,
+		Sender:    addr1,
+		Receiver:  addr2,
+	}
+
+	normalizedTezosUndelegation = blockatlas.Tx{
+		ID:        "ooYNZgmrAvA1bk8wcuR6ptUzHdDi1ijpGUq8UT1bHMxK38jP4hp",
+		Coin:      1729,
+		From:      addr1,
+		To:        addr2,
+		Fee:       "1350",
+		Date:      1583143884,
+		Block:     848012,
+		Status:    "completed",
+		Type:      blockatlas.TxAnyAction,
+		Direction: "outgoing",
+		Meta: blockatlas.AnyAction{
+			Coin:     1729,
+			Title:    blockatlas.AnyActionUndelegation,
+			Key:      blockatlas.KeyStakeUndelegate,
+			Name:     "Tezos",
+			Symbol:   "XTZ",
+			Decimals: 6,
+			Value:    "0",
+		},
+	}
+
 	normalizedTezosTransfer = blockatlas.Tx{
 		ID:        "op6GzJ3a3wGJTu4KuD2WNCVJdwEU5WKDXV6EyjsBYMEjyPQWozF",
 		Coin:      1729,
@@ -49,6 +83,7 @@ func TestNormalizeTx(t *testing.T) {
 		address string
 	}{
 		{"Normalize XTZ transfer", tezosTransfer, normalizedTezosTransfer, addr1},
+		{"Normalize XTZ undelegation", tezosUndelegation, normalizedTezosUndelegation, addr1},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
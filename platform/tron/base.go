@@ -3,11 +3,22 @@ package tron
 import (
 	"github.com/trustwallet/blockatlas/coin"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/platform/ethereum/nft"
+)
+
+const (
+	// jsonRPCBlockRange is the largest eth_getLogs range accepted by the JSON-RPC of the TRON nodes
+	jsonRPCBlockRange = 5000
+
+	// defaultCollectiblesStartBlock is the first block scanned for the TRC-721 transfers when tron.collectibles_start_block
+	// isn't set. Scanning the whole chain takes more than 10k calls in ranges of jsonRPCBlockRange.
+	defaultCollectiblesStartBlock = 60000000
 )
 
 type Platform struct {
 	client         Client
 	explorerClient ExplorerClient
+	collectibles   nft.Client
 }
 
 func Init(api, explorerApi string) *Platform {
@@ -17,6 +28,19 @@ func Init(api, explorerApi string) *Platform {
 	}
 }
 
+// InitWithCollection serves the TRC-721 collectibles through the JSON-RPC of the api, the ownership is read from
+// the transfers since the start block and the tokens received before aren't listed
+func InitWithCollection(api, explorerApi, gateway string, startBlock int64) *Platform {
+	platform := Init(api, explorerApi)
+	if startBlock <= 0 {
+		startBlock = defaultCollectiblesStartBlock
+	}
+	platform.collectibles = nft.InitClient(coin.TRX, api+"/jsonrpc", gateway, codec{})
+	platform.collectibles.Logs.BlockRange = jsonRPCBlockRange
+	platform.collectibles.Logs.StartBlock = startBlock
+	return platform
+}
+
 func (p *Platform) Coin() coin.Coin {
 	return coin.Coins[coin.TRX]
 }
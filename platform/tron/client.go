@@ -5,9 +5,17 @@ import (
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 	"github.com/trustwallet/blockatlas/pkg/errors"
 	"net/url"
+	"strconv"
 	"time"
 )
 
+const (
+	txsPageLimit     = 25
+	outgoingTxsLimit = 200
+	// maxRewardsTxsPages bounds the outgoing transactions walked to find the withdrawals of a page of rewards
+	maxRewardsTxsPages = 25
+)
+
 type (
 	Client struct {
 		blockatlas.Request
@@ -38,7 +46,7 @@ func (c *Client) fetchTxsOfAddress(address, token string) ([]Tx, error) {
 
 	var txs Page
 	err := c.Get(&txs, path, url.Values{
-		"limit":    {"25"},
+		"limit":    {strconv.Itoa(txsPageLimit)},
 		"token_id": {token},
 		"order_by": {"block_timestamp,desc"},
 	})
@@ -57,6 +65,38 @@ func (c *Client) fetchAccountVotes(address string) (account *AccountData, err er
 	return
 }
 
+func (c *Client) fetchReward(address string) (reward Reward, err error) {
+	err = c.Post(&reward, "wallet/getReward", RewardRequest{Address: address, Visible: true})
+	return
+}
+
+// fetchOutgoingTxs returns the last outgoingTxsLimit transactions sent by the address
+func (c *Client) fetchOutgoingTxs(address string) ([]Tx, error) {
+	page, err := c.fetchOutgoingTxsPage(address, "")
+	return page.Txs, err
+}
+
+// fetchOutgoingTxsPage returns a page of outgoingTxsLimit transactions sent by the address, the next page is
+// requested with the fingerprint of the previous one. The pages are cached, a walk reuses the pages of the last one
+func (c *Client) fetchOutgoingTxsPage(address, fingerprint string) (page Page, err error) {
+	path := fmt.Sprintf("v1/accounts/%s/transactions", url.PathEscape(address))
+	query := url.Values{
+		"limit":     {strconv.Itoa(outgoingTxsLimit)},
+		"only_from": {"true"},
+		"order_by":  {"block_timestamp,desc"},
+	}
+	if fingerprint != "" {
+		query.Set("fingerprint", fingerprint)
+	}
+	err = c.GetWithCache(&page, path, query, time.Minute)
+	return
+}
+
+func (c *Client) fetchTxInfo(id string) (info TxInfo, err error) {
+	err = c.Post(&info, "wallet/gettransactioninfobyid", TxInfoRequest{Value: id})
+	return
+}
+
 func (c *Client) fetchTokenInfo(id string) (asset Asset, err error) {
 	path := fmt.Sprintf("v1/assets/%s", id)
 	err = c.GetWithCache(&asset, path, nil, time.Hour*24)
@@ -68,6 +108,11 @@ func (c *Client) fetchValidators() (validators Validators, err error) {
 	return
 }
 
+func (c *Client) fetchChainParameters() (parameters ChainParameters, err error) {
+	err = c.GetWithCache(&parameters, "wallet/getchainparameters", nil, time.Hour)
+	return
+}
+
 func (c *Client) fetchTRC20Transactions(address string) (TRC20Transactions, error) {
 	var result TRC20Transactions
 	path := fmt.Sprintf("v1/accounts/%s/transactions/trc20", address)
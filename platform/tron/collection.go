@@ -0,0 +1,87 @@
+package tron
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/trustwallet/blockatlas/pkg/address"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/errors"
+	"github.com/trustwallet/blockatlas/platform/ethereum/nft"
+)
+
+const (
+	addressPrefix = 0x41
+	typeTRC721    = "TRC721"
+)
+
+// codec converts the base58 addresses to the hex form of the TRON JSON-RPC
+type codec struct{}
+
+func (codec) ToHex(addr string) (string, error) {
+	b := base58.Decode(addr)
+	if len(b) != 25 || b[0] != addressPrefix {
+		return "", errors.E("invalid address", errors.Params{"address": addr})
+	}
+	return "0x" + hex.EncodeToString(b[1:21]), nil
+}
+
+func (codec) FromHex(hexAddress string) string {
+	b58, err := address.HexToAddress("41" + strings.ToLower(address.Remove0x(hexAddress)))
+	if err != nil {
+		return hexAddress
+	}
+	return b58
+}
+
+func (p *Platform) GetCollections(owner string) (blockatlas.CollectionPage, error) {
+	page, err := p.collectibles.GetCollections(owner)
+	if err != nil {
+		return nil, err
+	}
+	for i := range page {
+		page[i].Type = tronType(page[i].Type)
+	}
+	return page, nil
+}
+
+func (p *Platform) GetCollectibles(owner, collectibleID string) (blockatlas.CollectiblePage, error) {
+	page, err := p.collectibles.GetCollectibles(owner, collectibleID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range page {
+		page[i].Type = tronType(page[i].Type)
+	}
+	return page, nil
+}
+
+func (p *Platform) GetCollectionsV3(owner string) (blockatlas.CollectionPageV3, error) {
+	page, err := p.collectibles.GetCollectionsV3(owner)
+	if err != nil {
+		return nil, err
+	}
+	for i := range page {
+		page[i].Type = tronType(page[i].Type)
+	}
+	return page, nil
+}
+
+func (p *Platform) GetCollectiblesV3(owner, collectibleID string) (blockatlas.CollectiblePageV3, error) {
+	page, err := p.collectibles.GetCollectiblesV3(owner, collectibleID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range page {
+		page[i].Type = tronType(page[i].Type)
+	}
+	return page, nil
+}
+
+func tronType(t string) string {
+	if t == nft.TypeERC721 {
+		return typeTRC721
+	}
+	return t
+}
@@ -0,0 +1,17 @@
+package tron
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCodec(t *testing.T) {
+	hex, err := codec{}.ToHex("TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t")
+	assert.Nil(t, err)
+	assert.Equal(t, "0xa614f803b6fd780986a42c78ec9c7f77e6ded13c", hex)
+	assert.Equal(t, "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t", codec{}.FromHex(hex))
+
+	_, err = codec{}.ToHex("0xa614f803b6fd780986a42c78ec9c7f77e6ded13c")
+	assert.NotNil(t, err)
+}
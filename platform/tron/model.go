@@ -28,9 +28,15 @@ type (
 	}
 
 	Page struct {
-		Success bool   `json:"success"`
-		Error   string `json:"error,omitempty"`
-		Txs     []Tx   `json:"data"`
+		Success bool     `json:"success"`
+		Error   string   `json:"error,omitempty"`
+		Txs     []Tx     `json:"data"`
+		Meta    PageMeta `json:"meta"`
+	}
+
+	// PageMeta holds the cursor of the next page, the fingerprint is missing on the last page
+	PageMeta struct {
+		Fingerprint string `json:"fingerprint,omitempty"`
 	}
 
 	Tx struct {
@@ -58,6 +64,9 @@ type (
 		OwnerAddress string            `json:"owner_address"`
 		ToAddress    string            `json:"to_address"`
 		AssetName    string            `json:"asset_name,omitempty"`
+		// FrozenBalance is the amount of a freeze, Votes the votes of a vote for witnesses
+		FrozenBalance int64   `json:"frozen_balance,omitempty"`
+		Votes         []Votes `json:"votes,omitempty"`
 	}
 
 	Account struct {
@@ -102,7 +111,19 @@ type (
 	}
 
 	Validator struct {
-		Address string `json:"address"`
+		Address       string `json:"address"`
+		VoteCount     int64  `json:"voteCount"`
+		TotalProduced int64  `json:"totalProduced"`
+		TotalMissed   int64  `json:"totalMissed"`
+	}
+
+	ChainParameters struct {
+		Parameters []ChainParameter `json:"chainParameter"`
+	}
+
+	ChainParameter struct {
+		Key   string `json:"key"`
+		Value int64  `json:"value"`
 	}
 
 	VotesRequest struct {
@@ -131,6 +152,25 @@ type (
 		Address  string `json:"address"`
 	}
 
+	RewardRequest struct {
+		Address string `json:"address"`
+		Visible bool   `json:"visible"`
+	}
+
+	Reward struct {
+		Reward int64 `json:"reward"`
+	}
+
+	TxInfoRequest struct {
+		Value string `json:"value"`
+	}
+
+	TxInfo struct {
+		ID             string `json:"id"`
+		WithdrawAmount int64  `json:"withdraw_amount"`
+		UnfreezeAmount int64  `json:"unfreeze_amount"`
+	}
+
 	ExplorerResponse struct {
 		ExplorerTrc20Tokens []ExplorerTrc20Tokens `json:"trc20token_balances"`
 	}
@@ -146,4 +186,8 @@ type (
 const (
 	TransferContract      ContractType = "TransferContract"
 	TransferAssetContract ContractType = "TransferAssetContract"
+	WithdrawContract      ContractType = "WithdrawBalanceContract"
+	FreezeContract        ContractType = "FreezeBalanceContract"
+	UnfreezeContract      ContractType = "UnfreezeBalanceContract"
+	VoteContract          ContractType = "VoteWitnessContract"
 )
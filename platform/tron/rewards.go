@@ -0,0 +1,75 @@
+package tron
+
+import (
+	"strconv"
+
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/logger"
+)
+
+// GetPendingRewards returns the unclaimed voting rewards, tron doesn't split them by witness
+func (p *Platform) GetPendingRewards(address string) (blockatlas.PendingRewards, error) {
+	reward, err := p.client.fetchReward(address)
+	if err != nil {
+		return nil, err
+	}
+	if reward.Reward <= 0 {
+		return make(blockatlas.PendingRewards, 0), nil
+	}
+	return blockatlas.PendingRewards{{Value: strconv.FormatInt(reward.Reward, 10)}}, nil
+}
+
+// GetRewardsHistory returns the reward withdrawals among the transactions sent by the address. The outgoing
+// transactions are walked page by page until the requested page of withdrawals is complete
+func (p *Platform) GetRewardsHistory(address string, page int) (blockatlas.RewardsPage, error) {
+	start := (page - 1) * blockatlas.RewardsPerPage
+	end := start + blockatlas.RewardsPerPage
+	withdrawals := make([]Tx, 0)
+	fingerprint := ""
+	for i := 0; i < maxRewardsTxsPages && len(withdrawals) < end; i++ {
+		txsPage, err := p.client.fetchOutgoingTxsPage(address, fingerprint)
+		if err != nil {
+			return nil, err
+		}
+		withdrawals = append(withdrawals, filterWithdrawals(txsPage.Txs)...)
+		fingerprint = txsPage.Meta.Fingerprint
+		if fingerprint == "" {
+			break
+		}
+	}
+	if start >= len(withdrawals) {
+		return make(blockatlas.RewardsPage, 0), nil
+	}
+	if end > len(withdrawals) {
+		end = len(withdrawals)
+	}
+	results := make(blockatlas.RewardsPage, 0, end-start)
+	for _, tx := range withdrawals[start:end] {
+		info, err := p.client.fetchTxInfo(tx.ID)
+		if err != nil {
+			logger.Error(err, "Tron: Failed to get the withdrawal amount", logger.Params{"tx": tx.ID})
+			continue
+		}
+		results = append(results, NormalizeReward(tx, info))
+	}
+	return results, nil
+}
+
+func filterWithdrawals(txs []Tx) []Tx {
+	results := make([]Tx, 0)
+	for _, tx := range txs {
+		if len(tx.Data.Contracts) > 0 && tx.Data.Contracts[0].Type == WithdrawContract {
+			results = append(results, tx)
+		}
+	}
+	return results
+}
+
+func NormalizeReward(tx Tx, info TxInfo) blockatlas.Reward {
+	return blockatlas.Reward{
+		ID:    tx.ID,
+		Value: strconv.FormatInt(info.WithdrawAmount, 10),
+		Type:  blockatlas.RewardTypeClaim,
+		Date:  tx.BlockTime / 1000,
+	}
+}
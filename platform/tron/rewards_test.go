@@ -0,0 +1,54 @@
+package tron
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+)
+
+const (
+	mockedRewardResponse          = `{"reward": 1520000}`
+	mockedOutgoingTxsResponse     = `{"success":true,"data":[{"txID":"w1","block_timestamp":1592755239000,"raw_data":{"contract":[{"parameter":{"value":{"owner_address":"4179309abcff2cf531070ca9222a1f72c4a5136874"}},"type":"WithdrawBalanceContract"}]}},{"txID":"t1","block_timestamp":1592755200000,"raw_data":{"contract":[{"parameter":{"value":{"amount":100,"owner_address":"4179309abcff2cf531070ca9222a1f72c4a5136874","to_address":"413b334848f75cf8c27ec975bcc7cc7e54f140b3c0"}},"type":"TransferContract"}]}}],"meta":{"fingerprint":"f1"}}`
+	mockedOutgoingTxsNextResponse = `{"success":true,"data":[{"txID":"w2","block_timestamp":1592668800000,"raw_data":{"contract":[{"parameter":{"value":{"owner_address":"4179309abcff2cf531070ca9222a1f72c4a5136874"}},"type":"WithdrawBalanceContract"}]}}],"meta":{}}`
+	mockedWithdrawInfoResponse    = `{"id":"w1","withdraw_amount":3400000}`
+)
+
+func TestPlatform_GetRewards(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/wallet/getReward":
+			_, _ = w.Write([]byte(mockedRewardResponse))
+		case "/v1/accounts/TM1zzNDZD2DPASbKcgdVoTYhfmYgtfwx9R/transactions":
+			assert.Equal(t, "true", r.URL.Query().Get("only_from"))
+			if r.URL.Query().Get("fingerprint") == "f1" {
+				_, _ = w.Write([]byte(mockedOutgoingTxsNextResponse))
+				return
+			}
+			_, _ = w.Write([]byte(mockedOutgoingTxsResponse))
+		case "/wallet/gettransactioninfobyid":
+			_, _ = w.Write([]byte(mockedWithdrawInfoResponse))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	p := Init(server.URL, server.URL)
+
+	pending, err := p.GetPendingRewards("TM1zzNDZD2DPASbKcgdVoTYhfmYgtfwx9R")
+	assert.Nil(t, err)
+	assert.Equal(t, blockatlas.PendingRewards{{Value: "1520000"}}, pending)
+
+	history, err := p.GetRewardsHistory("TM1zzNDZD2DPASbKcgdVoTYhfmYgtfwx9R", 1)
+	assert.Nil(t, err)
+	assert.Equal(t, blockatlas.RewardsPage{
+		{ID: "w1", Value: "3400000", Type: blockatlas.RewardTypeClaim, Date: 1592755239},
+		{ID: "w2", Value: "3400000", Type: blockatlas.RewardTypeClaim, Date: 1592668800},
+	}, history)
+
+	history, err = p.GetRewardsHistory("TM1zzNDZD2DPASbKcgdVoTYhfmYgtfwx9R", 2)
+	assert.Nil(t, err)
+	assert.Equal(t, blockatlas.RewardsPage{}, history)
+}
@@ -1,15 +1,32 @@
 package tron
 
 import (
+	"github.com/trustwallet/blockatlas/coin"
 	"github.com/trustwallet/blockatlas/pkg/address"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/errors"
 	"github.com/trustwallet/blockatlas/pkg/logger"
 	"github.com/trustwallet/blockatlas/services/assets"
+	"github.com/trustwallet/blockatlas/services/economics"
+	"sort"
 	"strconv"
 	"time"
 )
 
-const Annual = 0.74
+const (
+	// Annual is served before the first run of the economics job
+	Annual = 0.74
+
+	// blocksPerYear at a block every 3 seconds
+	blocksPerYear = 365 * 24 * 3600 / 3
+	// producers is the number of the super representatives producing the blocks in turn
+	producers = 27
+	// partners is the number of the candidates sharing the vote reward of every block
+	partners = 127
+
+	paramBlockReward = "getWitnessPayPerBlock"
+	paramVoteReward  = "getWitness127PayPerBlock"
+)
 
 func (p *Platform) GetActiveValidators() (blockatlas.StakeValidators, error) {
 	validators, err := assets.GetValidatorsMap(p)
@@ -35,6 +52,7 @@ func (p *Platform) GetValidators() (blockatlas.ValidatorPage, error) {
 			results = append(results, val)
 		}
 	}
+	results.SetStakeShares()
 	return results, nil
 }
 
@@ -42,9 +60,74 @@ func (p *Platform) GetDetails() blockatlas.StakingDetails {
 	return getDetails()
 }
 
+func (p *Platform) GetEconomics() (blockatlas.StakingEconomics, error) {
+	parameters, err := p.client.fetchChainParameters()
+	if err != nil {
+		return blockatlas.StakingEconomics{}, err
+	}
+	validators, err := p.client.fetchValidators()
+	if err != nil {
+		return blockatlas.StakingEconomics{}, err
+	}
+	return NormalizeEconomics(parameters, validators.Witnesses)
+}
+
+// NormalizeEconomics computes the yield of a vote, a TRX frozen. The vote reward of every block is shared by the
+// first 127 candidates in proportion of their votes, the producers also earn the reward of the blocks they produce.
+// The brokerage of the candidates is applied from the registry like the commissions
+func NormalizeEconomics(parameters ChainParameters, validators []Validator) (blockatlas.StakingEconomics, error) {
+	var blockReward, voteReward int64
+	for _, p := range parameters.Parameters {
+		switch p.Key {
+		case paramBlockReward:
+			blockReward = p.Value
+		case paramVoteReward:
+			voteReward = p.Value
+		}
+	}
+	sorted := make([]Validator, len(validators))
+	copy(sorted, validators)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].VoteCount > sorted[j].VoteCount
+	})
+	var partnerVotes int64
+	for i, v := range sorted {
+		if i == partners {
+			break
+		}
+		partnerVotes += v.VoteCount
+	}
+	if partnerVotes == 0 {
+		return blockatlas.StakingEconomics{}, errors.E("no votes for the candidates", errors.TypePlatformUnmarshal)
+	}
+
+	// rewards are in sun and the votes in TRX
+	annual := float64(blocksPerYear) * float64(voteReward) / 1000000 / float64(partnerVotes) * 100
+	result := blockatlas.StakingEconomics{
+		Annual:     annual,
+		Validators: make(map[string]float64, len(sorted)),
+	}
+	for i, v := range sorted {
+		a, err := address.HexToAddress(v.Address)
+		if err != nil {
+			continue
+		}
+		switch {
+		case i >= partners || v.VoteCount == 0:
+			result.Validators[a] = 0
+		case i < producers:
+			produced := float64(blocksPerYear) / producers * float64(blockReward) / 1000000
+			result.Validators[a] = annual + produced/float64(v.VoteCount)*100
+		default:
+			result.Validators[a] = annual
+		}
+	}
+	return result, nil
+}
+
 func getDetails() blockatlas.StakingDetails {
 	return blockatlas.StakingDetails{
-		Reward:        blockatlas.StakingReward{Annual: Annual},
+		Reward:        blockatlas.StakingReward{Annual: economics.Annual(coin.Coins[coin.TRX].Handle, Annual)},
 		MinimumAmount: blockatlas.Amount("1000000"),
 		LockTime:      259200,
 		Type:          blockatlas.DelegationTypeDelegate,
@@ -86,15 +169,32 @@ func normalizeValidator(v Validator) (validator blockatlas.Validator, ok bool) {
 		return validator, false
 	}
 
+	details := getDetails()
+	details.Reward.Annual = economics.ValidatorAnnual(coin.Coins[coin.TRX].Handle, a, details.Reward.Annual)
 	return blockatlas.Validator{
 		Status:  true,
 		ID:      a,
-		Details: getDetails(),
+		Details: details,
+		Metrics: normalizeMetrics(v),
 	}, true
 }
 
+// normalizeMetrics reads the votes, a TRX frozen each, and the blocks produced and missed by the super representatives.
+// The brokerage isn't listed with the witnesses, it is read from the registry
+func normalizeMetrics(v Validator) *blockatlas.ValidatorMetrics {
+	metrics := blockatlas.ValidatorMetrics{
+		VotingPower:  strconv.FormatInt(v.VoteCount*1000000, 10),
+		MissedBlocks: v.TotalMissed,
+	}
+	if scheduled := v.TotalProduced + v.TotalMissed; scheduled > 0 {
+		metrics.Uptime = float64(v.TotalProduced) / float64(scheduled) * 100
+	}
+	return &metrics
+}
+
 func NormalizeDelegations(data *AccountData, validators blockatlas.ValidatorMap) []blockatlas.Delegation {
 	results := make([]blockatlas.Delegation, 0)
+	lockedUntil := frozenUntil(data.Frozen, time.Now().UnixNano()/int64(time.Millisecond))
 	for _, v := range data.Votes {
 		validator, ok := validators[v.VoteAddress]
 		if !ok {
@@ -106,13 +206,23 @@ func NormalizeDelegations(data *AccountData, validators blockatlas.ValidatorMap)
 			Value:     strconv.Itoa(v.VoteCount * 1000000),
 			Status:    blockatlas.DelegationStatusActive,
 		}
-		for _, f := range data.Frozen {
-			t2 := time.Now().UnixNano() / int64(time.Millisecond)
-			if f.ExpireTime > t2 {
-				delegation.Status = blockatlas.DelegationStatusPending
-			}
+		if lockedUntil > 0 {
+			delegation.Status = blockatlas.DelegationStatusPending
+			delegation.Metadata = blockatlas.DelegationMetaDataPending{AvailableDate: uint(lockedUntil / 1000), Locked: true}
 		}
 		results = append(results, delegation)
 	}
 	return results
 }
+
+// frozenUntil returns the latest expiration in milliseconds of the balances still frozen, 0 if none is.
+// The votes are backed by the frozen balance, they are locked until it can be unfrozen
+func frozenUntil(frozen []Frozen, now int64) int64 {
+	until := int64(0)
+	for _, f := range frozen {
+		if f.ExpireTime > now && f.ExpireTime > until {
+			until = f.ExpireTime
+		}
+	}
+	return until
+}
@@ -8,7 +8,7 @@ import (
 )
 
 func TestNormalizeValidator(t *testing.T) {
-	validator := Validator{Address: "414d1ef8673f916debb7e2515a8f3ecaf2611034aa"}
+	validator := Validator{Address: "414d1ef8673f916debb7e2515a8f3ecaf2611034aa", VoteCount: 2500, TotalProduced: 990, TotalMissed: 10}
 
 	actual, _ := normalizeValidator(validator)
 	expected := blockatlas.Validator{
@@ -22,6 +22,11 @@ func TestNormalizeValidator(t *testing.T) {
 			MinimumAmount: "1000000",
 			Type:          blockatlas.DelegationTypeDelegate,
 		},
+		Metrics: &blockatlas.ValidatorMetrics{
+			VotingPower:  "2500000000",
+			Uptime:       99,
+			MissedBlocks: 10,
+		},
 	}
 	assert.Equal(t, expected, actual)
 }
@@ -108,16 +113,19 @@ var delegation1 = blockatlas.Delegation{
 	Delegator: validator1,
 	Value:     "21000000",
 	Status:    blockatlas.DelegationStatusPending,
+	Metadata:  blockatlas.DelegationMetaDataPending{AvailableDate: 10437262001, Locked: true},
 }
 var delegation2 = blockatlas.Delegation{
 	Delegator: validator2,
 	Value:     "5000000",
 	Status:    blockatlas.DelegationStatusPending,
+	Metadata:  blockatlas.DelegationMetaDataPending{AvailableDate: 10437262001, Locked: true},
 }
 var delegation3 = blockatlas.Delegation{
 	Delegator: validator2,
 	Value:     "5000000",
 	Status:    blockatlas.DelegationStatusPending,
+	Metadata:  blockatlas.DelegationMetaDataPending{AvailableDate: 10437262001, Locked: true},
 }
 var delegation4 = blockatlas.Delegation{
 	Delegator: validator2,
@@ -154,3 +162,24 @@ func testNormalizeDelegations(t *testing.T, value string, want []blockatlas.Dele
 	result := NormalizeDelegations(accountData, validatorMap)
 	assert.Equal(t, result, want)
 }
+
+func TestNormalizeEconomics(t *testing.T) {
+	parameters := ChainParameters{Parameters: []ChainParameter{
+		{Key: "getMaintenanceTimeInterval", Value: 21600000},
+		{Key: "getWitnessPayPerBlock", Value: 16000000},
+		{Key: "getWitness127PayPerBlock", Value: 160000000},
+	}}
+	validators := []Validator{
+		{Address: "414d1ef8673f916debb7e2515a8f3ecaf2611034aa", VoteCount: 1000000000},
+		{Address: "41a614f803b6fd780986a42c78ec9c7f77e6ded13c", VoteCount: 3000000000},
+	}
+
+	result, err := NormalizeEconomics(parameters, validators)
+	assert.NoError(t, err)
+	assert.InDelta(t, 42.048, result.Annual, 0.0001)
+	assert.InDelta(t, 42.670933, result.Validators["TGzz8gjYiYRqpfmDwnLxfgPuLVNmpCswVp"], 0.0001)
+	assert.InDelta(t, 42.255644, result.Validators["TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t"], 0.0001)
+
+	_, err = NormalizeEconomics(parameters, nil)
+	assert.Error(t, err)
+}
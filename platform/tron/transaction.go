@@ -6,6 +6,7 @@ import (
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 	"github.com/trustwallet/blockatlas/pkg/errors"
 	"github.com/trustwallet/blockatlas/pkg/logger"
+	"github.com/trustwallet/blockatlas/pkg/numbers"
 	"strconv"
 )
 
@@ -14,24 +15,181 @@ func (p *Platform) GetTxsByAddress(address string) (blockatlas.TxPage, error) {
 	if err != nil && len(Txs) == 0 {
 		return nil, err
 	}
+	firstVotes := p.firstVotes(address, Txs)
 
 	txs := make(blockatlas.TxPage, 0)
 	for _, srcTx := range Txs {
-		tx, err := normalize(srcTx)
-		if err != nil {
+		if len(srcTx.Data.Contracts) == 0 {
 			continue
 		}
-
-		if len(srcTx.Data.Contracts) > 0 && srcTx.Data.Contracts[0].Type == TransferContract {
+		switch srcTx.Data.Contracts[0].Type {
+		case TransferContract:
+			tx, err := normalize(srcTx)
+			if err != nil {
+				continue
+			}
+			txs = append(txs, *tx)
+		case FreezeContract, UnfreezeContract, VoteContract, WithdrawContract:
+			tx, err := p.normalizeStake(srcTx, firstVotes[srcTx.ID])
+			if err != nil {
+				logger.Error(err)
+				continue
+			}
 			txs = append(txs, *tx)
-		} else {
-			continue
 		}
 	}
 
 	return txs, nil
 }
 
+// firstVotes returns the votes of the page sent by the address without votes before them. The previous votes
+// are looked up in the cached outgoing transactions, the page itself is used when they can't be fetched.
+func (p *Platform) firstVotes(address string, page []Tx) map[string]bool {
+	hasVotes := false
+	for _, srcTx := range page {
+		if len(srcTx.Data.Contracts) > 0 && srcTx.Data.Contracts[0].Type == VoteContract {
+			hasVotes = true
+			break
+		}
+	}
+	if !hasVotes {
+		return nil
+	}
+	history, err := p.client.fetchOutgoingTxs(address)
+	if err != nil {
+		logger.Error(err, "TRON: failed to get the previous votes", logger.Params{"address": address})
+		return findFirstVotes(address, page, len(page) < txsPageLimit)
+	}
+	return findFirstVotes(address, history, len(history) < outgoingTxsLimit)
+}
+
+// findFirstVotes walks the history, ordered from the newest, down from every vote of the address. A vote is the
+// first one when an unfreeze, which cancels all the votes, or the end of a complete history comes before another vote.
+func findFirstVotes(voter string, history []Tx, complete bool) map[string]bool {
+	types := make([]ContractType, len(history))
+	for i, srcTx := range history {
+		if len(srcTx.Data.Contracts) == 0 {
+			continue
+		}
+		contract := srcTx.Data.Contracts[0]
+		if owner, err := address.HexToAddress(contract.Parameter.Value.OwnerAddress); err == nil && owner == voter {
+			types[i] = contract.Type
+		}
+	}
+	result := make(map[string]bool)
+	for i, t := range types {
+		if t != VoteContract {
+			continue
+		}
+		first := complete
+		for _, previous := range types[i+1:] {
+			if previous == VoteContract || previous == UnfreezeContract {
+				first = previous == UnfreezeContract
+				break
+			}
+		}
+		if first {
+			result[history[i].ID] = true
+		}
+	}
+	return result
+}
+
+// normalizeStake converts the freezes and the votes for witnesses, the amounts of the unfreezes and of the
+// reward withdrawals are only known from the receipt of the transaction
+func (p *Platform) normalizeStake(srcTx Tx, firstVote bool) (*blockatlas.Tx, error) {
+	tx, err := normalizeStake(srcTx, firstVote)
+	if err != nil {
+		return nil, err
+	}
+	contract := srcTx.Data.Contracts[0]
+	if contract.Type != UnfreezeContract && contract.Type != WithdrawContract {
+		return tx, nil
+	}
+	info, err := p.client.fetchTxInfo(srcTx.ID)
+	if err != nil {
+		return nil, errors.E(err, "TRON: failed to get the transaction info", errors.TypePlatformApi,
+			errors.Params{"tx": srcTx.ID})
+	}
+	meta := tx.Meta.(blockatlas.AnyAction)
+	if contract.Type == UnfreezeContract {
+		meta.Value = blockatlas.Amount(strconv.FormatInt(info.UnfreezeAmount, 10))
+	} else {
+		meta.Value = blockatlas.Amount(strconv.FormatInt(info.WithdrawAmount, 10))
+	}
+	tx.Meta = meta
+	return tx, nil
+}
+
+// normalizeStake converts a stake contract. A freeze only locks the balance for the resources and the votes,
+// the votes delegate it: the first vote is a delegation, the next ones replace the previous votes.
+// An unfreeze cancels all the votes.
+func normalizeStake(srcTx Tx, firstVote bool) (*blockatlas.Tx, error) {
+	contract := srcTx.Data.Contracts[0]
+	value := contract.Parameter.Value
+	from, err := address.HexToAddress(value.OwnerAddress)
+	if err != nil {
+		return nil, errors.E(err, "TRON: failed to get from address", errors.TypePlatformApi,
+			errors.Params{"tx": srcTx.ID})
+	}
+	tx := blockatlas.Tx{
+		ID:     srcTx.ID,
+		Coin:   coin.TRX,
+		Date:   srcTx.BlockTime / 1000,
+		From:   from,
+		To:     from,
+		Fee:    "0",
+		Block:  0,
+		Status: blockatlas.StatusCompleted,
+		Type:   blockatlas.TxAnyAction,
+	}
+	amount := "0"
+	var key blockatlas.KeyType
+	var title blockatlas.KeyTitle
+	switch contract.Type {
+	case FreezeContract:
+		key, title = blockatlas.KeyFreezeToken, blockatlas.KeyTitleFreezeToken
+		tx.Direction = blockatlas.DirectionOutgoing
+		amount = strconv.FormatInt(value.FrozenBalance, 10)
+	case UnfreezeContract:
+		key, title = blockatlas.KeyStakeUndelegate, blockatlas.AnyActionUndelegation
+		tx.Direction = blockatlas.DirectionIncoming
+	case VoteContract:
+		key, title = blockatlas.KeyStakeRedelegate, blockatlas.AnyActionRedelegation
+		tx.Direction = blockatlas.DirectionSelf
+		if firstVote {
+			key, title = blockatlas.KeyStakeDelegate, blockatlas.AnyActionDelegation
+			tx.Direction = blockatlas.DirectionOutgoing
+		}
+		var votes int64
+		for _, v := range value.Votes {
+			votes += int64(v.VoteCount)
+		}
+		amount = numbers.DecimalExp(strconv.FormatInt(votes, 10), int(coin.Tron().Decimals))
+		if len(value.Votes) > 0 {
+			if to, err := address.HexToAddress(value.Votes[0].VoteAddress); err == nil {
+				tx.To = to
+			}
+		}
+	case WithdrawContract:
+		key, title = blockatlas.KeyStakeClaimRewards, blockatlas.AnyActionClaimRewards
+		tx.Direction = blockatlas.DirectionIncoming
+	default:
+		return nil, errors.E("TRON: invalid stake contract", errors.TypePlatformApi,
+			errors.Params{"tx": srcTx.ID, "type": contract.Type})
+	}
+	tx.Meta = blockatlas.AnyAction{
+		Coin:     coin.TRX,
+		Title:    title,
+		Key:      key,
+		Name:     coin.Tron().Name,
+		Symbol:   coin.Tron().Symbol,
+		Decimals: coin.Tron().Decimals,
+		Value:    blockatlas.Amount(amount),
+	}
+	return &tx, nil
+}
+
 func (p *Platform) GetTokenTxsByAddress(address, token string) (blockatlas.TxPage, error) {
 	unknownTokenType := errors.E("unknownTokenType")
 	tokenType := getTokenType(token)
@@ -6,9 +6,10 @@ import (
 )
 
 type Platform struct {
-	client    Client
-	rpcClient RpcClient
-	udClient  Client
+	client          Client
+	rpcClient       RpcClient
+	udClient        Client
+	udReverseClient Client
 }
 
 func Init(api, apiKey, rpc, udClient string) *Platform {
@@ -21,6 +22,16 @@ func Init(api, apiKey, rpc, udClient string) *Platform {
 	return p
 }
 
+// InitWithReverseLookup enables the reverse resolution of Unstoppable Domains names
+func InitWithReverseLookup(api, apiKey, rpc, udClient, udReverse, udReverseKey string) *Platform {
+	p := Init(api, apiKey, rpc, udClient)
+	p.udReverseClient = Client{blockatlas.InitClient(udReverse)}
+	if udReverseKey != "" {
+		p.udReverseClient.Headers["Authorization"] = "Bearer " + udReverseKey
+	}
+	return p
+}
+
 func (p *Platform) Coin() coin.Coin {
 	return coin.Coins[coin.ZIL]
 }
@@ -2,6 +2,7 @@ package zilliqa
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 )
@@ -20,3 +21,13 @@ func (c *Client) LookupName(name string) (response ZNSResponse, err error) {
 	err = c.Get(&response, "/"+name, nil)
 	return
 }
+
+func (c *Client) LookupNameWithCache(name string, ttl time.Duration) (response ZNSResponse, err error) {
+	err = c.GetWithCache(&response, "/"+name, nil, ttl)
+	return
+}
+
+func (c *Client) ReverseLookup(address string) (response ReverseResponse, err error) {
+	err = c.Get(&response, "reverse/"+address, nil)
+	return
+}
@@ -1,6 +1,8 @@
 package zilliqa
 
 import (
+	"time"
+
 	"github.com/trustwallet/blockatlas/coin"
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 	"github.com/trustwallet/blockatlas/pkg/naming"
@@ -8,6 +10,13 @@ import (
 
 type ZNSResponse struct {
 	Addresses map[string]string
+	Records   map[string]string `json:"records"`
+}
+
+type ReverseResponse struct {
+	Meta struct {
+		Domain string `json:"domain"`
+	} `json:"meta"`
 }
 
 func (p *Platform) CanHandle(name string) bool {
@@ -36,3 +45,43 @@ func (p *Platform) Lookup(coins []uint64, name string) ([]blockatlas.Resolved, e
 	}
 	return result, nil
 }
+
+// ReverseLookup returns the reverse record of the address set on the Unstoppable Domains registry
+func (p *Platform) ReverseLookup(coin uint64, address string) (string, error) {
+	if p.udReverseClient.BaseUrl == "" {
+		return "", nil
+	}
+	resp, err := p.udReverseClient.ReverseLookup(address)
+	if err != nil {
+		return "", err
+	}
+	return resp.Meta.Domain, nil
+}
+
+// udRecords maps the profile fields to the Unstoppable Domains record keys, the first record set is used
+var udRecords = map[blockatlas.ProfileField][]string{
+	blockatlas.ProfileAvatar:      {"social.picture.value"},
+	blockatlas.ProfileURL:         {"ipfs.redirect_domain.value", "browser.redirect_url"},
+	blockatlas.ProfileEmail:       {"whois.email.value"},
+	blockatlas.ProfileTwitter:     {"social.twitter.username"},
+	blockatlas.ProfileContentHash: {"dweb.ipfs.hash", "ipfs.html.value"},
+}
+
+// Record reads the records of the LookupName response, which is cached briefly so the fields of a profile share a request
+func (p *Platform) Record(name string, field blockatlas.ProfileField) (string, error) {
+	resp, err := p.udClient.LookupNameWithCache(name, time.Minute)
+	if err != nil {
+		return "", err
+	}
+	for _, key := range udRecords[field] {
+		value := resp.Records[key]
+		if value == "" {
+			continue
+		}
+		if field == blockatlas.ProfileContentHash {
+			return "ipfs://" + value, nil
+		}
+		return value, nil
+	}
+	return "", nil
+}
@@ -1,22 +1,180 @@
 package assets
 
 import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/patrickmn/go-cache"
 	"github.com/trustwallet/blockatlas/coin"
-	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 	"github.com/trustwallet/blockatlas/pkg/errors"
-	"time"
+	"github.com/trustwallet/blockatlas/pkg/logger"
 )
 
 const (
 	AssetsURL = "https://raw.githubusercontent.com/trustwallet/assets/master/blockchains/"
+
+	listCacheTTL = time.Hour * 1
+	// snapshotCacheTTL is shorter, the remote sources are tried again soon after an outage
+	snapshotCacheTTL = time.Minute * 5
 )
 
+var (
+	// LocalSources are read first, their validators override the remote ones
+	LocalSources []Source
+	// RemoteSources are the mirrors of the registry in priority order, GitHub by default
+	RemoteSources = []Source{URLSource{URL: AssetsURL}}
+	// SnapshotDir keeps the last remote lists accepted for every coin, they are served when no remote
+	// source answers. Empty disables the snapshots
+	SnapshotDir string
+
+	listCache = cache.New(listCacheTTL, listCacheTTL)
+)
+
+// Init sets up the sources of the validators: the local directory overrides the mirrors, which override GitHub
+func Init(localDir string, mirrors []string, snapshotDir string) {
+	LocalSources = nil
+	if localDir != "" {
+		LocalSources = []Source{DirSource{Dir: localDir}}
+	}
+	RemoteSources = make([]Source, 0, len(mirrors)+1)
+	for _, m := range mirrors {
+		RemoteSources = append(RemoteSources, URLSource{URL: m})
+	}
+	RemoteSources = append(RemoteSources, URLSource{URL: AssetsURL})
+	SnapshotDir = snapshotDir
+	listCache.Flush()
+}
+
+// ReadFile returns a file of the folder of the coin from the first source which has it, the local
+// directory first and then the mirrors and GitHub in order
+func ReadFile(c coin.Coin, path string) ([]byte, error) {
+	var err error
+	for _, s := range append(append([]Source{}, LocalSources...), RemoteSources...) {
+		var raw []byte
+		if raw, err = s.Read(c, path); err == nil {
+			return raw, nil
+		}
+	}
+	if err == nil || err == errNotFound {
+		return nil, errors.E("file not found", errors.Params{"coin": c.Handle, "path": path})
+	}
+	return nil, err
+}
+
+// FileURL returns the public URL of a file of the folder of the coin, on the first remote source
+func FileURL(c coin.Coin, path string) string {
+	for _, s := range RemoteSources {
+		if u, ok := s.(URLSource); ok {
+			return u.URL + c.Handle + "/" + path
+		}
+	}
+	return AssetsURL + c.Handle + "/" + path
+}
+
+// fetchValidatorsInfo merges the lists of the sources by priority, the remote lists are replaced by the
+// last snapshot if none of them answers
 func fetchValidatorsInfo(coin coin.Coin) (AssetValidators, error) {
-	var results AssetValidators
-	request := blockatlas.InitClient(AssetsURL + coin.Handle)
-	err := request.GetWithCache(&results, "validators/list.json", nil, time.Hour*1)
-	if err != nil {
-		return nil, errors.E(err, errors.Params{"coin": coin.Handle})
+	if cached, ok := listCache.Get(coin.Handle); ok {
+		return cached.(AssetValidators), nil
+	}
+	ttl := listCacheTTL
+	remote, remoteOk := fetchLists(RemoteSources, coin)
+	if remoteOk {
+		if err := saveSnapshot(coin, mergeValidators(remote...)); err != nil {
+			logger.Error(err, "Failed to save the validators snapshot", logger.Params{"coin": coin.Handle})
+		}
+	} else {
+		// Without the remote lists the result is kept shortly, they are tried again soon
+		ttl = snapshotCacheTTL
+		if snapshot, err := loadSnapshot(coin); err == nil {
+			logger.Warn("Validators registry is unavailable, the last snapshot is served", logger.Params{"coin": coin.Handle})
+			remote, remoteOk = []AssetValidators{snapshot}, true
+		}
 	}
+	local, localOk := fetchLists(LocalSources, coin)
+	if !remoteOk && !localOk {
+		return nil, errors.E("validators list is unavailable", errors.Params{"coin": coin.Handle})
+	}
+	results := mergeValidators(append(local, remote...)...)
+	listCache.Set(coin.Handle, results, ttl)
 	return results, nil
 }
+
+// fetchLists returns the lists of the sources which answered, in the order of the sources
+func fetchLists(sources []Source, coin coin.Coin) ([]AssetValidators, bool) {
+	results := make([]AssetValidators, 0, len(sources))
+	for _, s := range sources {
+		validators, err := s.Fetch(coin)
+		if err == errNoList {
+			continue
+		}
+		if err != nil {
+			logger.Error(err, "Failed to read the validators list", logger.Params{"coin": coin.Handle, "source": s.Name()})
+			continue
+		}
+		results = append(results, validators)
+	}
+	return results, len(results) > 0
+}
+
+// mergeValidators keeps the first entry of every validator, the lists are in priority order
+func mergeValidators(lists ...AssetValidators) AssetValidators {
+	results := make(AssetValidators, 0)
+	ids := make(map[string]bool)
+	for _, list := range lists {
+		for _, v := range list {
+			if ids[v.ID] {
+				continue
+			}
+			ids[v.ID] = true
+			results = append(results, v)
+		}
+	}
+	return results
+}
+
+func snapshotPath(coin coin.Coin) string {
+	return filepath.Join(SnapshotDir, coin.Handle+".json")
+}
+
+// saveSnapshot replaces the snapshot of the coin with a rename, a reader never sees a partial file
+func saveSnapshot(coin coin.Coin, validators AssetValidators) error {
+	if SnapshotDir == "" {
+		return nil
+	}
+	raw, err := json.Marshal(validators)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(SnapshotDir, 0755); err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(SnapshotDir, coin.Handle+".*.tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(raw); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), snapshotPath(coin))
+}
+
+func loadSnapshot(coin coin.Coin) (AssetValidators, error) {
+	if SnapshotDir == "" {
+		return nil, errNoList
+	}
+	raw, err := ioutil.ReadFile(snapshotPath(coin))
+	if err != nil {
+		return nil, err
+	}
+	return parseValidators(raw)
+}
@@ -0,0 +1,152 @@
+package assets
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/coin"
+)
+
+const (
+	mirrorList = `[{"id":"test1","name":"Mirror","payout":{"commission":5}},{"id":"test2","name":"Man"}]`
+	localList  = `[{"id":"test1","name":"Local"},{"id":"test3","name":"Private"}]`
+)
+
+func setupSources(t *testing.T, mirror http.HandlerFunc) (server *httptest.Server, dir string, cleanup func()) {
+	server = httptest.NewServer(mirror)
+	dir, err := ioutil.TempDir("", "validators")
+	assert.Nil(t, err)
+	return server, dir, func() {
+		server.Close()
+		os.RemoveAll(dir)
+		Init("", nil, "")
+	}
+}
+
+func writeList(t *testing.T, dir, handle, list string) {
+	path := filepath.Join(dir, handle, "validators")
+	assert.Nil(t, os.MkdirAll(path, 0755))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(path, "list.json"), []byte(list), 0644))
+}
+
+func TestFetchValidatorsInfo(t *testing.T) {
+	available := true
+	server, dir, cleanup := setupSources(t, func(w http.ResponseWriter, r *http.Request) {
+		if !available || r.URL.Path != "/cosmos/validators/list.json" {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_, _ = w.Write([]byte(mirrorList))
+	})
+	defer cleanup()
+	writeList(t, filepath.Join(dir, "local"), "cosmos", localList)
+
+	Init(filepath.Join(dir, "local"), []string{server.URL + "/"}, filepath.Join(dir, "snapshots"))
+	// the test doesn't reach GitHub
+	RemoteSources = RemoteSources[:1]
+
+	validators, err := fetchValidatorsInfo(coin.Coins[coin.ATOM])
+	assert.Nil(t, err)
+	assert.Equal(t, AssetValidators{
+		{ID: "test1", Name: "Local"},
+		{ID: "test3", Name: "Private"},
+		{ID: "test2", Name: "Man"},
+	}, validators)
+
+	snapshot, err := loadSnapshot(coin.Coins[coin.ATOM])
+	assert.Nil(t, err)
+	assert.Equal(t, AssetValidators{
+		{ID: "test1", Name: "Mirror", Payout: ValidatorPayout{Commission: 5}},
+		{ID: "test2", Name: "Man"},
+	}, snapshot)
+
+	available = false
+	listCache.Flush()
+	validators, err = fetchValidatorsInfo(coin.Coins[coin.ATOM])
+	assert.Nil(t, err)
+	assert.Len(t, validators, 3)
+
+	_, err = fetchValidatorsInfo(coin.Coins[coin.XTZ])
+	assert.NotNil(t, err)
+}
+
+func TestFetchValidatorsInfo_LocalOnly(t *testing.T) {
+	server, dir, cleanup := setupSources(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	})
+	defer cleanup()
+	writeList(t, dir, "cosmos", localList)
+
+	Init(dir, []string{server.URL + "/"}, "")
+	RemoteSources = RemoteSources[:1]
+
+	validators, err := fetchValidatorsInfo(coin.Coins[coin.ATOM])
+	assert.Nil(t, err)
+	assert.Equal(t, AssetValidators{{ID: "test1", Name: "Local"}, {ID: "test3", Name: "Private"}}, validators)
+
+	_, expiration, ok := listCache.GetWithExpiration("cosmos")
+	assert.True(t, ok)
+	assert.True(t, time.Until(expiration) <= snapshotCacheTTL, "the remote lists are tried again soon")
+	listCache.Flush()
+}
+
+func TestParseValidators(t *testing.T) {
+	validators, err := parseValidators([]byte(mirrorList))
+	assert.Nil(t, err)
+	assert.Len(t, validators, 2)
+
+	for _, invalid := range []string{
+		`{"id":"test1","name":"Spider"}`,
+		`null`,
+		`[{"id":"test1","name":"Spider"},{"id":"test1","name":"Man"}]`,
+		`[{"name":"Spider"}]`,
+		`[{"id":"test1"}]`,
+		`[{"id":"test1","name":"Spider","payout":{"commission":101}}]`,
+		`[{"id":"test1","name":"Spider","staking":{"minDelegation":-1}}]`,
+		`[{"id":1,"name":"Spider"}]`,
+	} {
+		_, err := parseValidators([]byte(invalid))
+		assert.NotNil(t, err, invalid)
+	}
+}
+
+func TestMergeValidators(t *testing.T) {
+	assert.Equal(t, AssetValidators{{ID: "test1", Name: "Spider"}, {ID: "test2"}},
+		mergeValidators(AssetValidators{{ID: "test1", Name: "Spider"}}, AssetValidators{{ID: "test1"}, {ID: "test2"}}))
+	assert.Equal(t, AssetValidators{}, mergeValidators())
+}
+
+func TestReadFile(t *testing.T) {
+	server, dir, cleanup := setupSources(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/ethereum/tokenlist.json" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`404: Not Found`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"tokens":[]}`))
+	})
+	defer cleanup()
+	writeList(t, dir, "cosmos", localList)
+
+	Init(dir, []string{server.URL + "/"}, "")
+	RemoteSources = RemoteSources[:1]
+
+	raw, err := ReadFile(coin.Coins[coin.ETH], "tokenlist.json")
+	assert.Nil(t, err)
+	assert.Equal(t, `{"tokens":[]}`, string(raw))
+
+	raw, err = ReadFile(coin.Coins[coin.ATOM], "validators/list.json")
+	assert.Nil(t, err)
+	assert.Equal(t, localList, string(raw))
+
+	_, err = ReadFile(coin.Coins[coin.ETH], "assets/0x0/info.json")
+	assert.NotNil(t, err)
+
+	assert.Equal(t, server.URL+"/ethereum/assets/0x0/logo.png", FileURL(coin.Coins[coin.ETH], "assets/0x0/logo.png"))
+}
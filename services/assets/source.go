@@ -0,0 +1,120 @@
+package assets
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/trustwallet/blockatlas/coin"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/errors"
+)
+
+const validatorsListPath = "validators/list.json"
+
+var (
+	// errNoList is returned by the sources without a list for the coin, it isn't reported as a failure
+	errNoList = errors.E("no validators list")
+	// errNotFound is returned by the sources without the file
+	errNotFound = errors.E("file not found")
+)
+
+type (
+	// Source is a copy of the blockchains folder of the registry, the validators list of a coin is validated
+	// before being returned
+	Source interface {
+		Name() string
+		Fetch(c coin.Coin) (AssetValidators, error)
+		// Read returns a file of the folder of the coin, like tokenlist.json
+		Read(c coin.Coin, path string) ([]byte, error)
+	}
+
+	// DirSource reads the lists from a local copy of the blockchains folder: <dir>/<coin handle>/validators/list.json
+	DirSource struct {
+		Dir string
+	}
+
+	// URLSource reads the lists from the blockchains folder of the assets repository or of a mirror
+	URLSource struct {
+		URL string
+	}
+)
+
+func (s DirSource) Name() string {
+	return s.Dir
+}
+
+func (s DirSource) Fetch(c coin.Coin) (AssetValidators, error) {
+	raw, err := s.Read(c, validatorsListPath)
+	if err == errNotFound {
+		return nil, errNoList
+	}
+	if err != nil {
+		return nil, err
+	}
+	return parseValidators(raw)
+}
+
+func (s DirSource) Read(c coin.Coin, path string) ([]byte, error) {
+	raw, err := ioutil.ReadFile(filepath.Join(s.Dir, c.Handle, filepath.FromSlash(path)))
+	if os.IsNotExist(err) {
+		return nil, errNotFound
+	}
+	if err != nil {
+		return nil, errors.E(err, errors.Params{"coin": c.Handle, "source": s.Dir})
+	}
+	return raw, nil
+}
+
+func (s URLSource) Name() string {
+	return s.URL
+}
+
+func (s URLSource) Fetch(c coin.Coin) (AssetValidators, error) {
+	raw, err := s.Read(c, validatorsListPath)
+	if err != nil {
+		return nil, err
+	}
+	return parseValidators(raw)
+}
+
+// Read accepts the JSON files only, the error pages of the repository are not JSON
+func (s URLSource) Read(c coin.Coin, path string) ([]byte, error) {
+	var raw json.RawMessage
+	request := blockatlas.InitClient(s.URL + c.Handle)
+	if err := request.Get(&raw, path, nil); err != nil {
+		return nil, errors.E(err, errors.Params{"coin": c.Handle, "source": s.URL, "path": path})
+	}
+	return raw, nil
+}
+
+// parseValidators accepts a list matching the schema of the registry: an array of objects with a unique
+// non-empty "id", a "name", a "payout.commission" between 0 and 100 and a positive "staking.minDelegation"
+func parseValidators(raw []byte) (AssetValidators, error) {
+	var validators AssetValidators
+	if err := json.Unmarshal(raw, &validators); err != nil {
+		return nil, errors.E(err, "invalid validators list")
+	}
+	if validators == nil {
+		return nil, errors.E("invalid validators list: not an array")
+	}
+	ids := make(map[string]bool, len(validators))
+	for i, v := range validators {
+		params := errors.Params{"index": i, "id": v.ID}
+		switch {
+		case v.ID == "":
+			return nil, errors.E("invalid validators list: missing id", params)
+		case ids[v.ID]:
+			return nil, errors.E("invalid validators list: duplicated id", params)
+		case v.Name == "":
+			return nil, errors.E("invalid validators list: missing name", params)
+		case v.Payout.Commission < 0 || v.Payout.Commission > 100:
+			return nil, errors.E("invalid validators list: commission out of range", params)
+		case v.Staking.MinDelegation < 0:
+			return nil, errors.E("invalid validators list: negative minimum delegation", params)
+		}
+		ids[v.ID] = true
+	}
+	return validators, nil
+}
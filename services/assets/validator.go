@@ -62,8 +62,26 @@ func normalizeValidator(rpcValidator blockatlas.Validator, assetValidator AssetV
 			Website:     assetValidator.Website,
 		},
 		Details: details,
+		Metrics: mergeMetrics(rpcValidator.Metrics, assetValidator),
 	}
 }
+
+// mergeMetrics completes the metrics of the chain with the commission of the registry, when the chain doesn't expose it.
+// A 0% commission read from the chain is kept, a 0 of the registry is an unknown commission
+func mergeMetrics(metrics *blockatlas.ValidatorMetrics, assetValidator AssetValidator) *blockatlas.ValidatorMetrics {
+	if metrics == nil && assetValidator.Payout.Commission == 0 {
+		return nil
+	}
+	result := blockatlas.ValidatorMetrics{}
+	if metrics != nil {
+		result = *metrics
+	}
+	if !result.HasCommission {
+		result.Commission = assetValidator.Payout.Commission
+		result.HasCommission = assetValidator.Payout.Commission > 0
+	}
+	return &result
+}
 func calculateAnnual(annual float64, commission float64) float64 {
 	return (annual * (100 - commission)) / 100
 }
@@ -207,3 +207,21 @@ func Test_normalizeValidators(t *testing.T) {
 		})
 	}
 }
+
+func TestMergeMetrics(t *testing.T) {
+	withCommission := AssetValidator{ID: "test1", Payout: ValidatorPayout{Commission: 8}}
+
+	assert.Nil(t, mergeMetrics(nil, AssetValidator{ID: "test1"}))
+	assert.Equal(t, &blockatlas.ValidatorMetrics{Commission: 8, HasCommission: true}, mergeMetrics(nil, withCommission))
+
+	chain := &blockatlas.ValidatorMetrics{Commission: 5, HasCommission: true, VotingPower: "100", Jailed: true}
+	assert.Equal(t, chain, mergeMetrics(chain, withCommission))
+
+	noFee := &blockatlas.ValidatorMetrics{Commission: 0, HasCommission: true, VotingPower: "100"}
+	assert.Equal(t, noFee, mergeMetrics(noFee, withCommission), "a 0% commission of the chain is kept")
+
+	noCommission := &blockatlas.ValidatorMetrics{VotingPower: "100"}
+	assert.Equal(t, &blockatlas.ValidatorMetrics{Commission: 8, HasCommission: true, VotingPower: "100"}, mergeMetrics(noCommission, withCommission))
+	assert.Equal(t, noCommission, mergeMetrics(noCommission, AssetValidator{ID: "test1"}), "the commission stays unknown")
+	assert.Equal(t, float64(0), noCommission.Commission)
+}
@@ -1,33 +1,73 @@
 package domains
 
 import (
+	"sort"
+
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 	"github.com/trustwallet/blockatlas/pkg/errors"
+	"github.com/trustwallet/blockatlas/pkg/logger"
 	"github.com/trustwallet/blockatlas/platform"
 )
 
+// HandleLookup resolves the name with the providers of its route. Coins a provider fails
+// to resolve fall back to the next one, so the result can merge addresses of several providers.
 func HandleLookup(name string, coins []uint64) ([]blockatlas.Resolved, error) {
-	addresses := make([]blockatlas.Resolved, 0)
-	apis := findHandlerApis(name, platform.NamingAPIs)
-	if len(apis) == 0 {
+	providers := findProviders(name, getRoutes(), platform.GetNamingAPIs())
+	if len(providers) == 0 {
 		return nil, errors.E("platform not found", errors.Params{"name": name, "coins": coins})
 	}
-	for _, api := range apis {
-		provAddresses, err := api.Lookup(coins, name)
+	return lookup(name, coins, providers)
+}
+
+func lookup(name string, coins []uint64, providers []provider) ([]blockatlas.Resolved, error) {
+	result := make([]blockatlas.Resolved, 0, len(coins))
+	pending := coins
+	var lastErr error
+	for _, p := range providers {
+		if len(pending) == 0 {
+			break
+		}
+		addresses, err := p.api.Lookup(pending, name)
 		if err != nil {
-			return nil, errors.E(err, "name format not recognized", errors.Params{"name": name, "coins": coins})
+			lastErr = errors.E(err, "name format not recognized", errors.Params{"name": name, "coins": pending, "provider": p.name})
+			logger.Error(lastErr)
+			continue
 		}
-		addresses = append(addresses, provAddresses...)
+		resolved := make(map[uint64]bool)
+		for _, a := range addresses {
+			if a.Result == "" || resolved[a.Coin] || !containsCoin(pending, a.Coin) {
+				continue
+			}
+			a.Provider = p.name
+			result = append(result, a)
+			resolved[a.Coin] = true
+		}
+		next := make([]uint64, 0, len(pending))
+		for _, c := range pending {
+			if !resolved[c] {
+				next = append(next, c)
+			}
+		}
+		pending = next
+	}
+	if len(result) == 0 && lastErr != nil {
+		return nil, lastErr
+	}
+	order := make(map[uint64]int, len(coins))
+	for i, c := range coins {
+		order[c] = i
 	}
-	return addresses, nil
+	sort.SliceStable(result, func(i, j int) bool {
+		return order[result[i].Coin] < order[result[j].Coin]
+	})
+	return result, nil
 }
 
-func findHandlerApis(name string, allApis map[uint]blockatlas.NamingServiceAPI) []blockatlas.NamingServiceAPI {
-	apis := []blockatlas.NamingServiceAPI{}
-	for _, api := range allApis {
-		if api.CanHandle(name) {
-			apis = append(apis, api)
+func containsCoin(coins []uint64, coin uint64) bool {
+	for _, c := range coins {
+		if c == coin {
+			return true
 		}
 	}
-	return apis
+	return false
 }
@@ -1,8 +1,11 @@
 package domains
 
 import (
+	"errors"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+
 	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 	"github.com/trustwallet/blockatlas/pkg/naming"
 )
@@ -37,7 +40,7 @@ func setupProviders() map[uint]blockatlas.NamingServiceAPI {
 	}
 }
 
-func TestFindHandlerApis(t *testing.T) {
+func TestFindHandlerProviders(t *testing.T) {
 	tests := []struct {
 		name      string
 		wantCount int
@@ -65,9 +68,48 @@ func TestFindHandlerApis(t *testing.T) {
 	}
 	allApis := setupProviders()
 	for _, tt := range tests {
-		res := findHandlerApis(tt.name, allApis)
+		res := findHandlerProviders(tt.name, allApis)
 		if len(res) != tt.wantCount {
 			t.Errorf("Wrong answer %v %v %v", tt.name, len(res), tt.wantCount)
 		}
 	}
 }
+
+type lookupProvider struct {
+	addresses map[uint64]string
+	err       error
+}
+
+func (p *lookupProvider) CanHandle(name string) bool {
+	return true
+}
+
+func (p *lookupProvider) Lookup(coins []uint64, name string) ([]blockatlas.Resolved, error) {
+	result := make([]blockatlas.Resolved, 0)
+	for _, c := range coins {
+		if address, ok := p.addresses[c]; ok {
+			result = append(result, blockatlas.Resolved{Coin: c, Result: address})
+		}
+	}
+	return result, p.err
+}
+
+func TestLookup(t *testing.T) {
+	failing := provider{name: "failing", api: &lookupProvider{err: errors.New("unavailable")}}
+	first := provider{name: "first", api: &lookupProvider{addresses: map[uint64]string{60: "0x1"}}}
+	second := provider{name: "second", api: &lookupProvider{addresses: map[uint64]string{60: "0x2", 714: "bnb1"}}}
+
+	got, err := lookup("alice.eth", []uint64{714, 60}, []provider{failing, first, second})
+	assert.Nil(t, err)
+	assert.Equal(t, []blockatlas.Resolved{
+		{Coin: 714, Result: "bnb1", Provider: "second"},
+		{Coin: 60, Result: "0x1", Provider: "first"},
+	}, got)
+
+	got, err = lookup("alice.eth", []uint64{60}, []provider{first, failing})
+	assert.Nil(t, err)
+	assert.Len(t, got, 1)
+
+	_, err = lookup("alice.eth", []uint64{60}, []provider{failing})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,90 @@
+package domains
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/errors"
+	"github.com/trustwallet/blockatlas/pkg/logger"
+	"github.com/trustwallet/blockatlas/platform"
+)
+
+const (
+	profileCacheTTL     = time.Hour
+	profileMissCacheTTL = time.Minute * 10
+)
+
+// profileCache caches every field on its own, so a failed field doesn't expire the others
+var profileCache = cache.New(profileCacheTTL, profileCacheTTL)
+
+// HandleProfile returns the records of the name, fields are fetched concurrently
+func HandleProfile(name string) (blockatlas.NameProfile, error) {
+	apis := findProfileApis(name, getRoutes(), platform.GetNamingAPIs())
+	if len(apis) == 0 {
+		return blockatlas.NameProfile{}, errors.E("platform not found", errors.Params{"name": name})
+	}
+	return getProfile(name, apis)
+}
+
+func getProfile(name string, apis []blockatlas.ProfileNamingServiceAPI) (blockatlas.NameProfile, error) {
+	profile := blockatlas.NameProfile{Name: name}
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		failures int
+		lastErr  error
+	)
+	wg.Add(len(blockatlas.ProfileFields))
+	for _, field := range blockatlas.ProfileFields {
+		go func(field blockatlas.ProfileField) {
+			defer wg.Done()
+			value, err := getProfileField(name, field, apis)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				logger.Error(err)
+				failures++
+				lastErr = err
+				return
+			}
+			profile.Set(field, value)
+		}(field)
+	}
+	wg.Wait()
+	if failures == len(blockatlas.ProfileFields) {
+		return profile, lastErr
+	}
+	return profile, nil
+}
+
+func getProfileField(name string, field blockatlas.ProfileField, apis []blockatlas.ProfileNamingServiceAPI) (string, error) {
+	key := strings.ToLower(name) + ":" + string(field)
+	if value, ok := profileCache.Get(key); ok {
+		return value.(string), nil
+	}
+	for _, api := range apis {
+		value, err := api.Record(name, field)
+		if err != nil {
+			return "", errors.E(err, "profile lookup failed", errors.Params{"name": name, "field": field})
+		}
+		if value != "" {
+			profileCache.Set(key, value, profileCacheTTL)
+			return value, nil
+		}
+	}
+	profileCache.Set(key, "", profileMissCacheTTL)
+	return "", nil
+}
+
+func findProfileApis(name string, routes []Route, allApis map[uint]blockatlas.NamingServiceAPI) []blockatlas.ProfileNamingServiceAPI {
+	apis := make([]blockatlas.ProfileNamingServiceAPI, 0)
+	for _, p := range findProviders(name, routes, allApis) {
+		if profileAPI, ok := p.api.(blockatlas.ProfileNamingServiceAPI); ok {
+			apis = append(apis, profileAPI)
+		}
+	}
+	return apis
+}
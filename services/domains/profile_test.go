@@ -0,0 +1,61 @@
+package domains
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+)
+
+type profileProvider struct {
+	reverseProvider
+	records map[blockatlas.ProfileField]string
+	calls   int32
+}
+
+func (p *profileProvider) Record(name string, field blockatlas.ProfileField) (string, error) {
+	atomic.AddInt32(&p.calls, 1)
+	return p.records[field], p.err
+}
+
+func TestGetProfile(t *testing.T) {
+	provider := &profileProvider{records: map[blockatlas.ProfileField]string{
+		blockatlas.ProfileAvatar:      "https://example.com/alice.png",
+		blockatlas.ProfileTwitter:     "alice",
+		blockatlas.ProfileContentHash: "ipfs://QmRAQB6YaCyidP37UdDnjFY5vQuiBrcqdyoW1CuDgwxkD4",
+	}}
+	apis := []blockatlas.ProfileNamingServiceAPI{provider}
+
+	got, err := getProfile("alice.rev", apis)
+	assert.Nil(t, err)
+	assert.Equal(t, blockatlas.NameProfile{
+		Name:        "alice.rev",
+		Avatar:      "https://example.com/alice.png",
+		Twitter:     "alice",
+		ContentHash: "ipfs://QmRAQB6YaCyidP37UdDnjFY5vQuiBrcqdyoW1CuDgwxkD4",
+	}, got)
+	assert.Equal(t, int32(len(blockatlas.ProfileFields)), atomic.LoadInt32(&provider.calls))
+
+	_, err = getProfile("ALICE.rev", apis)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(len(blockatlas.ProfileFields)), atomic.LoadInt32(&provider.calls), "fields are cached")
+}
+
+func TestGetProfile_Error(t *testing.T) {
+	apis := []blockatlas.ProfileNamingServiceAPI{&profileProvider{reverseProvider: reverseProvider{err: errors.New("rpc unavailable")}}}
+	_, err := getProfile("bob.rev", apis)
+	assert.NotNil(t, err)
+	_, cached := profileCache.Get("bob.rev:avatar")
+	assert.False(t, cached)
+}
+
+func TestFindProfileApis(t *testing.T) {
+	apis := map[uint]blockatlas.NamingServiceAPI{
+		1: &ProviderOne{},
+		2: &profileProvider{},
+	}
+	assert.Len(t, findProfileApis("alice.rev", nil, apis), 1)
+	assert.Len(t, findProfileApis("alice.one", nil, apis), 0)
+}
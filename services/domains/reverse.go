@@ -0,0 +1,163 @@
+package domains
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/errors"
+	"github.com/trustwallet/blockatlas/pkg/logger"
+	"github.com/trustwallet/blockatlas/platform"
+)
+
+const (
+	// MaxReverseLookupBatch is the largest number of addresses of a batch reverse lookup
+	MaxReverseLookupBatch = 100
+
+	reverseCacheTTL     = time.Hour
+	reverseMissCacheTTL = time.Minute * 10
+
+	// maxReverseLookups limits the addresses of a batch resolved concurrently
+	maxReverseLookups = 8
+)
+
+var reverseCache = cache.New(reverseCacheTTL, reverseCacheTTL)
+
+type AddressRequest struct {
+	Coin    uint64
+	Address string
+}
+
+// HandleReverseLookup returns the name of the address, or an empty name if no provider knows it.
+// Names are verified with a forward lookup through the routes, since anyone can set a reverse record to any name.
+func HandleReverseLookup(coin uint64, address string) (blockatlas.ReverseResolved, error) {
+	return reverseLookup(coin, address, getRoutes(), platform.GetNamingAPIs())
+}
+
+// HandleReverseLookupBatch resolves the addresses concurrently, failed lookups are skipped
+func HandleReverseLookupBatch(requests []AddressRequest) []blockatlas.ReverseResolved {
+	return reverseLookupBatch(requests, getRoutes(), platform.GetNamingAPIs())
+}
+
+func reverseLookupBatch(requests []AddressRequest, routes []Route, apis map[uint]blockatlas.NamingServiceAPI) []blockatlas.ReverseResolved {
+	sem := make(chan struct{}, maxReverseLookups)
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		result = make([]blockatlas.ReverseResolved, 0, len(requests))
+	)
+	for _, r := range requests {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(r AddressRequest) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			resolved, err := reverseLookup(r.Coin, r.Address, routes, apis)
+			if err != nil {
+				logger.Error(err)
+				return
+			}
+			mu.Lock()
+			result = append(result, resolved)
+			mu.Unlock()
+		}(r)
+	}
+	wg.Wait()
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Coin != result[j].Coin {
+			return result[i].Coin < result[j].Coin
+		}
+		return result[i].Address < result[j].Address
+	})
+	return result
+}
+
+func reverseLookup(coin uint64, address string, routes []Route, allApis map[uint]blockatlas.NamingServiceAPI) (blockatlas.ReverseResolved, error) {
+	resolved := blockatlas.ReverseResolved{Coin: coin, Address: address}
+	key := reverseCacheKey(coin, address)
+	if name, ok := reverseCache.Get(key); ok {
+		resolved.Name = name.(string)
+		return resolved, nil
+	}
+
+	var lastErr error
+	for _, api := range findReverseApis(allApis) {
+		name, err := api.ReverseLookup(coin, address)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if name == "" {
+			continue
+		}
+		verified, err := verifyName(coin, address, name, routes, allApis)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if verified {
+			resolved.Name = name
+			reverseCache.Set(key, name, reverseCacheTTL)
+			return resolved, nil
+		}
+	}
+	if lastErr != nil {
+		return resolved, errors.E(lastErr, "reverse lookup failed", errors.Params{"coin": coin, "address": address})
+	}
+	reverseCache.Set(key, "", reverseMissCacheTTL)
+	return resolved, nil
+}
+
+// verifyName checks that the name resolves back to the address with the providers of its route,
+// the same way a forward lookup of the name would
+func verifyName(coin uint64, address, name string, routes []Route, allApis map[uint]blockatlas.NamingServiceAPI) (bool, error) {
+	providers := findProviders(name, routes, allApis)
+	if len(providers) == 0 {
+		return false, nil
+	}
+	addresses, err := lookup(name, []uint64{coin}, providers)
+	if err != nil {
+		return false, err
+	}
+	for _, a := range addresses {
+		if a.Coin == coin && sameAddress(a.Result, address) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// findReverseApis returns the providers supporting reverse lookups, ordered by coin
+func findReverseApis(allApis map[uint]blockatlas.NamingServiceAPI) []blockatlas.ReverseNamingServiceAPI {
+	keys := make([]uint, 0, len(allApis))
+	for k := range allApis {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+	apis := make([]blockatlas.ReverseNamingServiceAPI, 0)
+	for _, k := range keys {
+		if api, ok := allApis[k].(blockatlas.ReverseNamingServiceAPI); ok {
+			apis = append(apis, api)
+		}
+	}
+	return apis
+}
+
+func reverseCacheKey(coin uint64, address string) string {
+	return strconv.FormatUint(coin, 10) + ":" + address
+}
+
+// sameAddress ignores the EIP-55 checksum of hex addresses, other formats are case sensitive
+func sameAddress(a, b string) bool {
+	if strings.HasPrefix(a, "0x") {
+		return strings.EqualFold(a, b)
+	}
+	return a == b
+}
@@ -0,0 +1,146 @@
+package domains
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/coin"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/naming"
+)
+
+type reverseProvider struct {
+	names     map[string]string
+	addresses map[string]string
+	err       error
+}
+
+func (p *reverseProvider) CanHandle(name string) bool {
+	return naming.GetTopDomain(name, ".") == ".rev"
+}
+
+func (p *reverseProvider) Lookup(coins []uint64, name string) ([]blockatlas.Resolved, error) {
+	address, ok := p.addresses[name]
+	if !ok {
+		return []blockatlas.Resolved{}, nil
+	}
+	return []blockatlas.Resolved{{Coin: coins[0], Result: address}}, nil
+}
+
+func (p *reverseProvider) ReverseLookup(coin uint64, address string) (string, error) {
+	return p.names[address], p.err
+}
+
+func TestReverseLookup(t *testing.T) {
+	apis := map[uint]blockatlas.NamingServiceAPI{
+		1: &ProviderOne{},
+		2: &reverseProvider{
+			names: map[string]string{
+				"0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045": "alice.rev",
+				"0x5574Cd97432cEd0D7Caf58ac3c4fEDB2061C98fB": "bob.rev",
+			},
+			addresses: map[string]string{
+				"alice.rev": "0xd8da6bf26964af9d7eed9e03e53415d37aa96045",
+				"bob.rev":   "0x0000000000000000000000000000000000000001",
+			},
+		},
+	}
+	tests := []struct {
+		name    string
+		address string
+		want    string
+	}{
+		{"verified name", "0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045", "alice.rev"},
+		{"forward lookup mismatch", "0x5574Cd97432cEd0D7Caf58ac3c4fEDB2061C98fB", ""},
+		{"no reverse record", "0x0000000000000000000000000000000000000002", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := reverseLookup(60, tt.address, nil, apis)
+			assert.Nil(t, err)
+			assert.Equal(t, blockatlas.ReverseResolved{Coin: 60, Address: tt.address, Name: tt.want}, got)
+		})
+	}
+}
+
+func TestReverseLookup_Error(t *testing.T) {
+	apis := map[uint]blockatlas.NamingServiceAPI{
+		1: &reverseProvider{err: errors.New("rpc unavailable")},
+	}
+	_, err := reverseLookup(60, "0x0000000000000000000000000000000000000003", nil, apis)
+	assert.NotNil(t, err)
+
+	_, cached := reverseCache.Get(reverseCacheKey(60, "0x0000000000000000000000000000000000000003"))
+	assert.False(t, cached)
+}
+
+func TestReverseLookup_Routes(t *testing.T) {
+	address := "0x0000000000000000000000000000000000000004"
+	apis := map[uint]blockatlas.NamingServiceAPI{
+		coin.ETH: &reverseProvider{
+			names:     map[string]string{address: "carol.rev"},
+			addresses: map[string]string{"carol.rev": address},
+		},
+		coin.ZIL: &reverseProvider{addresses: map[string]string{"carol.rev": "0x0000000000000000000000000000000000000005"}},
+	}
+
+	got, err := reverseLookup(60, address, []Route{{TLD: ".rev", Providers: []string{"ethereum"}}}, apis)
+	assert.Nil(t, err)
+	assert.Equal(t, "carol.rev", got.Name)
+
+	reverseCache.Flush()
+	got, err = reverseLookup(60, address, []Route{{TLD: ".rev", Providers: []string{"zilliqa", "ethereum"}}}, apis)
+	assert.Nil(t, err)
+	assert.Empty(t, got.Name, "the name resolves to another address through its route")
+
+	reverseCache.Flush()
+	got, err = reverseLookup(60, address, []Route{{TLD: ".eth", Providers: []string{"ethereum"}}}, apis)
+	assert.Nil(t, err)
+	assert.Empty(t, got.Name, "the name has no route")
+	reverseCache.Flush()
+}
+
+type slowReverseProvider struct {
+	running, max int32
+}
+
+func (p *slowReverseProvider) CanHandle(name string) bool { return false }
+
+func (p *slowReverseProvider) Lookup(coins []uint64, name string) ([]blockatlas.Resolved, error) {
+	return []blockatlas.Resolved{}, nil
+}
+
+func (p *slowReverseProvider) ReverseLookup(coin uint64, address string) (string, error) {
+	running := atomic.AddInt32(&p.running, 1)
+	defer atomic.AddInt32(&p.running, -1)
+	for {
+		max := atomic.LoadInt32(&p.max)
+		if running <= max || atomic.CompareAndSwapInt32(&p.max, max, running) {
+			break
+		}
+	}
+	time.Sleep(time.Millisecond * 5)
+	return "", nil
+}
+
+func TestReverseLookupBatch(t *testing.T) {
+	provider := &slowReverseProvider{}
+	apis := map[uint]blockatlas.NamingServiceAPI{1: provider}
+	requests := make([]AddressRequest, 0, 30)
+	for i := 0; i < 30; i++ {
+		requests = append(requests, AddressRequest{Coin: 60, Address: fmt.Sprintf("0x%040d", 100+i)})
+	}
+	result := reverseLookupBatch(requests, nil, apis)
+	assert.Len(t, result, 30)
+	assert.LessOrEqual(t, provider.max, int32(maxReverseLookups), "the lookups are bounded")
+	reverseCache.Flush()
+}
+
+func TestSameAddress(t *testing.T) {
+	assert.True(t, sameAddress("0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045", "0xd8da6bf26964af9d7eed9e03e53415d37aa96045"))
+	assert.False(t, sameAddress("zil1abc", "ZIL1ABC"))
+}
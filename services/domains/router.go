@@ -0,0 +1,105 @@
+package domains
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/spf13/viper"
+	"github.com/trustwallet/blockatlas/coin"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/logger"
+	"github.com/trustwallet/blockatlas/pkg/naming"
+)
+
+const wildcard = "*"
+
+type (
+	// Route maps a top domain, e.g. ".eth" or "@trust", to the naming providers tried in order.
+	// A wildcard domain like "@*" matches any domain with the separator.
+	Route struct {
+		TLD       string   `mapstructure:"tld"`
+		Providers []string `mapstructure:"providers"`
+	}
+
+	provider struct {
+		name string
+		api  blockatlas.NamingServiceAPI
+	}
+)
+
+// getRoutes reads the routes on every call, so they follow a config reload
+func getRoutes() []Route {
+	var routes []Route
+	if err := viper.UnmarshalKey("naming.routes", &routes); err != nil {
+		logger.Error(err, "Invalid naming routes")
+		return nil
+	}
+	return routes
+}
+
+// findProviders returns the providers of the route matching the name, exact domains take precedence over wildcards.
+// Without routes every provider which CanHandle the name is returned.
+func findProviders(name string, routes []Route, allApis map[uint]blockatlas.NamingServiceAPI) []provider {
+	if len(routes) == 0 {
+		return findHandlerProviders(name, allApis)
+	}
+	route, ok := matchRoute(name, routes)
+	if !ok {
+		return nil
+	}
+	apis := make(map[string]blockatlas.NamingServiceAPI, len(allApis))
+	for id, api := range allApis {
+		apis[coin.Coins[id].Handle] = api
+	}
+	providers := make([]provider, 0, len(route.Providers))
+	for _, name := range route.Providers {
+		api, ok := apis[name]
+		if !ok {
+			continue
+		}
+		providers = append(providers, provider{name: name, api: api})
+	}
+	return providers
+}
+
+func matchRoute(name string, routes []Route) (Route, bool) {
+	var (
+		fallback Route
+		found    bool
+	)
+	for _, r := range routes {
+		if len(r.TLD) < 2 {
+			continue
+		}
+		domain := naming.GetTopDomain(name, r.TLD[:1])
+		if domain == "" {
+			continue
+		}
+		if r.TLD[1:] == wildcard {
+			if !found {
+				fallback, found = r, true
+			}
+			continue
+		}
+		if domain == strings.ToLower(r.TLD) {
+			return r, true
+		}
+	}
+	return fallback, found
+}
+
+func findHandlerProviders(name string, allApis map[uint]blockatlas.NamingServiceAPI) []provider {
+	ids := make([]uint, 0, len(allApis))
+	for id := range allApis {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	providers := make([]provider, 0)
+	for _, id := range ids {
+		if allApis[id].CanHandle(name) {
+			providers = append(providers, provider{name: coin.Coins[id].Handle, api: allApis[id]})
+		}
+	}
+	return providers
+}
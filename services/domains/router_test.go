@@ -0,0 +1,64 @@
+package domains
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/coin"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+)
+
+var testRoutes = []Route{
+	{TLD: ".eth", Providers: []string{"ethereum"}},
+	{TLD: ".crypto", Providers: []string{"zilliqa", "ethereum"}},
+	{TLD: "@*", Providers: []string{"fio"}},
+	{TLD: "@trust", Providers: []string{"fio", "disabled"}},
+}
+
+func TestMatchRoute(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    string
+		matched bool
+	}{
+		{"vitalik.eth", ".eth", true},
+		{"vitalik.ETH", ".eth", true},
+		{"brad.crypto", ".crypto", true},
+		{"bob@trust", "@trust", true},
+		{"bob@binance", "@*", true},
+		{"bob@", "", false},
+		{"vitalik.xyz", "", false},
+		{"vitalik", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := matchRoute(tt.name, testRoutes)
+			assert.Equal(t, tt.matched, ok)
+			assert.Equal(t, tt.want, got.TLD)
+		})
+	}
+}
+
+func TestFindProviders(t *testing.T) {
+	apis := map[uint]blockatlas.NamingServiceAPI{
+		coin.ETH: &ProviderOne{},
+		coin.ZIL: &ProviderTwo{},
+		coin.FIO: &ProviderOne{},
+	}
+	providers := findProviders("brad.crypto", testRoutes, apis)
+	assert.Len(t, providers, 2)
+	assert.Equal(t, "zilliqa", providers[0].name)
+	assert.Equal(t, "ethereum", providers[1].name)
+
+	providers = findProviders("bob@trust", testRoutes, apis)
+	assert.Len(t, providers, 1)
+	assert.Equal(t, "fio", providers[0].name)
+
+	assert.Len(t, findProviders("vitalik.xyz", testRoutes, apis), 0)
+
+	// without routes the providers decide with CanHandle
+	providers = findProviders("user.one", nil, apis)
+	assert.Len(t, providers, 2)
+	assert.Equal(t, "ethereum", providers[0].name)
+	assert.Equal(t, "fio", providers[1].name)
+}
@@ -0,0 +1,201 @@
+package economics
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/trustwallet/blockatlas/db/models"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/logger"
+)
+
+type (
+	snapshot struct {
+		date      int64
+		economics blockatlas.StakingEconomics
+	}
+
+	// Store persists the runs in postgres, the history survives the restarts and is shared by the API instances
+	Store interface {
+		AddStakingEconomics(economics models.StakingEconomics, ctx context.Context) error
+		GetStakingEconomics(handle string, limit int, ctx context.Context) ([]models.StakingEconomics, error)
+		DeleteStakingEconomicsBefore(handle string, date int64, ctx context.Context) error
+	}
+)
+
+var (
+	// HistorySize is the number of refreshes kept for every platform, the oldest are dropped first
+	HistorySize = 720
+	// Database keeps the history, nil keeps it in the memory of the process
+	Database Store
+
+	mu      sync.RWMutex
+	history = make(map[string][]snapshot)
+)
+
+// Run refreshes the economics of the staking platforms every interval, the first time right away once the
+// history is loaded. The platforms are resolved on every refresh to follow the reloads of the configuration
+func Run(apis func() map[string]blockatlas.StakeAPI, interval time.Duration) {
+	Load(apis())
+	for {
+		Refresh(apis())
+		time.Sleep(interval)
+	}
+}
+
+// Refresh computes the economics of the platforms implementing StakingEconomicsAPI and stores them
+func Refresh(apis map[string]blockatlas.StakeAPI) {
+	for handle, api := range apis {
+		economicsAPI, ok := api.(blockatlas.StakingEconomicsAPI)
+		if !ok {
+			continue
+		}
+		economics, err := economicsAPI.GetEconomics()
+		if err != nil {
+			logger.Error(err, "Failed to compute the staking economics", logger.Params{"coin": handle})
+			continue
+		}
+		store(handle, economics, time.Now().Unix())
+	}
+}
+
+// Load reads the history of the platforms implementing StakingEconomicsAPI from the database
+func Load(apis map[string]blockatlas.StakeAPI) {
+	if Database == nil {
+		return
+	}
+	for handle, api := range apis {
+		if _, ok := api.(blockatlas.StakingEconomicsAPI); !ok {
+			continue
+		}
+		if err := load(handle); err != nil {
+			logger.Error(err, "Failed to load the staking economics", logger.Params{"coin": handle})
+		}
+	}
+}
+
+// store saves the run in the database and reloads the history with the runs of the other instances,
+// the run is kept in memory if the database fails
+func store(handle string, economics blockatlas.StakingEconomics, date int64) {
+	if Database != nil {
+		err := persist(handle, economics, date)
+		if err == nil {
+			return
+		}
+		logger.Error(err, "Failed to save the staking economics", logger.Params{"coin": handle})
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	snapshots := append(history[handle], snapshot{date: date, economics: economics})
+	if len(snapshots) > HistorySize {
+		snapshots = snapshots[len(snapshots)-HistorySize:]
+	}
+	history[handle] = snapshots
+}
+
+func persist(handle string, economics blockatlas.StakingEconomics, date int64) error {
+	validators, err := json.Marshal(economics.Validators)
+	if err != nil {
+		return err
+	}
+	err = Database.AddStakingEconomics(models.StakingEconomics{
+		Handle:      handle,
+		Date:        date,
+		Annual:      economics.Annual,
+		Inflation:   economics.Inflation,
+		BondedRatio: economics.BondedRatio,
+		Validators:  string(validators),
+	}, context.Background())
+	if err != nil {
+		return err
+	}
+	if err := load(handle); err != nil {
+		return err
+	}
+	mu.RLock()
+	snapshots := history[handle]
+	mu.RUnlock()
+	if len(snapshots) < HistorySize {
+		return nil
+	}
+	return Database.DeleteStakingEconomicsBefore(handle, snapshots[0].date, context.Background())
+}
+
+// load replaces the history of the platform with its last runs in the database
+func load(handle string) error {
+	rows, err := Database.GetStakingEconomics(handle, HistorySize, context.Background())
+	if err != nil {
+		return err
+	}
+	snapshots := make([]snapshot, 0, len(rows))
+	for i := len(rows) - 1; i >= 0; i-- {
+		var validators map[string]float64
+		if err := json.Unmarshal([]byte(rows[i].Validators), &validators); err != nil {
+			return err
+		}
+		snapshots = append(snapshots, snapshot{date: rows[i].Date, economics: blockatlas.StakingEconomics{
+			Annual:      rows[i].Annual,
+			Inflation:   rows[i].Inflation,
+			BondedRatio: rows[i].BondedRatio,
+			Validators:  validators,
+		}})
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	history[handle] = snapshots
+	return nil
+}
+
+// Latest returns the last economics computed for the platform, false before the first run
+func Latest(handle string) (blockatlas.StakingEconomics, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	snapshots := history[handle]
+	if len(snapshots) == 0 {
+		return blockatlas.StakingEconomics{}, false
+	}
+	return snapshots[len(snapshots)-1].economics, true
+}
+
+// Annual returns the latest yield of the network, the fallback until it is computed the first time
+func Annual(handle string, fallback float64) float64 {
+	economics, ok := Latest(handle)
+	if !ok {
+		return fallback
+	}
+	return economics.Annual
+}
+
+// ValidatorAnnual returns the latest yield of the validator, the fallback if the validator is unknown
+func ValidatorAnnual(handle, id string, fallback float64) float64 {
+	economics, ok := Latest(handle)
+	if !ok {
+		return fallback
+	}
+	annual, ok := economics.Validators[id]
+	if !ok {
+		return fallback
+	}
+	return annual
+}
+
+// History returns the yield series of the network, or of the validator if id isn't empty, oldest first
+func History(handle, id string) blockatlas.APRHistory {
+	mu.RLock()
+	defer mu.RUnlock()
+	result := make(blockatlas.APRHistory, 0, len(history[handle]))
+	for _, s := range history[handle] {
+		annual := s.economics.Annual
+		if id != "" {
+			v, ok := s.economics.Validators[id]
+			if !ok {
+				continue
+			}
+			annual = v
+		}
+		result = append(result, blockatlas.APRPoint{Date: s.date, Annual: annual})
+	}
+	return result
+}
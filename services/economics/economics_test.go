@@ -0,0 +1,123 @@
+package economics
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/coin"
+	"github.com/trustwallet/blockatlas/db/models"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+)
+
+type testStakeAPI struct {
+	blockatlas.StakeAPI
+	economics blockatlas.StakingEconomics
+	err       error
+}
+
+func (p testStakeAPI) GetEconomics() (blockatlas.StakingEconomics, error) {
+	return p.economics, p.err
+}
+
+func reset() {
+	history = make(map[string][]snapshot)
+}
+
+func TestRefresh(t *testing.T) {
+	reset()
+	Refresh(map[string]blockatlas.StakeAPI{
+		"cosmos": testStakeAPI{economics: blockatlas.StakingEconomics{Annual: 9.5, Validators: map[string]float64{"v1": 8.55}}},
+		"tezos":  testStakeAPI{err: errors.New("upstream error")},
+	})
+	assert.Equal(t, 9.5, Annual("cosmos", 0))
+	assert.Equal(t, 8.55, ValidatorAnnual("cosmos", "v1", 0))
+	assert.Equal(t, 9.5, ValidatorAnnual("cosmos", "v2", 9.5))
+	assert.Equal(t, 6.0, Annual("tezos", 6.0))
+	assert.Equal(t, 6.0, ValidatorAnnual("tezos", "v1", 6.0))
+}
+
+func TestHistory(t *testing.T) {
+	reset()
+	handle := coin.Coins[coin.ATOM].Handle
+	store(handle, blockatlas.StakingEconomics{Annual: 9, Validators: map[string]float64{"v1": 8}}, 100)
+	store(handle, blockatlas.StakingEconomics{Annual: 10}, 200)
+	store(handle, blockatlas.StakingEconomics{Annual: 11, Validators: map[string]float64{"v1": 9}}, 300)
+
+	assert.Equal(t, blockatlas.APRHistory{{Date: 100, Annual: 9}, {Date: 200, Annual: 10}, {Date: 300, Annual: 11}}, History(handle, ""))
+	assert.Equal(t, blockatlas.APRHistory{{Date: 100, Annual: 8}, {Date: 300, Annual: 9}}, History(handle, "v1"))
+	assert.Equal(t, blockatlas.APRHistory{}, History("unknown", ""))
+}
+
+func TestHistorySize(t *testing.T) {
+	reset()
+	size := HistorySize
+	HistorySize = 2
+	defer func() { HistorySize = size }()
+
+	store("cosmos", blockatlas.StakingEconomics{Annual: 1}, 1)
+	store("cosmos", blockatlas.StakingEconomics{Annual: 2}, 2)
+	store("cosmos", blockatlas.StakingEconomics{Annual: 3}, 3)
+	assert.Equal(t, blockatlas.APRHistory{{Date: 2, Annual: 2}, {Date: 3, Annual: 3}}, History("cosmos", ""))
+}
+
+type testStore struct {
+	rows []models.StakingEconomics
+}
+
+func (s *testStore) AddStakingEconomics(economics models.StakingEconomics, ctx context.Context) error {
+	s.rows = append(s.rows, economics)
+	return nil
+}
+
+func (s *testStore) GetStakingEconomics(handle string, limit int, ctx context.Context) ([]models.StakingEconomics, error) {
+	result := make([]models.StakingEconomics, 0)
+	for _, row := range s.rows {
+		if row.Handle == handle {
+			result = append(result, row)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Date > result[j].Date })
+	if len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
+
+func (s *testStore) DeleteStakingEconomicsBefore(handle string, date int64, ctx context.Context) error {
+	kept := make([]models.StakingEconomics, 0)
+	for _, row := range s.rows {
+		if row.Handle != handle || row.Date >= date {
+			kept = append(kept, row)
+		}
+	}
+	s.rows = kept
+	return nil
+}
+
+func TestStore_Database(t *testing.T) {
+	reset()
+	size := HistorySize
+	HistorySize = 2
+	database := &testStore{rows: []models.StakingEconomics{
+		{Handle: "cosmos", Date: 1, Annual: 1, Validators: `{"v1":0.9}`},
+	}}
+	Database = database
+	defer func() {
+		HistorySize = size
+		Database = nil
+	}()
+
+	Load(map[string]blockatlas.StakeAPI{"cosmos": testStakeAPI{}})
+	assert.Equal(t, 1.0, Annual("cosmos", 0), "the history is loaded from the database")
+	assert.Equal(t, 0.9, ValidatorAnnual("cosmos", "v1", 0))
+
+	// another instance of the api saved a run
+	database.rows = append(database.rows, models.StakingEconomics{Handle: "cosmos", Date: 2, Annual: 2, Validators: `null`})
+	store("cosmos", blockatlas.StakingEconomics{Annual: 3, Validators: map[string]float64{"v1": 2.7}}, 3)
+	assert.Equal(t, blockatlas.APRHistory{{Date: 2, Annual: 2}, {Date: 3, Annual: 3}}, History("cosmos", ""))
+	assert.Len(t, database.rows, 2, "the runs beyond the history size are deleted")
+	assert.Equal(t, `{"v1":2.7}`, database.rows[1].Validators)
+}
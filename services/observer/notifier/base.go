@@ -4,7 +4,9 @@ import (
 	"context"
 	"github.com/streadway/amqp"
 	"github.com/trustwallet/blockatlas/db"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
 	"github.com/trustwallet/blockatlas/pkg/logger"
+	"github.com/trustwallet/blockatlas/services/tokens"
 
 	"go.elastic.co/apm"
 )
@@ -13,6 +15,9 @@ const DefaultPushNotificationsBatchLimit = 50
 
 var MaxPushNotificationsBatchLimit uint = DefaultPushNotificationsBatchLimit
 
+// CollectibleTransfers returns the platform resolving the collectible transfers of the coin, nil disables it
+var CollectibleTransfers func(coinID uint) (blockatlas.CollectibleTransferAPI, bool)
+
 func RunNotifier(database *db.Instance, delivery amqp.Delivery) {
 	tx := apm.DefaultTracer.StartTransaction("RunNotifier", "app")
 	defer tx.End()
@@ -28,6 +33,7 @@ func RunNotifier(database *db.Instance, delivery amqp.Delivery) {
 	if err != nil {
 		logger.Error("failed to get transactions", err)
 	}
+	tokens.EnrichTxs(txs)
 
 	allAddresses := make([]string, 0)
 	for _, tx := range txs {
@@ -50,9 +56,30 @@ func RunNotifier(database *db.Instance, delivery amqp.Delivery) {
 		notifications = append(notifications, notificationsForAddress...)
 	}
 
+	fillCollectibleTransfers(notifications)
+
 	batches := getNotificationBatches(notifications, MaxPushNotificationsBatchLimit, ctx)
 
 	for _, batch := range batches {
 		publishNotificationBatch(batch, ctx)
 	}
 }
+
+// fillCollectibleTransfers resolves the name and the image of the collectibles of the subscribed addresses only
+func fillCollectibleTransfers(notifications []TransactionNotification) {
+	if CollectibleTransfers == nil || len(notifications) == 0 {
+		return
+	}
+	api, ok := CollectibleTransfers(notifications[0].Result.Coin)
+	if !ok {
+		return
+	}
+	txs := make([]blockatlas.Tx, len(notifications))
+	for i := range notifications {
+		txs[i] = notifications[i].Result
+	}
+	api.FillCollectibleTransfers(txs)
+	for i := range notifications {
+		notifications[i].Result = txs[i]
+	}
+}
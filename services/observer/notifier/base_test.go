@@ -0,0 +1,39 @@
+package notifier
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/coin"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+)
+
+type collectiblesAPI struct {
+	blockatlas.Platform
+}
+
+func (collectiblesAPI) FillCollectibleTransfers(txs []blockatlas.Tx) {
+	for i := range txs {
+		if transfer, ok := txs[i].Meta.(blockatlas.CollectibleTransfer); ok {
+			transfer.Name = "Kitty #" + transfer.TokenID
+			txs[i].Meta = transfer
+		}
+	}
+}
+
+func Test_fillCollectibleTransfers(t *testing.T) {
+	notifications := []TransactionNotification{
+		{Result: blockatlas.Tx{Coin: coin.ETH, Meta: blockatlas.CollectibleTransfer{TokenID: "2"}}},
+		{Result: blockatlas.Tx{Coin: coin.ETH, Meta: blockatlas.Transfer{Value: "1"}}},
+	}
+	fillCollectibleTransfers(notifications)
+	assert.Equal(t, blockatlas.CollectibleTransfer{TokenID: "2"}, notifications[0].Result.Meta, "disabled without the platforms")
+
+	CollectibleTransfers = func(coinID uint) (blockatlas.CollectibleTransferAPI, bool) {
+		return collectiblesAPI{}, coinID == coin.ETH
+	}
+	defer func() { CollectibleTransfers = nil }()
+	fillCollectibleTransfers(notifications)
+	assert.Equal(t, blockatlas.CollectibleTransfer{Name: "Kitty #2", TokenID: "2"}, notifications[0].Result.Meta)
+	assert.Equal(t, blockatlas.Transfer{Value: "1"}, notifications[1].Result.Meta)
+}
@@ -46,3 +46,17 @@ func publishNotificationBatch(batch []TransactionNotification, ctx context.Conte
 
 	logger.Info("Txs batch dispatched", logger.Params{"txs": len(batch)})
 }
+
+func publishStakingNotificationBatch(batch []StakingNotification, ctx context.Context) error {
+	span, _ := apm.StartSpan(ctx, "publishStakingNotificationBatch", "app")
+	defer span.End()
+	raw, err := json.Marshal(batch)
+	if err != nil {
+		return errors.E(err, "failed to dispatch staking events")
+	}
+	if err := mq.TxNotifications.Publish(raw); err != nil {
+		return errors.E(err, "failed to dispatch staking events")
+	}
+	logger.Info("Staking events batch dispatched", logger.Params{"events": len(batch)})
+	return nil
+}
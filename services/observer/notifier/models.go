@@ -6,8 +6,15 @@ import (
 	"go.elastic.co/apm"
 )
 
+// Events of the notifications about collectibles
+const (
+	EventCollectibleReceived = "nft_received"
+	EventCollectibleSent     = "nft_sent"
+)
+
 type TransactionNotification struct {
 	Action blockatlas.TransactionType `json:"action"`
+	Event  string                     `json:"event,omitempty"`
 	Result blockatlas.Tx              `json:"result"`
 }
 
@@ -38,12 +45,48 @@ func buildNotificationsByAddress(address string, txs blockatlas.Txs, ctx context
 	for _, tx := range transactionsByAddress {
 		tx.Direction = tx.GetTransactionDirection(address)
 		tx.InferUtxoValue(address, tx.Coin)
-		result = append(result, TransactionNotification{Action: tx.Type, Result: tx})
+		result = append(result, TransactionNotification{Action: tx.Type, Event: collectibleEvent(tx, address), Result: tx})
 	}
 
 	return result
 }
 
+// collectibleEvent returns whether the address received or sent a collectible in the transaction
+func collectibleEvent(tx blockatlas.Tx, address string) string {
+	switch meta := tx.Meta.(type) {
+	case blockatlas.CollectibleTransfer, *blockatlas.CollectibleTransfer:
+		switch tx.Direction {
+		case blockatlas.DirectionIncoming:
+			return EventCollectibleReceived
+		case blockatlas.DirectionOutgoing:
+			return EventCollectibleSent
+		}
+	case blockatlas.MultiTransfer:
+		return subTransfersEvent(meta.Transfers, address)
+	case *blockatlas.MultiTransfer:
+		return subTransfersEvent(meta.Transfers, address)
+	}
+	return ""
+}
+
+func subTransfersEvent(transfers []blockatlas.SubTransfer, address string) string {
+	received, sent := false, false
+	for _, t := range transfers {
+		if t.Type != blockatlas.TxCollectibleTransfer {
+			continue
+		}
+		received = received || (t.To == address && t.From != address)
+		sent = sent || (t.From == address && t.To != address)
+	}
+	switch {
+	case sent:
+		return EventCollectibleSent
+	case received:
+		return EventCollectibleReceived
+	}
+	return ""
+}
+
 func toUniqueAddresses(addresses []string) []string {
 	keys := make(map[string]bool)
 	var list []string
@@ -79,7 +122,13 @@ func findTransactionsByAddress(txs blockatlas.Txs, address string) []blockatlas.
 	return result
 }
 
+// containsAddress matches the participants of the transaction. A swap is normalized from the point of view of
+// its sender, e.g. each side of a Binance DEX trade has its own swap, so it only belongs to the sender
 func containsAddress(tx blockatlas.Tx, address string) bool {
+	switch tx.Meta.(type) {
+	case blockatlas.TokenSwap, *blockatlas.TokenSwap:
+		return tx.From == address
+	}
 	allAddresses := tx.GetAddresses()
 	txAddresses := toUniqueAddresses(allAddresses)
 	for _, a := range txAddresses {
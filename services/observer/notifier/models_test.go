@@ -125,6 +125,25 @@ func Test_containsAddress(t *testing.T) {
 	assert.True(t, containsAddress(utxoTransfer, "bc1qjcslq88cht8llqmh3aqshjx9we9msv386jvxl6"))
 }
 
+func Test_containsAddress_swap(t *testing.T) {
+	const (
+		buyer  = "bnb1w7puzjxu05ktc5zvpnzkndt6tyl720nsutzvpg"
+		seller = "bnb1l83kstts7lt9dpgawzechnrgjq54dql36dyspc"
+	)
+	buy := blockatlas.Tx{
+		ID:   "104867508-0",
+		From: buyer,
+		To:   seller,
+		Type: blockatlas.TxTokenSwap,
+		Meta: blockatlas.TokenSwap{
+			Input:  blockatlas.TokenTransfer{TokenID: "BNB", From: buyer, To: seller},
+			Output: blockatlas.TokenTransfer{TokenID: "AVA-645", From: seller, To: buyer},
+		},
+	}
+	assert.True(t, containsAddress(buy, buyer))
+	assert.False(t, containsAddress(buy, seller))
+}
+
 func Test_findTransactionsByAddress(t *testing.T) {
 	res := findTransactionsByAddress([]blockatlas.Tx{nativeTokenTransfer, tokenTransfer}, "tbnb1ttyn4csghfgyxreu7lmdu3lcplhqhxtzced45a")
 	sort.Slice(res, func(i, j int) bool {
@@ -144,3 +163,45 @@ func Test_buildNotificationsByAddress(t *testing.T) {
 	nativeTokenTransfer.Direction = blockatlas.DirectionOutgoing
 	assert.Equal(t, nativeTokenTransfer, notifications[0].Result)
 }
+
+func Test_collectibleEvent(t *testing.T) {
+	const (
+		seller = "0x7d8bf18C7cE84b3E175b339c4Ca93aEd1dD166F1"
+		buyer  = "0xad37fd42185Ba63009177058208dd1be4b136e6b"
+	)
+	collectibleTransfer := blockatlas.Tx{
+		ID:   "0x3b2b1e6e7d3f9a0c4e5b6d7f8091a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3",
+		Coin: coin.ETH,
+		From: seller,
+		To:   "0x06012c8cf97BEaD5deAe237070F9587f8E7A266d",
+		Type: blockatlas.TxCollectibleTransfer,
+		Meta: &blockatlas.CollectibleTransfer{
+			Name:     "Kitty #1",
+			Contract: "0x06012c8cf97BEaD5deAe237070F9587f8E7A266d",
+			TokenID:  "1",
+			Value:    "1",
+			From:     seller,
+			To:       buyer,
+		},
+	}
+	notifications := buildNotificationsByAddress(buyer, []blockatlas.Tx{collectibleTransfer}, context.Background())
+	assert.Len(t, notifications, 1)
+	assert.Equal(t, EventCollectibleReceived, notifications[0].Event)
+	assert.Equal(t, blockatlas.TxCollectibleTransfer, notifications[0].Action)
+
+	notifications = buildNotificationsByAddress(seller, []blockatlas.Tx{collectibleTransfer}, context.Background())
+	assert.Len(t, notifications, 1)
+	assert.Equal(t, EventCollectibleSent, notifications[0].Event)
+
+	batch := blockatlas.Tx{
+		From: seller,
+		To:   buyer,
+		Meta: blockatlas.MultiTransfer{Transfers: []blockatlas.SubTransfer{
+			{Type: blockatlas.TxTransfer, From: seller, To: buyer, Value: "1000"},
+			{Type: blockatlas.TxCollectibleTransfer, From: buyer, To: seller, CollectibleID: "7", Value: "2"},
+		}},
+	}
+	assert.Equal(t, EventCollectibleReceived, collectibleEvent(batch, seller))
+	assert.Equal(t, EventCollectibleSent, collectibleEvent(batch, buyer))
+	assert.Equal(t, "", collectibleEvent(tokenTransfer, "0x38d45371993eEc84f38FEDf93C646aA2D2267CEA"))
+}
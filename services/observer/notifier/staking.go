@@ -0,0 +1,326 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/trustwallet/blockatlas/db"
+	"github.com/trustwallet/blockatlas/db/models"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/logger"
+	"go.elastic.co/apm"
+)
+
+// ActionStaking is the action of the staking notifications, they are published to the transaction
+// notifications queue along with the transactions
+const ActionStaking blockatlas.TransactionType = "staking"
+
+// maxStakingRequests limits the addresses of a coin fetched concurrently by the staking watcher
+const maxStakingRequests = 8
+
+// Events of the staking notifications
+const (
+	EventRewardsClaimed        = "staking_rewards_claimed"
+	EventUndelegationCompleted = "staking_undelegation_completed"
+	EventValidatorJailed       = "staking_validator_jailed"
+	EventValidatorInactive     = "staking_validator_inactive"
+)
+
+type (
+	// StakingNotification is a change of the staking position of a subscribed address:
+	// {"action": "staking", "event": "staking_rewards_claimed", "result": {"coin": 118, "address": "cosmos1...",
+	// "validator": "cosmosvaloper1...", "value": "1000", "date": 1603000000}}
+	StakingNotification struct {
+		Action blockatlas.TransactionType `json:"action"`
+		Event  string                     `json:"event"`
+		Result StakingEvent               `json:"result"`
+	}
+
+	// StakingEvent - Value is the claimed rewards or the undelegated amount, empty for the validator events
+	StakingEvent struct {
+		Coin      uint   `json:"coin"`
+		Address   string `json:"address"`
+		Validator string `json:"validator"`
+		Value     string `json:"value,omitempty"`
+		Date      int64  `json:"date"`
+	}
+
+	// StakingState is the staking position of an address stored between two runs of the watcher
+	StakingState struct {
+		Date        int64             `json:"date"`
+		Delegations []StakingPosition `json:"delegations"`
+		Rewards     map[string]string `json:"rewards,omitempty"`
+	}
+
+	// StakingPosition is a delegation with the status of its validator when the state was taken.
+	// Unbonding is set for the pending delegations released at AvailableDate, not for the locked ones
+	StakingPosition struct {
+		Validator       string                      `json:"validator"`
+		Value           string                      `json:"value"`
+		Status          blockatlas.DelegationStatus `json:"status"`
+		AvailableDate   uint                        `json:"available_date,omitempty"`
+		Unbonding       bool                        `json:"unbonding,omitempty"`
+		ValidatorActive bool                        `json:"validator_active"`
+		ValidatorJailed bool                        `json:"validator_jailed"`
+	}
+
+	// stakingChange is the new state of an address with the events since its stored state
+	stakingChange struct {
+		Address string
+		State   StakingState
+		Events  []StakingNotification
+	}
+)
+
+// RunStakingWatcher takes the staking state of the subscribed addresses every interval and publishes the changes.
+// The platforms are resolved on every run to follow the reloads of the configuration
+func RunStakingWatcher(database *db.Instance, apis func() map[string]blockatlas.StakeAPI, interval time.Duration) {
+	for {
+		for _, api := range apis() {
+			watchStaking(database, api)
+		}
+		time.Sleep(interval)
+	}
+}
+
+// watchStaking fetches the staking states of the subscribed addresses of a coin concurrently. The states are stored
+// once their events are published, the events of a failed publication are emitted again on the next run
+func watchStaking(database *db.Instance, api blockatlas.StakeAPI) {
+	tx := apm.DefaultTracer.StartTransaction("RunStakingWatcher", "app")
+	defer tx.End()
+	ctx := apm.ContextWithTransaction(context.Background(), tx)
+
+	coin := api.Coin()
+	subscriptions, err := database.GetSubscriptionsByCoin(coin.ID, ctx)
+	if err != nil {
+		logger.Error(err, "Staking watcher: Failed to get the subscriptions", logger.Params{"coin": coin.Handle})
+		return
+	}
+	if len(subscriptions) == 0 {
+		return
+	}
+	activeValidators, err := api.GetActiveValidators()
+	if err != nil {
+		logger.Error(err, "Staking watcher: Failed to get the validators", logger.Params{"coin": coin.Handle})
+		return
+	}
+	validators := activeValidators.ToMap()
+	previous, err := getStakingStates(database, coin.ID, ctx)
+	if err != nil {
+		logger.Error(err, "Staking watcher: Failed to get the stored states", logger.Params{"coin": coin.Handle})
+		return
+	}
+
+	sem := make(chan struct{}, maxStakingRequests)
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		changes = make([]stakingChange, 0)
+	)
+	for _, sub := range subscriptions {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(address string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			state, err := getStakingState(api, address, validators, time.Now().Unix())
+			if err != nil {
+				logger.Error(err, "Staking watcher: Failed to get the staking state", logger.Params{"coin": coin.Handle, "address": address})
+				return
+			}
+			change := stakingChange{Address: address, State: state}
+			if prev, ok := previous[address]; ok {
+				change.Events = diffStakingStates(coin.ID, address, prev, state)
+			} else if len(state.Delegations) == 0 && len(state.Rewards) == 0 {
+				return
+			}
+			mu.Lock()
+			changes = append(changes, change)
+			mu.Unlock()
+		}(sub.Address)
+	}
+	wg.Wait()
+
+	published := publishStakingChanges(changes, func(batch []StakingNotification) error {
+		return publishStakingNotificationBatch(batch, ctx)
+	})
+	for _, change := range published {
+		raw, err := json.Marshal(change.State)
+		if err != nil {
+			logger.Error(err)
+			continue
+		}
+		snapshot := models.StakingSnapshot{Coin: coin.ID, Address: change.Address, State: string(raw)}
+		if err := database.SetStakingSnapshot(snapshot, ctx); err != nil {
+			logger.Error(err, "Staking watcher: Failed to store the staking state", logger.Params{"coin": coin.Handle, "address": change.Address})
+		}
+	}
+}
+
+// publishStakingChanges publishes the events of the changes in batches and returns the changes to store. A failed
+// batch stops the publication, the addresses of its events and of the next batches keep their stored state
+func publishStakingChanges(changes []stakingChange, publish func([]StakingNotification) error) []stakingChange {
+	notifications := make([]StakingNotification, 0)
+	for _, change := range changes {
+		notifications = append(notifications, change.Events...)
+	}
+	failed := make(map[string]bool)
+	if len(notifications) > 0 {
+		batches := getStakingNotificationBatches(notifications, MaxPushNotificationsBatchLimit)
+		for i, batch := range batches {
+			err := publish(batch)
+			if err == nil {
+				continue
+			}
+			logger.Error(err, "Staking watcher: Failed to publish the events", logger.Params{"events": len(batch)})
+			for _, rest := range batches[i:] {
+				for _, n := range rest {
+					failed[n.Result.Address] = true
+				}
+			}
+			break
+		}
+	}
+	result := make([]stakingChange, 0, len(changes))
+	for _, change := range changes {
+		if !failed[change.Address] {
+			result = append(result, change)
+		}
+	}
+	return result
+}
+
+func getStakingStates(database *db.Instance, coin uint, ctx context.Context) (map[string]StakingState, error) {
+	snapshots, err := database.GetStakingSnapshots(coin, ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]StakingState, len(snapshots))
+	for _, s := range snapshots {
+		var state StakingState
+		if err := json.Unmarshal([]byte(s.State), &state); err != nil {
+			logger.Error(err, "Staking watcher: Invalid stored state", logger.Params{"coin": coin, "address": s.Address})
+			continue
+		}
+		result[s.Address] = state
+	}
+	return result, nil
+}
+
+// getStakingState reads the delegations of the address, and its pending rewards when the platform provides them
+func getStakingState(api blockatlas.StakeAPI, address string, validators blockatlas.ValidatorMap, now int64) (StakingState, error) {
+	delegations, err := api.GetDelegations(address)
+	if err != nil {
+		return StakingState{}, err
+	}
+	var rewards blockatlas.PendingRewards
+	if rewardsAPI, ok := api.(blockatlas.StakingRewardsAPI); ok {
+		if rewards, err = rewardsAPI.GetPendingRewards(address); err != nil {
+			return StakingState{}, err
+		}
+	}
+	return newStakingState(delegations, rewards, validators, now), nil
+}
+
+// newStakingState records the delegations, a validator missing from the active validators is inactive
+func newStakingState(delegations blockatlas.DelegationsPage, rewards blockatlas.PendingRewards, validators blockatlas.ValidatorMap, now int64) StakingState {
+	state := StakingState{Date: now, Delegations: make([]StakingPosition, 0, len(delegations))}
+	for _, d := range delegations {
+		position := StakingPosition{Validator: d.Delegator.ID, Value: d.Value, Status: d.Status}
+		if pending, ok := d.Metadata.(blockatlas.DelegationMetaDataPending); ok {
+			position.AvailableDate = pending.AvailableDate
+			position.Unbonding = d.Status == blockatlas.DelegationStatusPending && !pending.Locked
+		}
+		if v, ok := validators[d.Delegator.ID]; ok {
+			position.ValidatorActive = v.Status
+			position.ValidatorJailed = v.Metrics != nil && v.Metrics.Jailed
+		}
+		state.Delegations = append(state.Delegations, position)
+	}
+	if len(rewards) > 0 {
+		state.Rewards = make(map[string]string, len(rewards))
+		for _, r := range rewards {
+			state.Rewards[r.Validator] = r.Value
+		}
+	}
+	return state
+}
+
+// diffStakingStates returns the events between two states of an address:
+// the pending rewards which decreased were claimed, the unbonding delegations available since the previous state
+// completed, and the validators of the active delegations which got jailed or inactive
+func diffStakingStates(coin uint, address string, prev, current StakingState) []StakingNotification {
+	result := make([]StakingNotification, 0)
+	event := func(name, validator, value string) {
+		result = append(result, StakingNotification{
+			Action: ActionStaking,
+			Event:  name,
+			Result: StakingEvent{Coin: coin, Address: address, Validator: validator, Value: value, Date: current.Date},
+		})
+	}
+
+	for validator, value := range prev.Rewards {
+		before, ok := new(big.Int).SetString(value, 10)
+		if !ok {
+			continue
+		}
+		after, ok := new(big.Int).SetString(current.Rewards[validator], 10)
+		if !ok {
+			after = big.NewInt(0)
+		}
+		if claimed := before.Sub(before, after); claimed.Sign() > 0 {
+			event(EventRewardsClaimed, validator, claimed.String())
+		}
+	}
+
+	for _, p := range prev.Delegations {
+		if !p.Unbonding || p.AvailableDate == 0 {
+			continue
+		}
+		if int64(p.AvailableDate) > prev.Date && int64(p.AvailableDate) <= current.Date {
+			event(EventUndelegationCompleted, p.Validator, p.Value)
+		}
+	}
+
+	before := make(map[string]StakingPosition)
+	for _, p := range prev.Delegations {
+		before[p.Validator] = p
+	}
+	notified := make(map[string]bool)
+	for _, p := range current.Delegations {
+		b, ok := before[p.Validator]
+		if !ok || p.Status != blockatlas.DelegationStatusActive || notified[p.Validator] {
+			continue
+		}
+		switch {
+		case p.ValidatorJailed && !b.ValidatorJailed:
+			event(EventValidatorJailed, p.Validator, "")
+		case !p.ValidatorActive && b.ValidatorActive && !p.ValidatorJailed:
+			event(EventValidatorInactive, p.Validator, "")
+		default:
+			continue
+		}
+		notified[p.Validator] = true
+	}
+	return result
+}
+
+func getStakingNotificationBatches(notifications []StakingNotification, sizeUint uint) [][]StakingNotification {
+	size := int(sizeUint)
+	resultLength := (len(notifications) + size - 1) / size
+	result := make([][]StakingNotification, resultLength)
+	lo, hi := 0, size
+	for i := range result {
+		if hi > len(notifications) {
+			hi = len(notifications)
+		}
+		result[i] = notifications[lo:hi:hi]
+		lo, hi = hi, hi+size
+	}
+	return result
+}
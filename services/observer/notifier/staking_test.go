@@ -0,0 +1,130 @@
+package notifier
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/coin"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+)
+
+const (
+	stakingAddress = "cosmos1dx27g0kzhwej0ekcf2k9hsktcxnmpl7fcehcvq"
+	validatorA     = "cosmosvaloper1ptyzewnns2kn37ewtmv6ppsvhdnmeapvtfc9y5"
+	validatorB     = "cosmosvaloper17h2x3j7u44qkrq0sk8ul0r2qr440rwgjkfg0gh"
+)
+
+func TestNewStakingState(t *testing.T) {
+	delegations := blockatlas.DelegationsPage{
+		{Delegator: blockatlas.StakeValidator{ID: validatorA}, Value: "1000", Status: blockatlas.DelegationStatusActive},
+		{
+			Delegator: blockatlas.StakeValidator{ID: validatorB},
+			Value:     "500",
+			Status:    blockatlas.DelegationStatusPending,
+			Metadata:  blockatlas.DelegationMetaDataPending{AvailableDate: 1603100000},
+		},
+		{
+			Delegator: blockatlas.StakeValidator{ID: validatorA},
+			Value:     "200",
+			Status:    blockatlas.DelegationStatusPending,
+			Metadata:  blockatlas.DelegationMetaDataPending{AvailableDate: 1603200000, Locked: true},
+		},
+	}
+	rewards := blockatlas.PendingRewards{{Validator: validatorA, Value: "25"}}
+	validators := blockatlas.ValidatorMap{
+		validatorA: {ID: validatorA, Status: true},
+		validatorB: {ID: validatorB, Status: false, Metrics: &blockatlas.ValidatorMetrics{Jailed: true}},
+	}
+
+	assert.Equal(t, StakingState{
+		Date: 1603000000,
+		Delegations: []StakingPosition{
+			{Validator: validatorA, Value: "1000", Status: blockatlas.DelegationStatusActive, ValidatorActive: true},
+			{Validator: validatorB, Value: "500", Status: blockatlas.DelegationStatusPending, AvailableDate: 1603100000, Unbonding: true, ValidatorJailed: true},
+			{Validator: validatorA, Value: "200", Status: blockatlas.DelegationStatusPending, AvailableDate: 1603200000, ValidatorActive: true},
+		},
+		Rewards: map[string]string{validatorA: "25"},
+	}, newStakingState(delegations, rewards, validators, 1603000000))
+
+	assert.Equal(t, StakingState{Date: 1603000000, Delegations: []StakingPosition{}}, newStakingState(nil, nil, validators, 1603000000))
+}
+
+func TestDiffStakingStates(t *testing.T) {
+	prev := StakingState{
+		Date: 1603000000,
+		Delegations: []StakingPosition{
+			{Validator: validatorA, Value: "1000", Status: blockatlas.DelegationStatusActive, ValidatorActive: true},
+			{Validator: validatorB, Value: "700", Status: blockatlas.DelegationStatusActive, ValidatorActive: true},
+			{Validator: validatorB, Value: "500", Status: blockatlas.DelegationStatusPending, AvailableDate: 1603000500, Unbonding: true, ValidatorActive: true},
+			{Validator: validatorB, Value: "300", Status: blockatlas.DelegationStatusPending, AvailableDate: 1609000000, Unbonding: true, ValidatorActive: true},
+			{Validator: validatorA, Value: "200", Status: blockatlas.DelegationStatusPending, AvailableDate: 1603000400, ValidatorActive: true},
+		},
+		Rewards: map[string]string{validatorA: "25", validatorB: "10"},
+	}
+	current := StakingState{
+		Date: 1603000600,
+		Delegations: []StakingPosition{
+			{Validator: validatorA, Value: "1000", Status: blockatlas.DelegationStatusActive, ValidatorJailed: true},
+			{Validator: validatorB, Value: "700", Status: blockatlas.DelegationStatusActive},
+			{Validator: validatorB, Value: "300", Status: blockatlas.DelegationStatusPending, AvailableDate: 1609000000, Unbonding: true},
+			{Validator: validatorA, Value: "200", Status: blockatlas.DelegationStatusActive, ValidatorJailed: true},
+		},
+		Rewards: map[string]string{validatorA: "3", validatorB: "12"},
+	}
+	event := func(name, validator, value string) StakingNotification {
+		return StakingNotification{
+			Action: ActionStaking,
+			Event:  name,
+			Result: StakingEvent{Coin: coin.ATOM, Address: stakingAddress, Validator: validator, Value: value, Date: 1603000600},
+		}
+	}
+
+	assert.ElementsMatch(t, []StakingNotification{
+		event(EventRewardsClaimed, validatorA, "22"),
+		event(EventUndelegationCompleted, validatorB, "500"),
+		event(EventValidatorJailed, validatorA, ""),
+		event(EventValidatorInactive, validatorB, ""),
+	}, diffStakingStates(coin.ATOM, stakingAddress, prev, current))
+
+	assert.Empty(t, diffStakingStates(coin.ATOM, stakingAddress, current, current))
+}
+
+func TestGetStakingNotificationBatches(t *testing.T) {
+	notifications := make([]StakingNotification, 5)
+	batches := getStakingNotificationBatches(notifications, 2)
+	assert.Len(t, batches, 3)
+	assert.Len(t, batches[2], 1)
+}
+
+func TestPublishStakingChanges(t *testing.T) {
+	defer func(limit uint) { MaxPushNotificationsBatchLimit = limit }(MaxPushNotificationsBatchLimit)
+	MaxPushNotificationsBatchLimit = 1
+	event := func(address string) StakingNotification {
+		return StakingNotification{Action: ActionStaking, Event: EventRewardsClaimed, Result: StakingEvent{Address: address}}
+	}
+	changes := []stakingChange{
+		{Address: "a", Events: []StakingNotification{event("a")}},
+		{Address: "b"},
+		{Address: "c", Events: []StakingNotification{event("c")}},
+		{Address: "d", Events: []StakingNotification{event("d")}},
+	}
+
+	published := 0
+	stored := publishStakingChanges(changes, func(batch []StakingNotification) error {
+		published++
+		return nil
+	})
+	assert.Equal(t, 3, published)
+	assert.Equal(t, changes, stored)
+
+	published = 0
+	stored = publishStakingChanges(changes, func(batch []StakingNotification) error {
+		if published++; batch[0].Result.Address == "c" {
+			return errors.New("connection closed")
+		}
+		return nil
+	})
+	assert.Equal(t, 2, published, "the publication stops at the failed batch")
+	assert.Equal(t, []stakingChange{changes[0], changes[1]}, stored, "the states of the unpublished events are kept")
+}
@@ -0,0 +1,199 @@
+package status
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/trustwallet/blockatlas/db"
+	"github.com/trustwallet/blockatlas/internal"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/errors"
+	"github.com/trustwallet/blockatlas/platform"
+)
+
+const (
+	defaultTimeout = time.Second * 5
+	reportCacheTTL = time.Second * 10
+)
+
+type (
+	// Checker probes the upstream APIs of the platforms and the parser progress stored in postgres
+	Checker struct {
+		// Database is optional, without it the parser status is not reported
+		Database *db.Instance
+		Timeout  time.Duration
+		// MaxLag fails the readiness probe if the parser is more blocks behind, 0 disables the check
+		MaxLag int64
+		// RequireUpstreams fails the readiness probe if an upstream API is unavailable
+		RequireUpstreams bool
+
+		sync.Mutex
+		report    Report
+		reportAge time.Time
+	}
+
+	Report struct {
+		Status    bool                        `json:"status"`
+		Build     string                      `json:"build"`
+		Date      string                      `json:"date"`
+		Database  *DatabaseStatus             `json:"database,omitempty"`
+		Platforms []blockatlas.PlatformStatus `json:"platforms"`
+	}
+
+	DatabaseStatus struct {
+		Available bool   `json:"available"`
+		Error     string `json:"error,omitempty"`
+	}
+
+	Probe struct {
+		Status bool     `json:"status"`
+		Errors []string `json:"errors,omitempty"`
+	}
+)
+
+func NewChecker(database *db.Instance, timeout time.Duration) *Checker {
+	if timeout == 0 {
+		timeout = defaultTimeout
+	}
+	return &Checker{Database: database, Timeout: timeout}
+}
+
+// GetReport returns the status of all platforms. Reports are cached for a few seconds,
+// so frequent Kubernetes probes don't hit the upstream APIs on every call.
+func (c *Checker) GetReport(platforms map[string]blockatlas.Platform) Report {
+	c.Lock()
+	defer c.Unlock()
+	if time.Since(c.reportAge) < reportCacheTTL && len(c.report.Platforms) == len(platforms) {
+		return c.report
+	}
+
+	result := make([]blockatlas.PlatformStatus, 0, len(platforms))
+	var (
+		wg sync.WaitGroup
+		mu sync.Mutex
+	)
+	wg.Add(len(platforms))
+	for _, p := range platforms {
+		go func(p blockatlas.Platform) {
+			defer wg.Done()
+			status := c.GetPlatformStatus(p)
+			mu.Lock()
+			result = append(result, status)
+			mu.Unlock()
+		}(p)
+	}
+	wg.Wait()
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Handle < result[j].Handle
+	})
+
+	c.report = Report{
+		Status:    true,
+		Build:     internal.Build,
+		Date:      internal.Date,
+		Database:  c.getDatabaseStatus(),
+		Platforms: result,
+	}
+	c.reportAge = time.Now()
+	return c.report
+}
+
+// GetPlatformStatus probes the upstream API of the platform and reads the parser progress
+func (c *Checker) GetPlatformStatus(p blockatlas.Platform) blockatlas.PlatformStatus {
+	status := blockatlas.PlatformStatus{
+		Coin:         p.Coin().ID,
+		Handle:       p.Coin().Handle,
+		Status:       blockatlas.HealthUnknown,
+		Capabilities: platform.GetCapabilities(p),
+	}
+	api, ok := p.(blockatlas.BlockAPI)
+	if !ok {
+		return status
+	}
+
+	start := time.Now()
+	height, err := c.currentBlockNumber(api)
+	status.Latency = time.Since(start).Milliseconds()
+	if err != nil {
+		status.Status = blockatlas.HealthUnavailable
+		status.Chain.Error = err.Error()
+	} else {
+		status.Status = blockatlas.HealthAvailable
+		status.Chain.Height = height
+	}
+
+	if c.Database == nil {
+		return status
+	}
+	parsed, err := c.Database.GetLastParsedBlockNumber(status.Handle, context.Background())
+	if err != nil {
+		status.Parser = &blockatlas.ParserStatus{Error: err.Error()}
+		return status
+	}
+	status.Parser = &blockatlas.ParserStatus{Height: parsed}
+	if parsed > 0 && status.Chain.Height > parsed {
+		status.Parser.Lag = status.Chain.Height - parsed
+	}
+	return status
+}
+
+// Live reports whether the process is able to serve requests
+func (c *Checker) Live() Probe {
+	return Probe{Status: true}
+}
+
+// Ready reports whether the service is able to serve platform requests
+func (c *Checker) Ready(platforms map[string]blockatlas.Platform) Probe {
+	probe := Probe{Status: true}
+	if len(platforms) == 0 {
+		probe.Status = false
+		probe.Errors = append(probe.Errors, "no platforms enabled")
+		return probe
+	}
+	report := c.GetReport(platforms)
+	if report.Database != nil && !report.Database.Available {
+		probe.Status = false
+		probe.Errors = append(probe.Errors, "database: "+report.Database.Error)
+	}
+	for _, s := range report.Platforms {
+		if c.RequireUpstreams && s.Status == blockatlas.HealthUnavailable {
+			probe.Status = false
+			probe.Errors = append(probe.Errors, s.Handle+": "+s.Chain.Error)
+		}
+		if c.MaxLag > 0 && s.Parser != nil && s.Parser.Lag > c.MaxLag {
+			probe.Status = false
+			probe.Errors = append(probe.Errors, s.Handle+": parser is behind the chain head")
+		}
+	}
+	return probe
+}
+
+func (c *Checker) getDatabaseStatus() *DatabaseStatus {
+	if c.Database == nil {
+		return nil
+	}
+	if err := c.Database.Gorm.DB().Ping(); err != nil {
+		return &DatabaseStatus{Error: err.Error()}
+	}
+	return &DatabaseStatus{Available: true}
+}
+
+func (c *Checker) currentBlockNumber(api blockatlas.BlockAPI) (int64, error) {
+	type result struct {
+		height int64
+		err    error
+	}
+	out := make(chan result, 1)
+	go func() {
+		height, err := api.CurrentBlockNumber()
+		out <- result{height, err}
+	}()
+	select {
+	case r := <-out:
+		return r.height, r.err
+	case <-time.After(c.Timeout):
+		return 0, errors.E("upstream timeout", errors.Params{"timeout": c.Timeout.String()})
+	}
+}
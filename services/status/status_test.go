@@ -0,0 +1,97 @@
+package status
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/coin"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+)
+
+type testPlatform struct {
+	coin uint
+}
+
+func (p testPlatform) Coin() coin.Coin {
+	return coin.Coins[p.coin]
+}
+
+type testBlockAPI struct {
+	testPlatform
+	height int64
+	err    error
+	delay  time.Duration
+}
+
+func (p testBlockAPI) CurrentBlockNumber() (int64, error) {
+	time.Sleep(p.delay)
+	return p.height, p.err
+}
+
+func (p testBlockAPI) GetBlockByNumber(num int64) (*blockatlas.Block, error) {
+	return nil, nil
+}
+
+func TestChecker_GetPlatformStatus(t *testing.T) {
+	checker := NewChecker(nil, time.Millisecond*50)
+	tests := []struct {
+		name       string
+		platform   blockatlas.Platform
+		wantStatus blockatlas.HealthStatus
+		wantHeight int64
+		wantError  bool
+	}{
+		{"available", testBlockAPI{testPlatform: testPlatform{coin.ATOM}, height: 100}, blockatlas.HealthAvailable, 100, false},
+		{"upstream error", testBlockAPI{testPlatform: testPlatform{coin.ATOM}, err: errors.New("502")}, blockatlas.HealthUnavailable, 0, true},
+		{"upstream timeout", testBlockAPI{testPlatform: testPlatform{coin.ATOM}, height: 100, delay: time.Second}, blockatlas.HealthUnavailable, 0, true},
+		{"no block api", testPlatform{coin.FIO}, blockatlas.HealthUnknown, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := checker.GetPlatformStatus(tt.platform)
+			assert.Equal(t, tt.platform.Coin().Handle, got.Handle)
+			assert.Equal(t, tt.wantStatus, got.Status)
+			assert.Equal(t, tt.wantHeight, got.Chain.Height)
+			assert.Equal(t, tt.wantError, got.Chain.Error != "")
+			assert.Nil(t, got.Parser)
+		})
+	}
+}
+
+func TestChecker_Ready(t *testing.T) {
+	available := map[string]blockatlas.Platform{
+		"cosmos": testBlockAPI{testPlatform: testPlatform{coin.ATOM}, height: 100},
+	}
+	unavailable := map[string]blockatlas.Platform{
+		"tezos": testBlockAPI{testPlatform: testPlatform{coin.XTZ}, err: errors.New("502")},
+	}
+
+	assert.False(t, NewChecker(nil, 0).Ready(map[string]blockatlas.Platform{}).Status)
+	assert.True(t, NewChecker(nil, 0).Ready(available).Status)
+	assert.True(t, NewChecker(nil, 0).Ready(unavailable).Status)
+
+	strict := NewChecker(nil, 0)
+	strict.RequireUpstreams = true
+	assert.True(t, strict.Ready(available).Status)
+
+	strict = NewChecker(nil, 0)
+	strict.RequireUpstreams = true
+	probe := strict.Ready(unavailable)
+	assert.False(t, probe.Status)
+	assert.Len(t, probe.Errors, 1)
+}
+
+func TestChecker_GetReport(t *testing.T) {
+	checker := NewChecker(nil, 0)
+	report := checker.GetReport(map[string]blockatlas.Platform{
+		"tezos":  testBlockAPI{testPlatform: testPlatform{coin.XTZ}, height: 5},
+		"cosmos": testBlockAPI{testPlatform: testPlatform{coin.ATOM}, height: 100},
+	})
+	assert.True(t, report.Status)
+	assert.Nil(t, report.Database)
+	assert.Len(t, report.Platforms, 2)
+	assert.Equal(t, "cosmos", report.Platforms[0].Handle)
+	assert.Equal(t, "tezos", report.Platforms[1].Handle)
+}
@@ -0,0 +1,41 @@
+package tokens
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/trustwallet/blockatlas/coin"
+	"github.com/trustwallet/blockatlas/pkg/errors"
+	"github.com/trustwallet/blockatlas/services/assets"
+)
+
+const listCacheTTL = time.Hour * 24
+
+// fetchTokenList reads the list from the sources of the assets registry
+func fetchTokenList(c coin.Coin) (map[string]ListedToken, error) {
+	raw, err := assets.ReadFile(c, "tokenlist.json")
+	if err != nil {
+		return nil, err
+	}
+	var list TokenList
+	if err := json.Unmarshal(raw, &list); err != nil {
+		return nil, errors.E(err, "invalid token list", errors.Params{"coin": c.Handle})
+	}
+	return list.toMap(), nil
+}
+
+func fetchAssetInfo(c coin.Coin, tokenID string) (AssetInfo, error) {
+	raw, err := assets.ReadFile(c, "assets/"+tokenID+"/info.json")
+	if err != nil {
+		return AssetInfo{}, errors.E(err, errors.Params{"token_id": tokenID})
+	}
+	var info AssetInfo
+	if err := json.Unmarshal(raw, &info); err != nil {
+		return AssetInfo{}, errors.E(err, "invalid asset info", errors.Params{"coin": c.Handle, "token_id": tokenID})
+	}
+	return info, nil
+}
+
+func logoURL(c coin.Coin, tokenID string) string {
+	return assets.FileURL(c, "assets/"+tokenID+"/logo.png")
+}
@@ -0,0 +1,60 @@
+package tokens
+
+import "github.com/trustwallet/blockatlas/pkg/blockatlas"
+
+const (
+	assetStatusActive = "active"
+	assetStatusSpam   = "spam"
+)
+
+type (
+	// TokenList is the tokenlist.json of a chain in the assets repository, every listed token is verified
+	TokenList struct {
+		Tokens []ListedToken `json:"tokens"`
+	}
+
+	ListedToken struct {
+		Address  string `json:"address"`
+		Name     string `json:"name"`
+		Symbol   string `json:"symbol"`
+		Decimals uint   `json:"decimals"`
+		LogoURI  string `json:"logoURI"`
+	}
+
+	// AssetInfo is the info.json of a token in the assets repository
+	AssetInfo struct {
+		Name     string `json:"name"`
+		Symbol   string `json:"symbol"`
+		Decimals uint   `json:"decimals"`
+		Status   string `json:"status"`
+	}
+
+	// Info is the resolved metadata of a token, fields read from the chain only fill the missing ones
+	Info struct {
+		Name     string
+		Symbol   string
+		Decimals uint
+		Logo     string
+		Status   blockatlas.TokenStatus
+		OnChain  bool
+	}
+)
+
+func (l TokenList) toMap() map[string]ListedToken {
+	tokens := make(map[string]ListedToken, len(l.Tokens))
+	for _, t := range l.Tokens {
+		tokens[t.Address] = t
+	}
+	return tokens
+}
+
+func (a AssetInfo) status() blockatlas.TokenStatus {
+	switch a.Status {
+	case assetStatusActive:
+		return blockatlas.TokenStatusVerified
+	case assetStatusSpam:
+		return blockatlas.TokenStatusSpam
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,357 @@
+package tokens
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+	"github.com/trustwallet/blockatlas/coin"
+	"github.com/trustwallet/blockatlas/pkg/address"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/logger"
+)
+
+const (
+	infoCacheTTL     = time.Hour * 24
+	infoMissCacheTTL = time.Hour
+	maxLookups       = 8
+)
+
+var (
+	// Enabled turns the enrichment on, the metadata is requested from the assets repository
+	Enabled bool
+	// OnChain returns the api reading the metadata of the tokens missing in the assets repository
+	OnChain func(coinID uint) (blockatlas.TokenInfoAPI, bool)
+	// Async keeps the lookups off the network for the notifier: the tokens missing in the cache are resolved
+	// in the background and enrich the next transactions
+	Async bool
+
+	infoCache = cache.New(infoCacheTTL, infoCacheTTL)
+	// pending are the tokens being resolved in the background
+	pending sync.Map
+)
+
+type tokenKey struct {
+	coin    uint
+	tokenID string
+}
+
+// Lookup returns the metadata of the token, false if neither the assets repository nor the chain knows it
+func Lookup(coinID uint, tokenID string) (Info, bool) {
+	c, ok := coin.Coins[coinID]
+	if !ok || tokenID == "" {
+		return Info{}, false
+	}
+	tokenID = normalizeID(tokenID, coinID)
+	key := c.Handle + ":" + tokenID
+	if cached, ok := infoCache.Get(key); ok {
+		info, found := cached.(*Info)
+		if !found || info == nil {
+			return Info{}, false
+		}
+		return *info, true
+	}
+	if Async {
+		if list, ok := cachedTokenList(c); ok {
+			if t, ok := list[tokenID]; ok {
+				return store(key, listedInfo(c, tokenID, t), true), true
+			}
+		}
+		resolveAsync(c, tokenID, key)
+		return Info{}, false
+	}
+	info, found := resolve(c, tokenID)
+	return store(key, info, found), found
+}
+
+// resolveAsync resolves the token in the background once, the next lookups find it in the cache
+func resolveAsync(c coin.Coin, tokenID, key string) {
+	if _, running := pending.LoadOrStore(key, true); running {
+		return
+	}
+	go func() {
+		defer pending.Delete(key)
+		info, found := resolve(c, tokenID)
+		store(key, info, found)
+	}()
+}
+
+func store(key string, info Info, found bool) Info {
+	if !found {
+		infoCache.Set(key, (*Info)(nil), infoMissCacheTTL)
+		return Info{}
+	}
+	infoCache.Set(key, &info, infoCacheTTL)
+	return info
+}
+
+func resolve(c coin.Coin, tokenID string) (Info, bool) {
+	if t, ok := tokenList(c)[tokenID]; ok {
+		return listedInfo(c, tokenID, t), true
+	}
+	if asset, err := fetchAssetInfo(c, tokenID); err == nil {
+		return Info{
+			Name:     asset.Name,
+			Symbol:   asset.Symbol,
+			Decimals: asset.Decimals,
+			Logo:     logoURL(c, tokenID),
+			Status:   asset.status(),
+		}, true
+	}
+	if OnChain == nil {
+		return Info{}, false
+	}
+	api, ok := OnChain(c.ID)
+	if !ok {
+		return Info{}, false
+	}
+	token, err := api.GetTokenInfo(tokenID)
+	if err != nil {
+		logger.Error(err, "Failed to read the token from the chain", logger.Params{"coin": c.Handle, "token_id": tokenID})
+		return Info{}, false
+	}
+	return Info{Name: token.Name, Symbol: token.Symbol, Decimals: token.Decimals, OnChain: true}, true
+}
+
+func listedInfo(c coin.Coin, tokenID string, t ListedToken) Info {
+	return Info{
+		Name:     t.Name,
+		Symbol:   t.Symbol,
+		Decimals: t.Decimals,
+		Logo:     blockatlas.GetValidParameter(t.LogoURI, logoURL(c, tokenID)),
+		Status:   blockatlas.TokenStatusVerified,
+	}
+}
+
+// tokenList caches the list on its own, chains without a list are not requested again for a day
+func tokenList(c coin.Coin) map[string]ListedToken {
+	if list, ok := cachedTokenList(c); ok {
+		return list
+	}
+	list, err := fetchTokenList(c)
+	if err != nil {
+		list = make(map[string]ListedToken)
+	}
+	infoCache.Set(listKey(c), list, listCacheTTL)
+	return list
+}
+
+func cachedTokenList(c coin.Coin) (map[string]ListedToken, bool) {
+	cached, ok := infoCache.Get(listKey(c))
+	if !ok {
+		return nil, false
+	}
+	return cached.(map[string]ListedToken), true
+}
+
+func listKey(c coin.Coin) string {
+	return "list:" + c.Handle
+}
+
+// EnrichTokens fills the metadata of the tokens from the registry
+func EnrichTokens(page blockatlas.TokenPage) {
+	if !Enabled {
+		return
+	}
+	keys := make([]tokenKey, 0, len(page))
+	for _, t := range page {
+		keys = append(keys, tokenKey{coin: t.Coin, tokenID: t.TokenID})
+	}
+	infos := lookupAll(keys)
+	for i := range page {
+		info, ok := infos[tokenKey{coin: page[i].Coin, tokenID: page[i].TokenID}]
+		if !ok {
+			continue
+		}
+		page[i].Name = merge(page[i].Name, info.Name, info.OnChain)
+		page[i].Symbol = merge(page[i].Symbol, info.Symbol, info.OnChain)
+		page[i].Decimals = mergeDecimals(page[i].Decimals, info.Decimals, info.OnChain)
+		page[i].Logo = info.Logo
+		page[i].Status = info.Status
+	}
+}
+
+// EnrichTxs fills the name, the symbol and the decimals of the token transfers from the registry
+func EnrichTxs(txs []blockatlas.Tx) {
+	if !Enabled {
+		return
+	}
+	keys := make([]tokenKey, 0)
+	for _, tx := range txs {
+		for _, id := range tokenIDs(tx.Meta) {
+			keys = append(keys, tokenKey{coin: tx.Coin, tokenID: id})
+		}
+	}
+	if len(keys) == 0 {
+		return
+	}
+	infos := lookupAll(keys)
+	for i := range txs {
+		txs[i].Meta = enrichMeta(txs[i].Coin, txs[i].Meta, infos)
+	}
+}
+
+func tokenIDs(meta interface{}) []string {
+	switch m := meta.(type) {
+	case blockatlas.TokenTransfer:
+		return []string{m.TokenID}
+	case *blockatlas.TokenTransfer:
+		return []string{m.TokenID}
+	case blockatlas.NativeTokenTransfer:
+		return []string{m.TokenID}
+	case *blockatlas.NativeTokenTransfer:
+		return []string{m.TokenID}
+	case blockatlas.TokenSwap:
+		return []string{m.Input.TokenID, m.Output.TokenID}
+	case *blockatlas.TokenSwap:
+		return []string{m.Input.TokenID, m.Output.TokenID}
+	case blockatlas.MultiTransfer:
+		return subTransferIDs(m.Transfers)
+	case *blockatlas.MultiTransfer:
+		return subTransferIDs(m.Transfers)
+	case blockatlas.AnyAction:
+		return actionIDs(m)
+	case *blockatlas.AnyAction:
+		return actionIDs(*m)
+	default:
+		return nil
+	}
+}
+
+// actionIDs returns the token of the actions on a token, e.g. approve_token, the staking actions have none
+func actionIDs(action blockatlas.AnyAction) []string {
+	if action.TokenID == "" {
+		return nil
+	}
+	return []string{action.TokenID}
+}
+
+func subTransferIDs(transfers []blockatlas.SubTransfer) []string {
+	ids := make([]string, 0, len(transfers))
+	for _, t := range transfers {
+		if t.Type == blockatlas.TxTokenTransfer {
+			ids = append(ids, t.TokenID)
+		}
+	}
+	return ids
+}
+
+func enrichMeta(coinID uint, meta interface{}, infos map[tokenKey]Info) interface{} {
+	fill := func(name, symbol *string, decimals *uint, tokenID string) {
+		info, ok := infos[tokenKey{coin: coinID, tokenID: tokenID}]
+		if !ok {
+			return
+		}
+		*name = merge(*name, info.Name, info.OnChain)
+		*symbol = merge(*symbol, info.Symbol, info.OnChain)
+		*decimals = mergeDecimals(*decimals, info.Decimals, info.OnChain)
+	}
+	switch m := meta.(type) {
+	case blockatlas.TokenTransfer:
+		fill(&m.Name, &m.Symbol, &m.Decimals, m.TokenID)
+		return m
+	case *blockatlas.TokenTransfer:
+		fill(&m.Name, &m.Symbol, &m.Decimals, m.TokenID)
+		return m
+	case blockatlas.NativeTokenTransfer:
+		fill(&m.Name, &m.Symbol, &m.Decimals, m.TokenID)
+		return m
+	case *blockatlas.NativeTokenTransfer:
+		fill(&m.Name, &m.Symbol, &m.Decimals, m.TokenID)
+		return m
+	case blockatlas.TokenSwap:
+		fill(&m.Input.Name, &m.Input.Symbol, &m.Input.Decimals, m.Input.TokenID)
+		fill(&m.Output.Name, &m.Output.Symbol, &m.Output.Decimals, m.Output.TokenID)
+		return m
+	case *blockatlas.TokenSwap:
+		fill(&m.Input.Name, &m.Input.Symbol, &m.Input.Decimals, m.Input.TokenID)
+		fill(&m.Output.Name, &m.Output.Symbol, &m.Output.Decimals, m.Output.TokenID)
+		return m
+	case blockatlas.MultiTransfer:
+		m.Transfers = enrichSubTransfers(m.Transfers, fill)
+		return m
+	case *blockatlas.MultiTransfer:
+		m.Transfers = enrichSubTransfers(m.Transfers, fill)
+		return m
+	case blockatlas.AnyAction:
+		fill(&m.Name, &m.Symbol, &m.Decimals, m.TokenID)
+		return m
+	case *blockatlas.AnyAction:
+		fill(&m.Name, &m.Symbol, &m.Decimals, m.TokenID)
+		return m
+	default:
+		return meta
+	}
+}
+
+func enrichSubTransfers(transfers []blockatlas.SubTransfer, fill func(*string, *string, *uint, string)) []blockatlas.SubTransfer {
+	result := make([]blockatlas.SubTransfer, len(transfers))
+	copy(result, transfers)
+	for i := range result {
+		if result[i].Type == blockatlas.TxTokenTransfer {
+			fill(&result[i].Name, &result[i].Symbol, &result[i].Decimals, result[i].TokenID)
+		}
+	}
+	return result
+}
+
+// lookupAll resolves the distinct tokens concurrently
+func lookupAll(keys []tokenKey) map[tokenKey]Info {
+	var (
+		result = make(map[tokenKey]Info)
+		mu     sync.Mutex
+		wg     sync.WaitGroup
+		sem    = make(chan struct{}, maxLookups)
+		seen   = make(map[tokenKey]bool)
+	)
+	for _, key := range keys {
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(key tokenKey) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			info, ok := Lookup(key.coin, key.tokenID)
+			if !ok {
+				return
+			}
+			mu.Lock()
+			result[key] = info
+			mu.Unlock()
+		}(key)
+	}
+	wg.Wait()
+	return result
+}
+
+// merge prefers the registry over the platform value, and the platform value over the chain
+func merge(current, value string, onChain bool) string {
+	if onChain {
+		return blockatlas.GetValidParameter(current, value)
+	}
+	return blockatlas.GetValidParameter(value, current)
+}
+
+func mergeDecimals(current, value uint, onChain bool) uint {
+	if onChain && current != 0 {
+		return current
+	}
+	if !onChain && value == 0 {
+		return current
+	}
+	return value
+}
+
+// normalizeID checksums the contract addresses of the ethereum-family chains, the form of the assets repository
+func normalizeID(tokenID string, coinID uint) string {
+	if strings.HasPrefix(tokenID, "0x") && len(tokenID) == 42 {
+		return address.ToEIP55ByCoinID(tokenID, coinID)
+	}
+	return tokenID
+}
@@ -0,0 +1,146 @@
+package tokens
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/coin"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/services/assets"
+)
+
+const (
+	daiID  = "0x6B175474E89094C44Da98b954EedeAC495271d0F"
+	spamID = "0x7a250d5630B4cF539739dF2C5dAcb4c659F2488D"
+)
+
+type chainAPI struct {
+	blockatlas.Platform
+	token blockatlas.Token
+}
+
+func (c chainAPI) GetTokenInfo(tokenID string) (blockatlas.Token, error) {
+	return c.token, nil
+}
+
+func initRegistry() func() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ethereum/tokenlist.json":
+			_, _ = w.Write([]byte(`{"tokens":[{"address":"` + daiID + `","name":"Dai","symbol":"DAI","decimals":18,"logoURI":"https://logo/dai.png"}]}`))
+		case "/ethereum/assets/" + spamID + "/info.json":
+			_, _ = w.Write([]byte(`{"name":"Free Tokens","symbol":"FREE","decimals":18,"status":"spam"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`404: Not Found`))
+		}
+	}))
+	assets.Init("", []string{server.URL + "/"}, "")
+	// the tests don't reach GitHub
+	assets.RemoteSources = assets.RemoteSources[:1]
+	Enabled = true
+	OnChain = nil
+	infoCache.Flush()
+	return func() {
+		server.Close()
+		assets.Init("", nil, "")
+		Enabled = false
+		Async = false
+	}
+}
+
+func TestLookup(t *testing.T) {
+	defer initRegistry()()
+
+	info, ok := Lookup(coin.ETH, "0x6b175474e89094c44da98b954eedeac495271d0f")
+	assert.True(t, ok)
+	assert.Equal(t, Info{Name: "Dai", Symbol: "DAI", Decimals: 18, Logo: "https://logo/dai.png", Status: blockatlas.TokenStatusVerified}, info)
+
+	info, ok = Lookup(coin.ETH, spamID)
+	assert.True(t, ok)
+	assert.Equal(t, blockatlas.TokenStatusSpam, info.Status)
+	assert.Equal(t, assets.RemoteSources[0].Name()+"ethereum/assets/"+spamID+"/logo.png", info.Logo)
+
+	_, ok = Lookup(coin.ETH, "0x0000000000085d4780B73119b644AE5ecd22b376")
+	assert.False(t, ok)
+
+	OnChain = func(coinID uint) (blockatlas.TokenInfoAPI, bool) {
+		return chainAPI{token: blockatlas.Token{Name: "TrueUSD", Symbol: "TUSD", Decimals: 18}}, true
+	}
+	_, ok = Lookup(coin.ETH, "0x0000000000085d4780B73119b644AE5ecd22b376")
+	assert.False(t, ok, "misses are cached")
+
+	info, ok = Lookup(coin.ETH, "0x8E870D67F660D95d5be530380D0eC0bd388289E1")
+	assert.True(t, ok)
+	assert.Equal(t, Info{Name: "TrueUSD", Symbol: "TUSD", Decimals: 18, OnChain: true}, info)
+}
+
+func TestLookup_Async(t *testing.T) {
+	defer initRegistry()()
+	Async = true
+
+	_, ok := Lookup(coin.ETH, spamID)
+	assert.False(t, ok, "the lookup doesn't wait for the registry")
+	assert.Eventually(t, func() bool {
+		_, ok := Lookup(coin.ETH, spamID)
+		return ok
+	}, time.Second, time.Millisecond*10)
+
+	info, ok := Lookup(coin.ETH, daiID)
+	assert.True(t, ok, "the tokens of the cached list are found at once")
+	assert.Equal(t, "Dai", info.Name)
+}
+
+func TestEnrichTxs(t *testing.T) {
+	defer initRegistry()()
+	OnChain = func(coinID uint) (blockatlas.TokenInfoAPI, bool) {
+		return chainAPI{token: blockatlas.Token{Name: "Chain", Symbol: "CHN", Decimals: 6}}, true
+	}
+
+	txs := []blockatlas.Tx{
+		{Coin: coin.ETH, Meta: blockatlas.TokenTransfer{Name: "", Symbol: "dai", TokenID: daiID, Decimals: 0, Value: "1"}},
+		{Coin: coin.ETH, Meta: &blockatlas.TokenTransfer{Name: "Platform", TokenID: "0x8E870D67F660D95d5be530380D0eC0bd388289E1", Decimals: 8}},
+		{Coin: coin.ETH, Meta: blockatlas.MultiTransfer{Transfers: []blockatlas.SubTransfer{
+			{Type: blockatlas.TxTransfer, Value: "1"},
+			{Type: blockatlas.TxTokenTransfer, TokenID: daiID, Value: "2"},
+		}}},
+		{Coin: coin.ETH, Meta: blockatlas.Transfer{Value: "1"}},
+		{Coin: coin.ETH, Meta: blockatlas.AnyAction{Key: blockatlas.KeyApproveToken, TokenID: daiID, Value: "1"}},
+		{Coin: coin.ETH, Meta: blockatlas.AnyAction{Key: blockatlas.KeyStakeDelegate, Value: "1"}},
+	}
+	EnrichTxs(txs)
+
+	assert.Equal(t, blockatlas.TokenTransfer{Name: "Dai", Symbol: "DAI", TokenID: daiID, Decimals: 18, Value: "1"}, txs[0].Meta)
+	assert.Equal(t, &blockatlas.TokenTransfer{Name: "Platform", Symbol: "CHN", TokenID: "0x8E870D67F660D95d5be530380D0eC0bd388289E1", Decimals: 8}, txs[1].Meta)
+	multi := txs[2].Meta.(blockatlas.MultiTransfer)
+	assert.Equal(t, "", multi.Transfers[0].Symbol)
+	assert.Equal(t, "DAI", multi.Transfers[1].Symbol)
+	assert.Equal(t, blockatlas.Transfer{Value: "1"}, txs[3].Meta)
+	assert.Equal(t, blockatlas.AnyAction{Key: blockatlas.KeyApproveToken, TokenID: daiID, Name: "Dai", Symbol: "DAI", Decimals: 18, Value: "1"}, txs[4].Meta)
+	assert.Equal(t, blockatlas.AnyAction{Key: blockatlas.KeyStakeDelegate, Value: "1"}, txs[5].Meta)
+}
+
+func TestEnrichTokens(t *testing.T) {
+	defer initRegistry()()
+
+	page := blockatlas.TokenPage{
+		{Name: "Dai Stablecoin", Symbol: "DAI", Decimals: 18, TokenID: daiID, Coin: coin.ETH},
+		{Name: "Free Tokens", Symbol: "FREE", Decimals: 18, TokenID: spamID, Coin: coin.ETH},
+	}
+	EnrichTokens(page)
+
+	assert.Equal(t, "Dai", page[0].Name)
+	assert.Equal(t, "https://logo/dai.png", page[0].Logo)
+	assert.Equal(t, blockatlas.TokenStatusVerified, page[0].Status)
+	assert.Equal(t, blockatlas.TokenStatusSpam, page[1].Status)
+}
+
+func TestEnrich_Disabled(t *testing.T) {
+	page := blockatlas.TokenPage{{Name: "Dai Stablecoin", TokenID: daiID, Coin: coin.ETH}}
+	EnrichTokens(page)
+	assert.Equal(t, "Dai Stablecoin", page[0].Name)
+	assert.Equal(t, "", page[0].Logo)
+}
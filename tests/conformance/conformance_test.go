@@ -0,0 +1,105 @@
+package conformance
+
+import (
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/imroc/req"
+	"github.com/spf13/viper"
+	"github.com/trustwallet/blockatlas/config"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/conformance"
+	"github.com/trustwallet/blockatlas/pkg/replay"
+	"github.com/trustwallet/blockatlas/platform"
+)
+
+const (
+	fixtures        = "../golden/testdata"
+	mockConfig      = "../../configmock.yml"
+	mockTransaction = "../postman/transaction_data.json"
+)
+
+var mockserver = flag.Bool("mockserver", false, "run the platforms against a running mockserver (make start-mockserver)")
+
+type mockTransactionCase struct {
+	Handler string `json:"handler"`
+	Address string `json:"address"`
+}
+
+// TestConformanceFixtures runs every golden suite through the conformance checks using recorded fixtures
+func TestConformanceFixtures(t *testing.T) {
+	suites, err := replay.LoadSuites(fixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, suite := range suites {
+		suite := suite
+		t.Run(filepath.Base(suite.Dir), func(t *testing.T) {
+			transport := replay.NewTransport(replay.ModeReplay, suite.FixturesPath())
+			transport.IgnoreQuery = suite.IgnoreQuery
+
+			defaultTransport := blockatlas.DefaultClient.Transport
+			blockatlas.DefaultClient.Transport = transport
+			defer func() { blockatlas.DefaultClient.Transport = defaultTransport }()
+			defaultReqClient := req.Client()
+			req.SetClient(transport.Client())
+			defer req.SetClient(defaultReqClient)
+
+			for key, value := range suite.Config {
+				viper.Set(key, value)
+			}
+			platform.Init([]string{suite.Platform})
+			p, ok := platform.Platforms[suite.Platform]
+			if !ok {
+				t.Fatalf("platform %s is not enabled, check the suite config", suite.Platform)
+			}
+			for _, c := range suite.Cases {
+				result, err := replay.Run(p, c)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if violations := conformance.CheckResult(p, result); len(violations) > 0 {
+					t.Errorf("%s:\n%s", c.Name, violations.Error())
+				}
+			}
+		})
+	}
+}
+
+// TestConformanceMockserver runs the transaction cases of the postman collection against the mockserver
+func TestConformanceMockserver(t *testing.T) {
+	if !*mockserver {
+		t.Skip("mockserver is not enabled, use -mockserver")
+	}
+	b, err := ioutil.ReadFile(mockTransaction)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var cases []mockTransactionCase
+	if err := json.Unmarshal(b, &cases); err != nil {
+		t.Fatal(err)
+	}
+
+	config.LoadConfig(mockConfig)
+	platform.Init(viper.GetStringSlice("platform"))
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.Handler, func(t *testing.T) {
+			p, ok := platform.Platforms[c.Handler]
+			if !ok {
+				t.Skipf("platform %s is not enabled", c.Handler)
+			}
+			result, err := replay.Run(p, replay.Case{Name: c.Handler, Method: replay.MethodTxsByAddress, Address: c.Address})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if violations := conformance.CheckResult(p, result); len(violations) > 0 {
+				t.Error(violations.Error())
+			}
+		})
+	}
+}
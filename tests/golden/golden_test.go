@@ -0,0 +1,94 @@
+package golden
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/imroc/req"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/trustwallet/blockatlas/pkg/blockatlas"
+	"github.com/trustwallet/blockatlas/pkg/replay"
+	"github.com/trustwallet/blockatlas/platform"
+	"github.com/trustwallet/blockatlas/services/economics"
+)
+
+const testdata = "testdata"
+
+var (
+	record = flag.Bool("record", false, "call the real upstream APIs and store the responses as fixtures")
+	update = flag.Bool("update", false, "rewrite the golden files with the current output")
+)
+
+func TestGolden(t *testing.T) {
+	suites, err := replay.LoadSuites(testdata)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, suite := range suites {
+		suite := suite
+		t.Run(filepath.Base(suite.Dir), func(t *testing.T) {
+			runSuite(t, suite)
+		})
+	}
+}
+
+func runSuite(t *testing.T, suite replay.Suite) {
+	mode := replay.ModeReplay
+	if *record {
+		mode = replay.ModeRecord
+	}
+	transport := replay.NewTransport(mode, suite.FixturesPath())
+	transport.IgnoreQuery = suite.IgnoreQuery
+
+	defaultTransport := blockatlas.DefaultClient.Transport
+	blockatlas.DefaultClient.Transport = transport
+	defer func() { blockatlas.DefaultClient.Transport = defaultTransport }()
+	// binance and zilliqa call their APIs through the default client of req
+	defaultReqClient := req.Client()
+	req.SetClient(transport.Client())
+	defer req.SetClient(defaultReqClient)
+
+	for key, value := range suite.Config {
+		viper.Set(key, value)
+	}
+	platform.Init([]string{suite.Platform})
+	p, ok := platform.Platforms[suite.Platform]
+	if !ok {
+		t.Fatalf("platform %s is not enabled, check the suite config", suite.Platform)
+	}
+	economics.Refresh(platform.GetStakeAPIs())
+
+	for _, c := range suite.Cases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			result, err := replay.Run(p, c)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := replay.Normalize(result, c)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			path := suite.GoldenPath(c)
+			if *record || *update {
+				if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+					t.Fatal(err)
+				}
+				if err := ioutil.WriteFile(path, got, 0644); err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			want, err := ioutil.ReadFile(path)
+			if err != nil {
+				t.Fatalf("missing golden file, run with -update: %s", err)
+			}
+			assert.JSONEq(t, string(want), string(got))
+		})
+	}
+}